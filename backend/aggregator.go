@@ -0,0 +1,37 @@
+package backend
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-coldbrew/log"
+)
+
+// RunAggregator calls refresh every interval until ctx is cancelled. Each
+// outcome is logged; a failed refresh is retried on the next tick.
+func RunAggregator(ctx context.Context, interval time.Duration, refresh func(context.Context) error) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	log.Info(ctx, "Summary aggregator started", "interval", interval.String())
+
+	for {
+		select {
+		case <-ctx.Done():
+			log.Info(ctx, "Summary aggregator stopped")
+			return
+		case <-ticker.C:
+		}
+
+		start := time.Now()
+		if err := refresh(ctx); err != nil {
+			if ctx.Err() != nil {
+				log.Info(ctx, "Summary aggregator stopped")
+				return
+			}
+			log.Error(ctx, "Failed to refresh summaries", "error", err)
+			continue
+		}
+		log.Info(ctx, "Summaries refreshed", "duration_ms", time.Since(start).Milliseconds())
+	}
+}
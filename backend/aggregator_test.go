@@ -0,0 +1,42 @@
+package backend
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRunAggregator_RefreshesUntilCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	var calls atomic.Int32
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		RunAggregator(ctx, time.Millisecond, func(ctx context.Context) error {
+			// A failing refresh must not stop the loop.
+			if calls.Add(1) == 1 {
+				return errors.New("refresh failed")
+			}
+			return nil
+		})
+	}()
+
+	require.Eventually(t, func() bool { return calls.Load() >= 3 }, time.Second, time.Millisecond)
+	cancel()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("aggregator did not stop after cancellation")
+	}
+
+	stopped := calls.Load()
+	time.Sleep(5 * time.Millisecond)
+	assert.Equal(t, stopped, calls.Load())
+}
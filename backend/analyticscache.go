@@ -0,0 +1,196 @@
+package backend
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	analyticsCacheHits   atomic.Uint64
+	analyticsCacheMisses atomic.Uint64
+
+	analyticsCacheLookups = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "analytics_cache_lookups_total",
+		Help: "Analytics cache lookups by repository method and result (hit or miss).",
+	}, []string{"method", "result"})
+
+	_ = promauto.NewGaugeFunc(prometheus.GaugeOpts{
+		Name: "analytics_cache_hit_ratio",
+		Help: "Fraction of analytics cache lookups served from the cache since startup.",
+	}, func() float64 {
+		hits, misses := analyticsCacheHits.Load(), analyticsCacheMisses.Load()
+		if hits+misses == 0 {
+			return 0
+		}
+		return float64(hits) / float64(hits+misses)
+	})
+)
+
+// cachedRepository serves MTBF and top-breakdown reads, which change slowly
+// but are polled by every dashboard, from a TTL cache. The cache is cleared
+// on any write that can change those results: incident writes, and
+// creations, renames or deletions of the lines and stations they are
+// reported against, since new ones appear as zero-count rows. Writes
+// made by other replicas are only picked up once entries expire.
+type cachedRepository struct {
+	RepositoryInterface
+	cache *ttlCache[any]
+}
+
+// newCachedRepository wraps repo with an analytics cache holding results for
+// ttl, or returns repo unchanged when ttl is not positive.
+func newCachedRepository(repo RepositoryInterface, ttl time.Duration) RepositoryInterface {
+	if ttl <= 0 {
+		return repo
+	}
+	return &cachedRepository{RepositoryInterface: repo, cache: newTTLCache[any](ttl)}
+}
+
+// cachedRead returns the value cached under method and key, calling load and
+// caching its result on a miss. Errors are not cached.
+func cachedRead[V any](r *cachedRepository, method, key string, load func() (V, error)) (V, error) {
+	cacheKey := method + "|" + key
+	if value, ok := r.cache.Get(cacheKey); ok {
+		analyticsCacheHits.Add(1)
+		analyticsCacheLookups.WithLabelValues(method, "hit").Inc()
+		return value.(V), nil
+	}
+	analyticsCacheMisses.Add(1)
+	analyticsCacheLookups.WithLabelValues(method, "miss").Inc()
+
+	generation := r.cache.Generation()
+	value, err := load()
+	if err != nil {
+		return value, err
+	}
+	r.cache.SetIfCurrent(generation, cacheKey, value)
+	return value, nil
+}
+
+// invalidate clears the cache after a write, whether or not it succeeded:
+// a write that failed part-way may still have changed rows.
+func (r *cachedRepository) invalidate() {
+	r.cache.Clear()
+}
+
+// analyticsResult is a cached analytics read together with the newest
+// incident timestamp it covers, so data_as_of is served from the same entry
+// as the result it describes.
+type analyticsResult[V any] struct {
+	value  V
+	latest *time.Time
+}
+
+func (r *cachedRepository) CalculateMTBF(ctx context.Context, includeDeleted bool) ([]MTBFResult, *time.Time, error) {
+	res, err := cachedRead(r, "CalculateMTBF", fmt.Sprint(includeDeleted), func() (analyticsResult[[]MTBFResult], error) {
+		results, latest, err := r.RepositoryInterface.CalculateMTBF(ctx, includeDeleted)
+		return analyticsResult[[]MTBFResult]{results, latest}, err
+	})
+	return res.value, res.latest, err
+}
+
+func (r *cachedRepository) GetTopBreakdownsByLine(ctx context.Context, limit int32, includeDeleted bool) ([]BreakdownCount, *time.Time, error) {
+	res, err := cachedRead(r, "GetTopBreakdownsByLine", fmt.Sprint(limit, includeDeleted), func() (analyticsResult[[]BreakdownCount], error) {
+		results, latest, err := r.RepositoryInterface.GetTopBreakdownsByLine(ctx, limit, includeDeleted)
+		return analyticsResult[[]BreakdownCount]{results, latest}, err
+	})
+	return res.value, res.latest, err
+}
+
+func (r *cachedRepository) GetTopBreakdownsByStation(ctx context.Context, limit int32, lineID *uuid.UUID, includeDeleted bool) ([]BreakdownCount, *time.Time, error) {
+	key := fmt.Sprint(limit, includeDeleted)
+	if lineID != nil {
+		key += "|" + lineID.String()
+	}
+	res, err := cachedRead(r, "GetTopBreakdownsByStation", key, func() (analyticsResult[[]BreakdownCount], error) {
+		results, latest, err := r.RepositoryInterface.GetTopBreakdownsByStation(ctx, limit, lineID, includeDeleted)
+		return analyticsResult[[]BreakdownCount]{results, latest}, err
+	})
+	return res.value, res.latest, err
+}
+
+func (r *cachedRepository) CreateLine(ctx context.Context, name string, attrs LineAttributes) (*Line, error) {
+	defer r.invalidate()
+	return r.RepositoryInterface.CreateLine(ctx, name, attrs)
+}
+
+func (r *cachedRepository) GetOrCreateLine(ctx context.Context, name string) (*Line, bool, error) {
+	defer r.invalidate()
+	return r.RepositoryInterface.GetOrCreateLine(ctx, name)
+}
+
+func (r *cachedRepository) UpdateLine(ctx context.Context, id uuid.UUID, name string, attrs LineAttributes) (*Line, error) {
+	defer r.invalidate()
+	return r.RepositoryInterface.UpdateLine(ctx, id, name, attrs)
+}
+
+func (r *cachedRepository) DeleteLine(ctx context.Context, id uuid.UUID) error {
+	defer r.invalidate()
+	return r.RepositoryInterface.DeleteLine(ctx, id)
+}
+
+func (r *cachedRepository) CreateStation(ctx context.Context, name string, lineID uuid.UUID, status string, additionalLineIDs []uuid.UUID, coords *StationCoordinates) (*StationWithLine, error) {
+	defer r.invalidate()
+	return r.RepositoryInterface.CreateStation(ctx, name, lineID, status, additionalLineIDs, coords)
+}
+
+func (r *cachedRepository) GetOrCreateStation(ctx context.Context, name string, lineID uuid.UUID) (*Station, bool, error) {
+	defer r.invalidate()
+	return r.RepositoryInterface.GetOrCreateStation(ctx, name, lineID)
+}
+
+func (r *cachedRepository) UpdateStation(ctx context.Context, id uuid.UUID, name, status *string, coords *StationCoordinates) (*StationWithLine, error) {
+	defer r.invalidate()
+	return r.RepositoryInterface.UpdateStation(ctx, id, name, status, coords)
+}
+
+func (r *cachedRepository) DeleteStation(ctx context.Context, id uuid.UUID, force bool) error {
+	defer r.invalidate()
+	return r.RepositoryInterface.DeleteStation(ctx, id, force)
+}
+
+func (r *cachedRepository) MergeStations(ctx context.Context, sourceID, targetID uuid.UUID, force bool) (int64, error) {
+	defer r.invalidate()
+	return r.RepositoryInterface.MergeStations(ctx, sourceID, targetID, force)
+}
+
+func (r *cachedRepository) CreateIncident(ctx context.Context, stationID, lineID uuid.UUID, ts time.Time, durationMinutes int32, incidentType, status, source string) (*Incident, error) {
+	defer r.invalidate()
+	return r.RepositoryInterface.CreateIncident(ctx, stationID, lineID, ts, durationMinutes, incidentType, status, source)
+}
+
+func (r *cachedRepository) CreateIncidentWithOptions(ctx context.Context, stationID, lineID uuid.UUID, ts time.Time, durationMinutes int32, incidentType, status, source string, opts IncidentCreateOptions) (*IncidentCreateResult, error) {
+	defer r.invalidate()
+	return r.RepositoryInterface.CreateIncidentWithOptions(ctx, stationID, lineID, ts, durationMinutes, incidentType, status, source, opts)
+}
+
+func (r *cachedRepository) UpdateIncidentStatus(ctx context.Context, id uuid.UUID, status, action string) (*IncidentWithDetails, error) {
+	defer r.invalidate()
+	return r.RepositoryInterface.UpdateIncidentStatus(ctx, id, status, action)
+}
+
+func (r *cachedRepository) UpdateIncident(ctx context.Context, id uuid.UUID, durationMinutes *int32, incidentType *string) (*IncidentWithDetails, error) {
+	defer r.invalidate()
+	return r.RepositoryInterface.UpdateIncident(ctx, id, durationMinutes, incidentType)
+}
+
+func (r *cachedRepository) ReassignIncident(ctx context.Context, id, lineID uuid.UUID) (*IncidentWithDetails, error) {
+	defer r.invalidate()
+	return r.RepositoryInterface.ReassignIncident(ctx, id, lineID)
+}
+
+func (r *cachedRepository) DeleteIncident(ctx context.Context, id uuid.UUID) error {
+	defer r.invalidate()
+	return r.RepositoryInterface.DeleteIncident(ctx, id)
+}
+
+func (r *cachedRepository) PurgeIncidentsBefore(ctx context.Context, cutoff time.Time, batchSize int) (int64, error) {
+	defer r.invalidate()
+	return r.RepositoryInterface.PurgeIncidentsBefore(ctx, cutoff, batchSize)
+}
@@ -0,0 +1,191 @@
+package backend
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCachedRepository_CachesUntilIncidentWrite(t *testing.T) {
+	mockRepo := &MockRepository{}
+	repo := newCachedRepository(mockRepo, time.Minute)
+	ctx := context.Background()
+
+	calls := 0
+	mockRepo.CalculateMTBFFn = func(ctx context.Context, includeDeleted bool) ([]MTBFResult, *time.Time, error) {
+		calls++
+		return []MTBFResult{{LineName: "Circle Line", MTBFMinutes: float64(calls)}}, nil, nil
+	}
+	mockRepo.CreateIncidentFn = func(ctx context.Context, stationID, lineID uuid.UUID, ts time.Time, durationMinutes int32, incidentType, status, source string) (*Incident, error) {
+		return &Incident{ID: uuid.New()}, nil
+	}
+
+	hits := analyticsCacheHits.Load()
+
+	for i := 0; i < 2; i++ {
+		results, _, err := repo.CalculateMTBF(ctx, false)
+		require.NoError(t, err)
+		assert.Equal(t, 1.0, results[0].MTBFMinutes)
+	}
+	assert.Equal(t, 1, calls)
+	assert.Equal(t, hits+1, analyticsCacheHits.Load())
+
+	_, err := repo.CreateIncident(ctx, uuid.New(), uuid.New(), time.Now(), 10, "signal", "open", "")
+	require.NoError(t, err)
+
+	results, _, err := repo.CalculateMTBF(ctx, false)
+	require.NoError(t, err)
+	assert.Equal(t, 2.0, results[0].MTBFMinutes, "write should invalidate the cache")
+}
+
+func TestCachedRepository_InvalidatesOnLineAndStationCreate(t *testing.T) {
+	mockRepo := &MockRepository{}
+	repo := newCachedRepository(mockRepo, time.Minute)
+	ctx := context.Background()
+
+	calls := 0
+	mockRepo.GetTopBreakdownsByLineFn = func(ctx context.Context, limit int32, includeDeleted bool) ([]BreakdownCount, *time.Time, error) {
+		calls++
+		return nil, nil, nil
+	}
+	mockRepo.CreateLineFn = func(ctx context.Context, name string, attrs LineAttributes) (*Line, error) {
+		return &Line{ID: uuid.New(), Name: name}, nil
+	}
+	mockRepo.GetOrCreateLineFn = func(ctx context.Context, name string) (*Line, bool, error) {
+		return &Line{ID: uuid.New(), Name: name}, true, nil
+	}
+	mockRepo.CreateStationFn = func(ctx context.Context, name string, lineID uuid.UUID, status string, additionalLineIDs []uuid.UUID, coords *StationCoordinates) (*StationWithLine, error) {
+		return &StationWithLine{}, nil
+	}
+	mockRepo.GetOrCreateStationFn = func(ctx context.Context, name string, lineID uuid.UUID) (*Station, bool, error) {
+		return &Station{ID: uuid.New(), Name: name, LineID: lineID}, true, nil
+	}
+
+	writes := map[string]func() error{
+		"CreateLine": func() error {
+			_, err := repo.CreateLine(ctx, "New Line", LineAttributes{})
+			return err
+		},
+		"GetOrCreateLine": func() error {
+			_, _, err := repo.GetOrCreateLine(ctx, "New Line")
+			return err
+		},
+		"CreateStation": func() error {
+			_, err := repo.CreateStation(ctx, "New Station", uuid.New(), "active", nil, nil)
+			return err
+		},
+		"GetOrCreateStation": func() error {
+			_, _, err := repo.GetOrCreateStation(ctx, "New Station", uuid.New())
+			return err
+		},
+	}
+	for name, write := range writes {
+		_, _, err := repo.GetTopBreakdownsByLine(ctx, 10, false)
+		require.NoError(t, err)
+		calls = 0
+		require.NoError(t, write())
+		_, _, err = repo.GetTopBreakdownsByLine(ctx, 10, false)
+		require.NoError(t, err)
+		assert.Equal(t, 1, calls, "%s should invalidate the cache", name)
+	}
+}
+
+func TestCachedRepository_KeysByParameters(t *testing.T) {
+	mockRepo := &MockRepository{}
+	repo := newCachedRepository(mockRepo, time.Minute)
+	ctx := context.Background()
+
+	lineID := uuid.New()
+	var calls []string
+	mockRepo.GetTopBreakdownsByStationFn = func(ctx context.Context, limit int32, lid *uuid.UUID, includeDeleted bool) ([]BreakdownCount, *time.Time, error) {
+		key := "all"
+		if lid != nil {
+			key = lid.String()
+		}
+		calls = append(calls, key)
+		return []BreakdownCount{{Name: key, Count: limit}}, nil, nil
+	}
+
+	for _, lid := range []*uuid.UUID{nil, &lineID, nil, &lineID} {
+		results, _, err := repo.GetTopBreakdownsByStation(ctx, 5, lid, false)
+		require.NoError(t, err)
+		require.Len(t, results, 1)
+	}
+	_, _, err := repo.GetTopBreakdownsByStation(ctx, 10, nil, false)
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"all", lineID.String(), "all"}, calls)
+}
+
+func TestCachedRepository_DoesNotCacheErrors(t *testing.T) {
+	mockRepo := &MockRepository{}
+	repo := newCachedRepository(mockRepo, time.Minute)
+	ctx := context.Background()
+
+	calls := 0
+	mockRepo.GetTopBreakdownsByLineFn = func(ctx context.Context, limit int32, includeDeleted bool) ([]BreakdownCount, *time.Time, error) {
+		calls++
+		if calls == 1 {
+			return nil, nil, ErrDatabaseError
+		}
+		return []BreakdownCount{{Name: "Circle Line", Count: 3}}, nil, nil
+	}
+
+	_, _, err := repo.GetTopBreakdownsByLine(ctx, 5, false)
+	require.ErrorIs(t, err, ErrDatabaseError)
+
+	results, _, err := repo.GetTopBreakdownsByLine(ctx, 5, false)
+	require.NoError(t, err)
+	assert.Len(t, results, 1)
+	assert.Equal(t, 2, calls)
+}
+
+func TestNewCachedRepository_DisabledWithoutTTL(t *testing.T) {
+	mockRepo := &MockRepository{}
+	assert.Same(t, mockRepo, newCachedRepository(mockRepo, 0))
+}
+
+func TestCachedRepository_KeysByIncludeDeleted(t *testing.T) {
+	mockRepo := &MockRepository{}
+	repo := newCachedRepository(mockRepo, time.Minute)
+	ctx := context.Background()
+
+	calls := 0
+	mockRepo.CalculateMTBFFn = func(ctx context.Context, includeDeleted bool) ([]MTBFResult, *time.Time, error) {
+		calls++
+		return []MTBFResult{{LineName: fmt.Sprint(includeDeleted)}}, nil, nil
+	}
+
+	for _, includeDeleted := range []bool{false, true, false, true} {
+		results, _, err := repo.CalculateMTBF(ctx, includeDeleted)
+		require.NoError(t, err)
+		assert.Equal(t, fmt.Sprint(includeDeleted), results[0].LineName)
+	}
+	assert.Equal(t, 2, calls)
+}
+
+func TestCachedRepository_CachesDataAsOfWithResult(t *testing.T) {
+	mockRepo := &MockRepository{}
+	repo := newCachedRepository(mockRepo, time.Minute)
+	ctx := context.Background()
+
+	latest := time.Date(2024, 3, 1, 8, 30, 0, 0, time.UTC)
+	mockRepo.GetTopBreakdownsByLineFn = func(ctx context.Context, limit int32, includeDeleted bool) ([]BreakdownCount, *time.Time, error) {
+		newest := latest
+		latest = latest.Add(time.Hour)
+		return []BreakdownCount{{Name: "Circle Line", Count: 1}}, &newest, nil
+	}
+
+	_, first, err := repo.GetTopBreakdownsByLine(ctx, 10, false)
+	require.NoError(t, err)
+	_, second, err := repo.GetTopBreakdownsByLine(ctx, 10, false)
+	require.NoError(t, err)
+
+	require.NotNil(t, first)
+	assert.Equal(t, first, second, "a cached result must keep the data_as_of it was computed with")
+}
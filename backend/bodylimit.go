@@ -0,0 +1,84 @@
+package backend
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// MaxBodySize rejects gateway requests whose body exceeds limit bytes with
+// 413 Request Entity Too Large. The body is buffered up to the limit before
+// the request reaches the gateway, so oversized uploads are never decoded.
+// A non-positive limit disables the check.
+func MaxBodySize(limit int64) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		if limit <= 0 {
+			return next
+		}
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.ContentLength > limit {
+				http.Error(w, "request body too large", http.StatusRequestEntityTooLarge)
+				return
+			}
+			if r.Body == nil || r.Body == http.NoBody {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, limit))
+			if err != nil {
+				var tooLarge *http.MaxBytesError
+				if errors.As(err, &tooLarge) {
+					http.Error(w, "request body too large", http.StatusRequestEntityTooLarge)
+					return
+				}
+				http.Error(w, "failed to read request body", http.StatusBadRequest)
+				return
+			}
+			r.Body = io.NopCloser(bytes.NewReader(body))
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// WithMaxRequestSize returns a copy of desc whose unary methods reject
+// requests larger than limit bytes with codes.ResourceExhausted. ColdBrew
+// builds the gRPC server itself and does not accept grpc.MaxRecvMsgSize, so
+// the limit is checked after decoding; grpc-go's own 4 MiB transport limit
+// still bounds what can be received. A non-positive limit disables the check.
+func WithMaxRequestSize(desc *grpc.ServiceDesc, limit int) *grpc.ServiceDesc {
+	if limit <= 0 {
+		return desc
+	}
+
+	wrapped := *desc
+	wrapped.Methods = make([]grpc.MethodDesc, len(desc.Methods))
+	for i, method := range desc.Methods {
+		handler := method.Handler
+		wrapped.Methods[i] = grpc.MethodDesc{
+			MethodName: method.MethodName,
+			Handler: func(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+				limited := func(v interface{}) error {
+					if err := dec(v); err != nil {
+						return err
+					}
+					if msg, ok := v.(proto.Message); ok {
+						if size := proto.Size(msg); size > limit {
+							return status.Errorf(codes.ResourceExhausted, "request message larger than max (%d vs. %d)", size, limit)
+						}
+					}
+					return nil
+				}
+				return handler(srv, ctx, limited, interceptor)
+			},
+		}
+	}
+	return &wrapped
+}
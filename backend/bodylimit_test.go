@@ -0,0 +1,91 @@
+package backend
+
+import (
+	"context"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+
+	pb "github.com/bluesg/transport-analytics/proto"
+)
+
+func TestMaxBodySize(t *testing.T) {
+	var received string
+	handler := MaxBodySize(16)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		received = string(body)
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	tests := []struct {
+		name          string
+		body          string
+		contentLength int64
+		wantCode      int
+	}{
+		{"within limit", `{"name":"CCL"}`, -1, http.StatusOK},
+		{"declared too large", strings.Repeat("x", 17), 17, http.StatusRequestEntityTooLarge},
+		{"chunked too large", strings.Repeat("x", 17), -1, http.StatusRequestEntityTooLarge},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			received = ""
+			req := httptest.NewRequest(http.MethodPost, "/lines", strings.NewReader(tt.body))
+			req.ContentLength = tt.contentLength
+			rec := httptest.NewRecorder()
+
+			handler.ServeHTTP(rec, req)
+
+			assert.Equal(t, tt.wantCode, rec.Code)
+			if tt.wantCode == http.StatusOK {
+				assert.Equal(t, tt.body, received)
+			} else {
+				assert.Empty(t, received)
+			}
+		})
+	}
+}
+
+func TestWithMaxRequestSize(t *testing.T) {
+	service, mockRepo := setupServiceWithMock()
+
+	listener := bufconn.Listen(1024 * 1024)
+	server := grpc.NewServer()
+	server.RegisterService(WithMaxRequestSize(&pb.TransportAnalytics_ServiceDesc, 64), service)
+	go func() { _ = server.Serve(listener) }()
+	defer server.Stop()
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	defer conn.Close()
+	client := pb.NewTransportAnalyticsClient(conn)
+	ctx := context.Background()
+
+	mockRepo.CreateLineFn = func(ctx context.Context, name string, attrs LineAttributes) (*Line, error) {
+		return &Line{Name: name}, nil
+	}
+
+	_, err = client.CreateLine(ctx, &pb.CreateLineRequest{Name: "Circle Line"})
+	require.NoError(t, err)
+
+	_, err = client.CreateLine(ctx, &pb.CreateLineRequest{Name: strings.Repeat("x", 100)})
+	require.Error(t, err)
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+}
@@ -0,0 +1,186 @@
+package backend
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/go-coldbrew/log"
+	"google.golang.org/genproto/googleapis/api/annotations"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+// breakerPingTimeout bounds the health ping that may close an open breaker.
+const breakerPingTimeout = 2 * time.Second
+
+type CircuitBreakerConfig struct {
+	// FailureThreshold is how many consecutive database failures trip the
+	// breaker. Zero disables automatic tripping.
+	FailureThreshold int
+	// Cooldown is how long the breaker stays open before a health ping may
+	// close it again.
+	Cooldown time.Duration
+}
+
+// CircuitBreaker makes write RPCs fail fast while the database is
+// unreachable instead of letting them queue up on a dead connection pool.
+type CircuitBreaker struct {
+	cfg  CircuitBreakerConfig
+	ping func(context.Context) error
+	now  func() time.Time
+
+	mu       sync.Mutex
+	failures int
+	open     bool
+	openedAt time.Time
+	probing  bool
+}
+
+// NewCircuitBreaker returns a closed breaker that uses ping to check whether
+// the database has recovered once the cooldown has passed.
+func NewCircuitBreaker(cfg CircuitBreakerConfig, ping func(context.Context) error) *CircuitBreaker {
+	return &CircuitBreaker{cfg: cfg, ping: ping, now: time.Now}
+}
+
+// Trip opens the breaker.
+func (b *CircuitBreaker) Trip() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.trip()
+}
+
+func (b *CircuitBreaker) trip() {
+	b.open = true
+	b.openedAt = b.now()
+	b.failures = 0
+}
+
+// Reset closes the breaker and clears the failure count.
+func (b *CircuitBreaker) Reset() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.open = false
+	b.failures = 0
+}
+
+// RecordFailure counts a database failure and opens the breaker once
+// FailureThreshold consecutive failures have been seen.
+func (b *CircuitBreaker) RecordFailure() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.open {
+		return
+	}
+	b.failures++
+	if b.cfg.FailureThreshold > 0 && b.failures >= b.cfg.FailureThreshold {
+		log.Warn(context.Background(), "Circuit breaker tripped", "consecutive_failures", b.failures)
+		b.trip()
+	}
+}
+
+// RecordSuccess clears the consecutive failure count.
+func (b *CircuitBreaker) RecordSuccess() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.failures = 0
+}
+
+// Allow reports whether a write may proceed. While the breaker is open it
+// returns false until the cooldown has passed and a health ping succeeds.
+// Only one caller pings at a time; the rest keep failing fast meanwhile.
+func (b *CircuitBreaker) Allow(ctx context.Context) bool {
+	b.mu.Lock()
+	if !b.open {
+		b.mu.Unlock()
+		return true
+	}
+	if b.probing || b.now().Sub(b.openedAt) < b.cfg.Cooldown {
+		b.mu.Unlock()
+		return false
+	}
+	b.probing = true
+	b.mu.Unlock()
+
+	var err error
+	if b.ping != nil {
+		pingCtx, cancel := context.WithTimeout(ctx, breakerPingTimeout)
+		err = b.ping(pingCtx)
+		cancel()
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.probing = false
+	if err != nil {
+		log.Warn(ctx, "Circuit breaker health ping failed", "error", err)
+		b.openedAt = b.now()
+		return false
+	}
+	log.Info(ctx, "Circuit breaker reset after successful health ping")
+	b.open = false
+	b.failures = 0
+	return true
+}
+
+// breakerExempt lists write-bound RPCs that never reach the database and so
+// keep working while the breaker is open.
+var breakerExempt = map[string]bool{
+	"ValidateIncident": true,
+}
+
+// WithCircuitBreaker returns a copy of desc whose write methods, those not
+// mapped to an HTTP GET, fail with codes.Unavailable while breaker is open.
+// Reads are left alone so dashboards keep working from a healthy replica.
+func WithCircuitBreaker(desc *grpc.ServiceDesc, breaker *CircuitBreaker) *grpc.ServiceDesc {
+	if breaker == nil {
+		return desc
+	}
+
+	wrapped := *desc
+	wrapped.Methods = make([]grpc.MethodDesc, len(desc.Methods))
+	for i, method := range desc.Methods {
+		if !isWriteMethod(desc.ServiceName, method.MethodName) || breakerExempt[method.MethodName] {
+			wrapped.Methods[i] = method
+			continue
+		}
+		handler := method.Handler
+		wrapped.Methods[i] = grpc.MethodDesc{
+			MethodName: method.MethodName,
+			Handler: func(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+				return handler(srv, ctx, dec, func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, next grpc.UnaryHandler) (interface{}, error) {
+					guarded := func(ctx context.Context, req interface{}) (interface{}, error) {
+						if !breaker.Allow(ctx) {
+							return nil, status.Error(codes.Unavailable, "database unavailable, try again later")
+						}
+						return next(ctx, req)
+					}
+					if interceptor == nil {
+						return guarded(ctx, req)
+					}
+					return interceptor(ctx, req, info, guarded)
+				})
+			},
+		}
+	}
+	return &wrapped
+}
+
+// isWriteMethod reports whether the RPC's HTTP binding uses a method other
+// than GET.
+func isWriteMethod(service, method string) bool {
+	d, err := protoregistry.GlobalFiles.FindDescriptorByName(protoreflect.FullName(service + "." + method))
+	if err != nil {
+		return false
+	}
+	md, ok := d.(protoreflect.MethodDescriptor)
+	if !ok {
+		return false
+	}
+	rule, ok := proto.GetExtension(md.Options(), annotations.E_Http).(*annotations.HttpRule)
+	return ok && rule != nil && rule.GetGet() == ""
+}
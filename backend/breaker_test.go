@@ -0,0 +1,130 @@
+package backend
+
+import (
+	"context"
+	"errors"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+
+	pb "github.com/bluesg/transport-analytics/proto"
+)
+
+func TestCircuitBreaker_TripsAndResets(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2024, 1, 15, 8, 0, 0, 0, time.UTC)
+	pingErr := errors.New("connection refused")
+	pings := 0
+
+	breaker := NewCircuitBreaker(CircuitBreakerConfig{FailureThreshold: 3, Cooldown: 30 * time.Second}, func(context.Context) error {
+		pings++
+		return pingErr
+	})
+	breaker.now = func() time.Time { return now }
+
+	breaker.RecordFailure()
+	breaker.RecordFailure()
+	breaker.RecordSuccess()
+	breaker.RecordFailure()
+	breaker.RecordFailure()
+	assert.True(t, breaker.Allow(ctx), "failures were not consecutive")
+
+	breaker.RecordFailure()
+	assert.False(t, breaker.Allow(ctx))
+	assert.Equal(t, 0, pings, "no ping before the cooldown")
+
+	now = now.Add(31 * time.Second)
+	assert.False(t, breaker.Allow(ctx))
+	assert.Equal(t, 1, pings)
+	assert.False(t, breaker.Allow(ctx), "failed ping restarts the cooldown")
+	assert.Equal(t, 1, pings)
+
+	now = now.Add(31 * time.Second)
+	pingErr = nil
+	assert.True(t, breaker.Allow(ctx))
+	assert.Equal(t, 2, pings)
+	assert.True(t, breaker.Allow(ctx))
+}
+
+func TestCircuitBreaker_ManualTrip(t *testing.T) {
+	breaker := NewCircuitBreaker(CircuitBreakerConfig{Cooldown: time.Minute}, nil)
+
+	for i := 0; i < 10; i++ {
+		breaker.RecordFailure()
+	}
+	assert.True(t, breaker.Allow(context.Background()), "zero threshold never trips automatically")
+
+	breaker.Trip()
+	assert.False(t, breaker.Allow(context.Background()))
+
+	breaker.Reset()
+	assert.True(t, breaker.Allow(context.Background()))
+}
+
+func TestIsWriteMethod(t *testing.T) {
+	service := pb.TransportAnalytics_ServiceDesc.ServiceName
+	assert.True(t, isWriteMethod(service, "CreateIncident"))
+	assert.True(t, isWriteMethod(service, "UpdateStation"))
+	assert.True(t, isWriteMethod(service, "DeleteLine"))
+	assert.False(t, isWriteMethod(service, "ListLines"))
+	assert.False(t, isWriteMethod(service, "GetMTBF"))
+	assert.False(t, isWriteMethod(service, "NoSuchMethod"))
+}
+
+func TestWithCircuitBreaker(t *testing.T) {
+	service, mockRepo := setupServiceWithMock()
+	breaker := NewCircuitBreaker(CircuitBreakerConfig{Cooldown: time.Hour}, nil)
+
+	listener := bufconn.Listen(1024 * 1024)
+	server := grpc.NewServer()
+	server.RegisterService(WithCircuitBreaker(&pb.TransportAnalytics_ServiceDesc, breaker), service)
+	go func() { _ = server.Serve(listener) }()
+	defer server.Stop()
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	defer conn.Close()
+	client := pb.NewTransportAnalyticsClient(conn)
+	ctx := context.Background()
+
+	mockRepo.CreateLineFn = func(ctx context.Context, name string, attrs LineAttributes) (*Line, error) {
+		return &Line{Name: name}, nil
+	}
+	mockRepo.ListLinesFn = func(ctx context.Context, nameContains string, includeStats bool, order LineSort) ([]Line, error) {
+		return []Line{{Name: "Circle Line"}}, nil
+	}
+
+	_, err = client.CreateLine(ctx, &pb.CreateLineRequest{Name: "Circle Line"})
+	require.NoError(t, err)
+
+	breaker.Trip()
+
+	_, err = client.CreateLine(ctx, &pb.CreateLineRequest{Name: "Circle Line"})
+	require.Error(t, err)
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+
+	resp, err := client.ListLines(ctx, &pb.ListLinesRequest{})
+	require.NoError(t, err)
+	assert.Len(t, resp.Lines, 1)
+
+	validation, err := client.ValidateIncident(ctx, &pb.CreateIncidentRequest{})
+	require.NoError(t, err)
+	assert.False(t, validation.Valid)
+
+	breaker.Reset()
+
+	_, err = client.CreateLine(ctx, &pb.CreateLineRequest{Name: "Circle Line"})
+	require.NoError(t, err)
+}
@@ -0,0 +1,111 @@
+package backend
+
+import (
+	"sync"
+	"time"
+)
+
+// ttlCache is a small in-memory cache whose entries expire ttl after they
+// were stored. A nil *ttlCache is valid and caches nothing, so callers need
+// not check whether caching is enabled.
+type ttlCache[V any] struct {
+	ttl time.Duration
+	now func() time.Time
+
+	mu      sync.Mutex
+	entries map[string]ttlEntry[V]
+	// generation is bumped by Clear so SetIfCurrent can drop values loaded
+	// before the cache was invalidated.
+	generation uint64
+}
+
+type ttlEntry[V any] struct {
+	value     V
+	expiresAt time.Time
+}
+
+// newTTLCache returns a cache holding entries for ttl, or nil when ttl is not
+// positive.
+func newTTLCache[V any](ttl time.Duration) *ttlCache[V] {
+	if ttl <= 0 {
+		return nil
+	}
+	return &ttlCache[V]{
+		ttl:     ttl,
+		now:     time.Now,
+		entries: make(map[string]ttlEntry[V]),
+	}
+}
+
+// Get returns the value stored under key if it has not expired.
+func (c *ttlCache[V]) Get(key string) (V, bool) {
+	var zero V
+	if c == nil {
+		return zero, false
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	entry, ok := c.entries[key]
+	if !ok || !c.now().Before(entry.expiresAt) {
+		return zero, false
+	}
+	return entry.value, true
+}
+
+// Set stores value under key and drops any entries that have expired, which
+// keeps the map bounded by the number of distinct keys seen within one ttl.
+func (c *ttlCache[V]) Set(key string, value V) {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.set(key, value)
+}
+
+// Generation returns a token for SetIfCurrent, to be taken before loading
+// the value that will be stored.
+func (c *ttlCache[V]) Generation() uint64 {
+	if c == nil {
+		return 0
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.generation
+}
+
+// SetIfCurrent stores value under key unless Clear has been called since
+// generation was taken, in which case value may predate the change that
+// caused the Clear and is discarded.
+func (c *ttlCache[V]) SetIfCurrent(generation uint64, key string, value V) {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if generation != c.generation {
+		return
+	}
+	c.set(key, value)
+}
+
+// Clear drops every entry.
+func (c *ttlCache[V]) Clear() {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.generation++
+	clear(c.entries)
+}
+
+func (c *ttlCache[V]) set(key string, value V) {
+	now := c.now()
+	for k, entry := range c.entries {
+		if !now.Before(entry.expiresAt) {
+			delete(c.entries, k)
+		}
+	}
+	c.entries[key] = ttlEntry[V]{value: value, expiresAt: now.Add(c.ttl)}
+}
@@ -0,0 +1,54 @@
+package backend
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTTLCache_ExpiresEntries(t *testing.T) {
+	now := time.Date(2024, 1, 15, 8, 0, 0, 0, time.UTC)
+	cache := newTTLCache[int](30 * time.Second)
+	cache.now = func() time.Time { return now }
+
+	_, ok := cache.Get("a")
+	assert.False(t, ok)
+
+	cache.Set("a", 1)
+	value, ok := cache.Get("a")
+	assert.True(t, ok)
+	assert.Equal(t, 1, value)
+
+	now = now.Add(30 * time.Second)
+	_, ok = cache.Get("a")
+	assert.False(t, ok, "entry should expire after the ttl")
+
+	cache.Set("b", 2)
+	assert.NotContains(t, cache.entries, "a", "expired entries are dropped on Set")
+	assert.Contains(t, cache.entries, "b")
+}
+
+func TestTTLCache_DisabledWhenTTLNotPositive(t *testing.T) {
+	cache := newTTLCache[int](0)
+	assert.Nil(t, cache)
+
+	cache.Set("a", 1)
+	_, ok := cache.Get("a")
+	assert.False(t, ok)
+}
+
+func TestTTLCache_SetIfCurrentSkipsAfterClear(t *testing.T) {
+	cache := newTTLCache[int](time.Minute)
+
+	generation := cache.Generation()
+	cache.Clear()
+	cache.SetIfCurrent(generation, "a", 1)
+	_, ok := cache.Get("a")
+	assert.False(t, ok, "value loaded before Clear must not be stored")
+
+	cache.SetIfCurrent(cache.Generation(), "a", 2)
+	value, ok := cache.Get("a")
+	assert.True(t, ok)
+	assert.Equal(t, 2, value)
+}
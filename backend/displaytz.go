@@ -0,0 +1,94 @@
+package backend
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	pb "github.com/bluesg/transport-analytics/proto"
+)
+
+// DisplayTimezoneHeader names the zone used for the *_label fields of a
+// gateway response.
+const DisplayTimezoneHeader = "X-Display-Timezone"
+
+type displayLocationKey struct{}
+
+// DisplayTimezone resolves the zone gateway responses format their period
+// labels in: the IANA name in the tz query parameter, or def when it is
+// absent. An unknown zone is rejected with 400 Bad Request. The zone is
+// echoed in the X-Display-Timezone header and stored on the request context
+// for FormatDisplayLabels.
+func DisplayTimezone(def *time.Location) func(http.Handler) http.Handler {
+	if def == nil {
+		def = time.UTC
+	}
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			loc := def
+			if tz := strings.TrimSpace(r.URL.Query().Get("tz")); tz != "" {
+				var err error
+				loc, err = time.LoadLocation(tz)
+				if err != nil {
+					http.Error(w, "invalid tz: "+tz, http.StatusBadRequest)
+					return
+				}
+			}
+
+			w.Header().Set(DisplayTimezoneHeader, loc.String())
+			next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), displayLocationKey{}, loc)))
+		})
+	}
+}
+
+// FormatDisplayLabels is a grpc-gateway forward response option that
+// rewrites the period labels of analytics responses in the zone chosen by
+// DisplayTimezone. Every response with period timestamps carries labels:
+// rolling average, cumulative incidents, busiest period and longest quiet
+// period. The protobuf timestamps themselves stay in UTC.
+func FormatDisplayLabels(ctx context.Context, _ http.ResponseWriter, msg proto.Message) error {
+	loc, ok := ctx.Value(displayLocationKey{}).(*time.Location)
+	if !ok {
+		return nil
+	}
+
+	switch m := msg.(type) {
+	case *pb.RollingAverageResponse:
+		for _, item := range m.Items {
+			if item.Day != nil {
+				item.DayLabel = displayLabel(item.Day.AsTime(), loc)
+			}
+		}
+	case *pb.CumulativeIncidentsResponse:
+		for _, item := range m.Items {
+			if item.Day != nil {
+				item.DayLabel = displayLabel(item.Day.AsTime(), loc)
+			}
+		}
+	case *pb.BusiestPeriodResponse:
+		if m.WindowStart != nil {
+			m.WindowStartLabel = displayLabel(m.WindowStart.AsTime(), loc)
+		}
+		if m.WindowEnd != nil {
+			m.WindowEndLabel = displayLabel(m.WindowEnd.AsTime(), loc)
+		}
+	case *pb.LongestQuietPeriodResponse:
+		for _, line := range m.Lines {
+			if line.StartTime != nil {
+				line.StartTimeLabel = displayLabel(line.StartTime.AsTime(), loc)
+			}
+			if line.EndTime != nil {
+				line.EndTimeLabel = displayLabel(line.EndTime.AsTime(), loc)
+			}
+		}
+	}
+	return nil
+}
+
+// displayLabel formats t as RFC 3339 in loc.
+func displayLabel(t time.Time, loc *time.Location) string {
+	return t.In(loc).Format(time.RFC3339)
+}
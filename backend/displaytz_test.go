@@ -0,0 +1,103 @@
+package backend
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "github.com/bluesg/transport-analytics/proto"
+)
+
+func TestDisplayTimezone(t *testing.T) {
+	singapore, err := time.LoadLocation("Asia/Singapore")
+	require.NoError(t, err)
+
+	var got *time.Location
+	handler := DisplayTimezone(singapore)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got, _ = r.Context().Value(displayLocationKey{}).(*time.Location)
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	tests := []struct {
+		name       string
+		target     string
+		wantCode   int
+		wantHeader string
+	}{
+		{"default zone", "/analytics/busiest_period", http.StatusOK, "Asia/Singapore"},
+		{"tz parameter", "/analytics/busiest_period?tz=Europe/London", http.StatusOK, "Europe/London"},
+		{"unknown zone", "/analytics/busiest_period?tz=Mars/Olympus", http.StatusBadRequest, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got = nil
+			rec := httptest.NewRecorder()
+
+			handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, tt.target, nil))
+
+			assert.Equal(t, tt.wantCode, rec.Code)
+			assert.Equal(t, tt.wantHeader, rec.Header().Get(DisplayTimezoneHeader))
+			if tt.wantCode == http.StatusOK {
+				require.NotNil(t, got)
+				assert.Equal(t, tt.wantHeader, got.String())
+			} else {
+				assert.Nil(t, got)
+			}
+		})
+	}
+}
+
+func TestFormatDisplayLabels(t *testing.T) {
+	singapore, err := time.LoadLocation("Asia/Singapore")
+	require.NoError(t, err)
+	ctx := context.WithValue(context.Background(), displayLocationKey{}, singapore)
+
+	start := time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC)
+	busiest := &pb.BusiestPeriodResponse{
+		WindowStart: timestamppb.New(start),
+		WindowEnd:   timestamppb.New(start.Add(time.Hour)),
+	}
+	rolling := &pb.RollingAverageResponse{
+		Items: []*pb.RollingAverageItem{{Day: timestamppb.New(start)}},
+	}
+	cumulative := &pb.CumulativeIncidentsResponse{
+		Items: []*pb.CumulativeIncidentsItem{{Day: timestamppb.New(start)}},
+	}
+	quiet := &pb.LongestQuietPeriodResponse{
+		Lines: []*pb.QuietPeriodLineItem{{
+			StartTime: timestamppb.New(start),
+			EndTime:   timestamppb.New(start.Add(2 * time.Hour)),
+		}},
+	}
+
+	for _, msg := range []proto.Message{busiest, rolling, cumulative, quiet} {
+		require.NoError(t, FormatDisplayLabels(ctx, httptest.NewRecorder(), msg))
+	}
+
+	assert.Equal(t, "2024-01-15T08:00:00+08:00", busiest.WindowStartLabel)
+	assert.Equal(t, "2024-01-15T09:00:00+08:00", busiest.WindowEndLabel)
+	assert.Equal(t, start, busiest.WindowStart.AsTime(), "timestamps stay in UTC")
+	assert.Equal(t, "2024-01-15T08:00:00+08:00", rolling.Items[0].DayLabel)
+	assert.Equal(t, "2024-01-15T08:00:00+08:00", cumulative.Items[0].DayLabel)
+	assert.Equal(t, "2024-01-15T08:00:00+08:00", quiet.Lines[0].StartTimeLabel)
+	assert.Equal(t, "2024-01-15T10:00:00+08:00", quiet.Lines[0].EndTimeLabel)
+}
+
+func TestFormatDisplayLabels_NoZone(t *testing.T) {
+	resp := &pb.BusiestPeriodResponse{
+		WindowStart:      timestamppb.New(time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC)),
+		WindowStartLabel: "2024-01-15T00:00:00Z",
+	}
+
+	require.NoError(t, FormatDisplayLabels(context.Background(), httptest.NewRecorder(), resp))
+
+	assert.Equal(t, "2024-01-15T00:00:00Z", resp.WindowStartLabel)
+}
@@ -0,0 +1,94 @@
+package backend
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+
+	pb "github.com/bluesg/transport-analytics/proto"
+)
+
+// SetETag is a grpc-gateway forward response option that adds an ETag header
+// to GetLine and GetStation responses, derived from the serialized message.
+func SetETag(_ context.Context, w http.ResponseWriter, msg proto.Message) error {
+	switch msg.(type) {
+	case *pb.LineResponse, *pb.StationResponse:
+	default:
+		return nil
+	}
+
+	data, err := proto.MarshalOptions{Deterministic: true}.Marshal(msg)
+	if err != nil {
+		return err
+	}
+	sum := sha256.Sum256(data)
+	w.Header().Set("ETag", `"`+hex.EncodeToString(sum[:16])+`"`)
+	return nil
+}
+
+// ConditionalGET answers GET requests with 304 Not Modified when the ETag set
+// by SetETag matches the request's If-None-Match header.
+func ConditionalGET(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ifNoneMatch := r.Header.Get("If-None-Match")
+		if r.Method != http.MethodGet || ifNoneMatch == "" {
+			next.ServeHTTP(w, r)
+			return
+		}
+		next.ServeHTTP(&conditionalWriter{ResponseWriter: w, ifNoneMatch: ifNoneMatch}, r)
+	})
+}
+
+type conditionalWriter struct {
+	http.ResponseWriter
+	ifNoneMatch string
+	wroteHeader bool
+	notModified bool
+}
+
+func (w *conditionalWriter) WriteHeader(code int) {
+	if w.wroteHeader {
+		return
+	}
+	w.wroteHeader = true
+
+	etag := w.Header().Get("ETag")
+	if code == http.StatusOK && etag != "" && etagMatches(w.ifNoneMatch, etag) {
+		w.notModified = true
+		w.Header().Del("Content-Type")
+		w.Header().Del("Content-Length")
+		w.ResponseWriter.WriteHeader(http.StatusNotModified)
+		return
+	}
+	w.ResponseWriter.WriteHeader(code)
+}
+
+func (w *conditionalWriter) Write(b []byte) (int, error) {
+	if !w.wroteHeader {
+		w.WriteHeader(http.StatusOK)
+	}
+	if w.notModified {
+		return len(b), nil
+	}
+	return w.ResponseWriter.Write(b)
+}
+
+func (w *conditionalWriter) Flush() {
+	if f, ok := w.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+func etagMatches(ifNoneMatch, etag string) bool {
+	for _, candidate := range strings.Split(ifNoneMatch, ",") {
+		candidate = strings.TrimSpace(candidate)
+		if candidate == "*" || strings.TrimPrefix(candidate, "W/") == strings.TrimPrefix(etag, "W/") {
+			return true
+		}
+	}
+	return false
+}
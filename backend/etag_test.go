@@ -0,0 +1,82 @@
+package backend
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	pb "github.com/bluesg/transport-analytics/proto"
+)
+
+func etagHandler(msg proto.Message) http.Handler {
+	return ConditionalGET(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := SetETag(context.Background(), w, msg); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id":"1"}`))
+	}))
+}
+
+func TestSetETag_OnlyLinesAndStations(t *testing.T) {
+	rec := httptest.NewRecorder()
+	require.NoError(t, SetETag(context.Background(), rec, &pb.LineResponse{Id: "1", Name: "Circle Line"}))
+	assert.NotEmpty(t, rec.Header().Get("ETag"))
+
+	rec = httptest.NewRecorder()
+	require.NoError(t, SetETag(context.Background(), rec, &pb.ListLinesResponse{}))
+	assert.Empty(t, rec.Header().Get("ETag"))
+}
+
+func TestSetETag_ChangesWithContent(t *testing.T) {
+	a := httptest.NewRecorder()
+	b := httptest.NewRecorder()
+	require.NoError(t, SetETag(context.Background(), a, &pb.StationResponse{Id: "1", Status: "active"}))
+	require.NoError(t, SetETag(context.Background(), b, &pb.StationResponse{Id: "1", Status: "closed"}))
+
+	assert.NotEqual(t, a.Header().Get("ETag"), b.Header().Get("ETag"))
+}
+
+func TestConditionalGET(t *testing.T) {
+	handler := etagHandler(&pb.LineResponse{Id: "1", Name: "Circle Line"})
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/lines/1", nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+	etag := rec.Header().Get("ETag")
+	require.NotEmpty(t, etag)
+
+	tests := []struct {
+		name        string
+		ifNoneMatch string
+		wantCode    int
+	}{
+		{"matching", etag, http.StatusNotModified},
+		{"weak matching", "W/" + etag, http.StatusNotModified},
+		{"in list", `"other", ` + etag, http.StatusNotModified},
+		{"wildcard", "*", http.StatusNotModified},
+		{"stale", `"stale"`, http.StatusOK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/lines/1", nil)
+			req.Header.Set("If-None-Match", tt.ifNoneMatch)
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, req)
+
+			assert.Equal(t, tt.wantCode, rec.Code)
+			if tt.wantCode == http.StatusNotModified {
+				assert.Empty(t, rec.Body.String())
+			} else {
+				assert.NotEmpty(t, rec.Body.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,70 @@
+package backend
+
+import (
+	"bytes"
+	"io"
+	"mime"
+	"net/http"
+	"strconv"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/utilities"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	pb "github.com/bluesg/transport-analytics/proto"
+)
+
+// FormIncidentPath accepts incident submissions from collectors that can only
+// send application/x-www-form-urlencoded bodies.
+const FormIncidentPath = "/incidents/form"
+
+// FormIncidents lets clients POST a form-encoded CreateIncidentRequest to
+// FormIncidentPath. Form fields use the JSON or proto field names (tags may
+// be repeated) and are re-encoded as JSON for POST /incidents, so the request
+// goes through the same gateway route and validation as a JSON submission.
+// Every other request is passed through unchanged.
+func FormIncidents(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != FormIncidentPath {
+			next.ServeHTTP(w, r)
+			return
+		}
+		if r.Method != http.MethodPost {
+			w.Header().Set("Allow", http.MethodPost)
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		mediaType, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+		if err != nil || mediaType != "application/x-www-form-urlencoded" {
+			http.Error(w, "content type must be application/x-www-form-urlencoded", http.StatusUnsupportedMediaType)
+			return
+		}
+		if err := r.ParseForm(); err != nil {
+			http.Error(w, "failed to parse form body", http.StatusBadRequest)
+			return
+		}
+
+		var req pb.CreateIncidentRequest
+		if err := runtime.PopulateQueryParameters(&req, r.PostForm, utilities.NewDoubleArray(nil)); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		body, err := protojson.Marshal(&req)
+		if err != nil {
+			http.Error(w, "failed to encode request", http.StatusInternalServerError)
+			return
+		}
+
+		r = r.Clone(r.Context())
+		r.URL.Path = "/incidents"
+		r.URL.RawPath = ""
+		r.RequestURI = ""
+		r.Header.Set("Content-Type", "application/json")
+		r.Header.Set("Content-Length", strconv.Itoa(len(body)))
+		r.ContentLength = int64(len(body))
+		r.Body = io.NopCloser(bytes.NewReader(body))
+		r.Form = nil
+		r.PostForm = nil
+		next.ServeHTTP(w, r)
+	})
+}
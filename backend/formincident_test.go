@@ -0,0 +1,109 @@
+package backend
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	pb "github.com/bluesg/transport-analytics/proto"
+)
+
+func TestFormIncidents_ConvertsToJSON(t *testing.T) {
+	var gotPath, gotContentType string
+	var got pb.CreateIncidentRequest
+	handler := FormIncidents(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotPath = r.URL.Path
+		gotContentType = r.Header.Get("Content-Type")
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		assert.Equal(t, int64(len(body)), r.ContentLength)
+		require.NoError(t, protojson.Unmarshal(body, &got))
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	form := url.Values{
+		"line":             {"Circle Line"},
+		"station":          {"Bishan"},
+		"timestamp":        {"2024-01-15T08:30:00Z"},
+		"duration_minutes": {"15"},
+		"incidentType":     {"signal"},
+		"tags":             {"rain-related", "peak-hour"},
+		"dry_run":          {"true"},
+	}
+	req := httptest.NewRequest(http.MethodPost, FormIncidentPath, strings.NewReader(form.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded; charset=utf-8")
+	rec := httptest.NewRecorder()
+
+	handler.ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "/incidents", gotPath)
+	assert.Equal(t, "application/json", gotContentType)
+	assert.Equal(t, "Circle Line", got.Line)
+	assert.Equal(t, "Bishan", got.Station)
+	assert.Equal(t, time.Date(2024, 1, 15, 8, 30, 0, 0, time.UTC), got.Timestamp.AsTime())
+	assert.Equal(t, int32(15), got.DurationMinutes)
+	assert.Equal(t, "signal", got.IncidentType)
+	assert.Equal(t, []string{"rain-related", "peak-hour"}, got.Tags)
+	assert.True(t, got.DryRun)
+}
+
+func TestFormIncidents_Rejects(t *testing.T) {
+	handler := FormIncidents(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Fatal("request should not reach the gateway")
+	}))
+
+	tests := []struct {
+		name        string
+		method      string
+		contentType string
+		body        string
+		wantCode    int
+	}{
+		{"wrong method", http.MethodGet, "", "", http.StatusMethodNotAllowed},
+		{"json body", http.MethodPost, "application/json", `{"line":"Circle Line"}`, http.StatusUnsupportedMediaType},
+		{"bad number", http.MethodPost, "application/x-www-form-urlencoded", "duration_minutes=ten", http.StatusBadRequest},
+		{"bad timestamp", http.MethodPost, "application/x-www-form-urlencoded", "timestamp=yesterday", http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(tt.method, FormIncidentPath, strings.NewReader(tt.body))
+			if tt.contentType != "" {
+				req.Header.Set("Content-Type", tt.contentType)
+			}
+			rec := httptest.NewRecorder()
+
+			handler.ServeHTTP(rec, req)
+
+			assert.Equal(t, tt.wantCode, rec.Code)
+		})
+	}
+}
+
+func TestFormIncidents_PassesThroughOtherPaths(t *testing.T) {
+	var gotBody string
+	handler := FormIncidents(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		gotBody = string(body)
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	req := httptest.NewRequest(http.MethodPost, "/incidents", strings.NewReader(`{"line":"Circle Line"}`))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+
+	handler.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, `{"line":"Circle Line"}`, gotBody)
+}
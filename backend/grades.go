@@ -0,0 +1,52 @@
+package backend
+
+import "fmt"
+
+// GradeThreshold is the worst record a station may have and still earn Grade.
+type GradeThreshold struct {
+	Grade              string
+	MaxIncidents       int32
+	MaxDowntimeMinutes int32
+}
+
+const failingGrade = "F"
+
+var gradeLetters = []string{"A", "B", "C", "D"}
+
+var defaultGradeThresholds = []GradeThreshold{
+	{Grade: "A", MaxIncidents: 0, MaxDowntimeMinutes: 0},
+	{Grade: "B", MaxIncidents: 2, MaxDowntimeMinutes: 60},
+	{Grade: "C", MaxIncidents: 5, MaxDowntimeMinutes: 180},
+	{Grade: "D", MaxIncidents: 10, MaxDowntimeMinutes: 480},
+}
+
+// NewGradeThresholds builds the A-D thresholds from parallel incident and
+// downtime limits. Stations exceeding the D limits are graded F.
+func NewGradeThresholds(maxIncidents, maxDowntimeMinutes []int32) ([]GradeThreshold, error) {
+	if len(maxIncidents) != len(gradeLetters) || len(maxDowntimeMinutes) != len(gradeLetters) {
+		return nil, fmt.Errorf("expected %d incident and downtime limits, got %d and %d",
+			len(gradeLetters), len(maxIncidents), len(maxDowntimeMinutes))
+	}
+
+	thresholds := make([]GradeThreshold, len(gradeLetters))
+	for i, grade := range gradeLetters {
+		if i > 0 && (maxIncidents[i] < maxIncidents[i-1] || maxDowntimeMinutes[i] < maxDowntimeMinutes[i-1]) {
+			return nil, fmt.Errorf("grade %s limits must not be stricter than grade %s", grade, gradeLetters[i-1])
+		}
+		thresholds[i] = GradeThreshold{
+			Grade:              grade,
+			MaxIncidents:       maxIncidents[i],
+			MaxDowntimeMinutes: maxDowntimeMinutes[i],
+		}
+	}
+	return thresholds, nil
+}
+
+func gradeStation(thresholds []GradeThreshold, incidentCount, downtimeMinutes int32) string {
+	for _, t := range thresholds {
+		if incidentCount <= t.MaxIncidents && downtimeMinutes <= t.MaxDowntimeMinutes {
+			return t.Grade
+		}
+	}
+	return failingGrade
+}
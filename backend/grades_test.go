@@ -0,0 +1,43 @@
+package backend
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGradeStation(t *testing.T) {
+	tests := []struct {
+		name      string
+		incidents int32
+		downtime  int32
+		want      string
+	}{
+		{"no incidents", 0, 0, "A"},
+		{"few short incidents", 2, 45, "B"},
+		{"few incidents long downtime", 2, 120, "C"},
+		{"many incidents", 8, 100, "D"},
+		{"too many incidents", 11, 100, "F"},
+		{"too much downtime", 1, 600, "F"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, gradeStation(defaultGradeThresholds, tt.incidents, tt.downtime))
+		})
+	}
+}
+
+func TestNewGradeThresholds(t *testing.T) {
+	thresholds, err := NewGradeThresholds([]int32{1, 3, 6, 12}, []int32{30, 90, 240, 600})
+	require.NoError(t, err)
+	require.Len(t, thresholds, 4)
+	assert.Equal(t, GradeThreshold{Grade: "C", MaxIncidents: 6, MaxDowntimeMinutes: 240}, thresholds[2])
+
+	_, err = NewGradeThresholds([]int32{1, 3, 6}, []int32{30, 90, 240, 600})
+	assert.Error(t, err)
+
+	_, err = NewGradeThresholds([]int32{1, 3, 2, 12}, []int32{30, 90, 240, 600})
+	assert.Error(t, err)
+}
@@ -4,60 +4,445 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/lib/pq"
 )
 
 type Line struct {
-	ID        uuid.UUID `db:"id"`
-	Name      string    `db:"name"`
-	CreatedAt time.Time `db:"created_at"`
+	ID           uuid.UUID `db:"id" json:"id"`
+	Name         string    `db:"name" json:"name"`
+	Color        *string   `db:"color" json:"color"`
+	DisplayOrder *int32    `db:"display_order" json:"display_order"`
+	CreatedAt    time.Time `db:"created_at" json:"created_at"`
+	// The stats below are only loaded by ListLines with stats.
+	// LastIncidentAt stays nil for a line without incidents, and MTBFMinutes
+	// for a line with fewer than two.
+	IncidentCount   *int32     `db:"incident_count" json:"incident_count,omitempty"`
+	LastIncidentAt  *time.Time `db:"last_incident_at" json:"last_incident_at,omitempty"`
+	DowntimeMinutes *int32     `db:"downtime_minutes" json:"downtime_minutes,omitempty"`
+	MTBFMinutes     *float64   `db:"mtbf_minutes" json:"mtbf_minutes,omitempty"`
+}
+
+// LineSortField selects what ListLines orders by. The zero value keeps the
+// curated order: display_order, then name.
+type LineSortField string
+
+const (
+	LineSortName          LineSortField = "name"
+	LineSortIncidentCount LineSortField = "incident_count"
+	LineSortDowntime      LineSortField = "downtime"
+	LineSortMTBF          LineSortField = "mtbf"
+)
+
+// needsStats reports whether sorting by f requires the per-line stats.
+func (f LineSortField) needsStats() bool {
+	return f == LineSortIncidentCount || f == LineSortDowntime || f == LineSortMTBF
+}
+
+type LineSort struct {
+	By         LineSortField
+	Descending bool
+}
+
+// orderBy returns the ORDER BY clause for the ListLines queries. Lines
+// without an MTBF sort last in either direction, and ties are broken by name.
+func (s LineSort) orderBy() string {
+	dir := "ASC"
+	if s.Descending {
+		dir = "DESC"
+	}
+	switch s.By {
+	case LineSortName:
+		return "l.name " + dir
+	case LineSortIncidentCount:
+		return "incident_count " + dir + ", l.name"
+	case LineSortDowntime:
+		return "downtime_minutes " + dir + ", l.name"
+	case LineSortMTBF:
+		return "mtbf_minutes " + dir + " NULLS LAST, l.name"
+	}
+	return "l.display_order NULLS LAST, l.name"
+}
+
+// LineAttributes holds the optional presentation fields of a line. Nil fields
+// are left unchanged on update; an empty Color clears it.
+type LineAttributes struct {
+	Color        *string `json:"color"`
+	DisplayOrder *int32  `json:"display_order"`
 }
 
 type Station struct {
-	ID        uuid.UUID `db:"id"`
-	Name      string    `db:"name"`
-	LineID    uuid.UUID `db:"line_id"`
-	Status    string    `db:"status"`
-	CreatedAt time.Time `db:"created_at"`
+	ID        uuid.UUID `db:"id" json:"id"`
+	Name      string    `db:"name" json:"name"`
+	LineID    uuid.UUID `db:"line_id" json:"line_id"`
+	Status    string    `db:"status" json:"status"`
+	CreatedAt time.Time `db:"created_at" json:"created_at"`
 }
 
 type Incident struct {
-	ID              uuid.UUID `db:"id"`
-	StationID       uuid.UUID `db:"station_id"`
-	LineID          uuid.UUID `db:"line_id"`
-	Timestamp       time.Time `db:"ts"`
-	DurationMinutes int32     `db:"duration_minutes"`
-	IncidentType    string    `db:"incident_type"`
-	Status          string    `db:"status"`
-	CreatedAt       time.Time `db:"created_at"`
+	ID              uuid.UUID `db:"id" json:"id"`
+	StationID       uuid.UUID `db:"station_id" json:"station_id"`
+	LineID          uuid.UUID `db:"line_id" json:"line_id"`
+	Timestamp       time.Time `db:"ts" json:"timestamp"`
+	DurationMinutes int32     `db:"duration_minutes" json:"duration_minutes"`
+	IncidentType    string    `db:"incident_type" json:"incident_type"`
+	Status          string    `db:"status" json:"status"`
+	Source          *string   `db:"source" json:"source"`
+	CreatedAt       time.Time `db:"created_at" json:"created_at"`
+}
+
+// IncidentCreateOptions holds the writes CreateIncidentWithOptions makes in
+// the same transaction as the incident. Zero fields are skipped.
+type IncidentCreateOptions struct {
+	// StationStatus becomes the status of the incident's station.
+	StationStatus string
+	// Tags are attached to the incident.
+	Tags []string
+}
+
+// IncidentCreateResult is what CreateIncidentWithOptions wrote.
+type IncidentCreateResult struct {
+	Incident *Incident
+	// Tags are every tag now on the incident, sorted by name.
+	Tags []string
+	// StationStatusUpdated reports whether the station status update matched
+	// a row. It is false when no status was requested or the station has been
+	// deleted since it was looked up.
+	StationStatusUpdated bool
 }
 
 type IncidentWithDetails struct {
-	ID              uuid.UUID `db:"id"`
-	StationID       uuid.UUID `db:"station_id"`
-	LineID          uuid.UUID `db:"line_id"`
-	Timestamp       time.Time `db:"ts"`
-	DurationMinutes int32     `db:"duration_minutes"`
-	IncidentType    string    `db:"incident_type"`
-	Status          string    `db:"status"`
-	LineName        string    `db:"line_name"`
-	StationName     string    `db:"station_name"`
+	ID              uuid.UUID      `db:"id" json:"id"`
+	StationID       uuid.UUID      `db:"station_id" json:"station_id"`
+	LineID          uuid.UUID      `db:"line_id" json:"line_id"`
+	Timestamp       time.Time      `db:"ts" json:"timestamp"`
+	DurationMinutes int32          `db:"duration_minutes" json:"duration_minutes"`
+	IncidentType    string         `db:"incident_type" json:"incident_type"`
+	Status          string         `db:"status" json:"status"`
+	Source          *string        `db:"source" json:"source"`
+	CreatedAt       time.Time      `db:"created_at" json:"created_at"`
+	LineName        string         `db:"line_name" json:"line_name"`
+	StationName     string         `db:"station_name" json:"station_name"`
+	Tags            pq.StringArray `db:"tags" json:"tags"`
+}
+
+// ConcurrentIncidentPair is two incidents on the same line whose
+// [ts, ts+duration) intervals overlap, First being the earlier one.
+type ConcurrentIncidentPair struct {
+	First        IncidentWithDetails `db:"first"`
+	Second       IncidentWithDetails `db:"second"`
+	OverlapStart time.Time           `db:"overlap_start" json:"overlap_start"`
+	OverlapEnd   time.Time           `db:"overlap_end" json:"overlap_end"`
+}
+
+// StationCoordinates is a station location in WGS84 decimal degrees.
+type StationCoordinates struct {
+	Latitude  float64 `json:"latitude"`
+	Longitude float64 `json:"longitude"`
+}
+
+type MapIncident struct {
+	ID              uuid.UUID `db:"id" json:"id"`
+	LineName        string    `db:"line_name" json:"line_name"`
+	StationName     string    `db:"station_name" json:"station_name"`
+	Timestamp       time.Time `db:"ts" json:"timestamp"`
+	DurationMinutes int32     `db:"duration_minutes" json:"duration_minutes"`
+	IncidentType    string    `db:"incident_type" json:"incident_type"`
+	Status          string    `db:"status" json:"status"`
+	Latitude        *float64  `db:"latitude" json:"latitude"`
+	Longitude       *float64  `db:"longitude" json:"longitude"`
+}
+
+// OrphanedIncident is an incident whose line or station row no longer exists.
+type OrphanedIncident struct {
+	ID             uuid.UUID `db:"id" json:"id"`
+	StationID      uuid.UUID `db:"station_id" json:"station_id"`
+	LineID         uuid.UUID `db:"line_id" json:"line_id"`
+	Timestamp      time.Time `db:"ts" json:"timestamp"`
+	IncidentType   string    `db:"incident_type" json:"incident_type"`
+	Status         string    `db:"status" json:"status"`
+	MissingLine    bool      `db:"missing_line" json:"missing_line"`
+	MissingStation bool      `db:"missing_station" json:"missing_station"`
+}
+
+type StationFilter struct {
+	LineID      *uuid.UUID
+	CreatedFrom *time.Time
+	CreatedTo   *time.Time
+}
+
+// TimeField selects which incident timestamp a listing filters and orders
+// by: when the incident happened (ts) or when it was recorded (created_at).
+type TimeField string
+
+const (
+	TimeFieldEvent    TimeField = "event"
+	TimeFieldIngested TimeField = "ingested"
+)
+
+func (f TimeField) column() string {
+	if f == TimeFieldIngested {
+		return "i.created_at"
+	}
+	return "i.ts"
+}
+
+// DisruptionSortField selects what GetRecentDisruptions orders by. The zero
+// value orders by the filter's time field.
+type DisruptionSortField string
+
+const (
+	DisruptionSortTime     DisruptionSortField = "ts"
+	DisruptionSortDuration DisruptionSortField = "duration"
+)
+
+// DisruptionSort orders recent disruptions. The zero value is newest first.
+type DisruptionSort struct {
+	By        DisruptionSortField
+	Ascending bool
+}
+
+// orderBy returns the ORDER BY clause for GetRecentDisruptions, where
+// timeColumn is the filter's time field. Equal durations are broken by time,
+// newest first.
+func (s DisruptionSort) orderBy(timeColumn string) string {
+	dir := "DESC"
+	if s.Ascending {
+		dir = "ASC"
+	}
+	if s.By == DisruptionSortDuration {
+		return "i.duration_minutes " + dir + ", " + timeColumn + " DESC"
+	}
+	return timeColumn + " " + dir
+}
+
+type IncidentFilter struct {
+	LineName string
+	// LineNames matches incidents on any of the lines and takes precedence
+	// over LineName.
+	LineNames   []string
+	StationName string
+	StationID   *uuid.UUID
+	Source      string
+	MinDuration *int32
+	MaxDuration *int32
+	// OnlyActive skips incidents whose status is ResolvedStatus.
+	OnlyActive     bool
+	ResolvedStatus string
+	Start          *time.Time
+	End            *time.Time
+	TimeField      TimeField
+	// IncludeDeleted keeps incidents at soft-deleted stations.
+	IncludeDeleted bool
+	Sort           DisruptionSort
 }
 
 type BreakdownCount struct {
-	Name  string `db:"name"`
-	Count int32  `db:"count"`
+	Name  string `db:"name" json:"name"`
+	Count int32  `db:"count" json:"count"`
 }
 
 type MTBFResult struct {
-	LineName    string  `db:"line_name"`
-	MTBFMinutes float64 `db:"mtbf_minutes"`
+	LineName    string  `db:"line_name" json:"line_name"`
+	MTBFMinutes float64 `db:"mtbf_minutes" json:"mtbf_minutes"`
+}
+
+// IncidentInterval is the gap before an incident and that incident's duration.
+type IncidentInterval struct {
+	LineName        string  `db:"line_name" json:"line_name"`
+	MinutesBetween  float64 `db:"minutes_between" json:"minutes_between"`
+	DurationMinutes int32   `db:"duration_minutes" json:"duration_minutes"`
 }
 
 type StationWithLine struct {
-	ID        uuid.UUID `db:"id"`
-	Name      string    `db:"name"`
-	LineID    uuid.UUID `db:"line_id"`
-	LineName  string    `db:"line_name"`
-	Status    string    `db:"status"`
-	CreatedAt time.Time `db:"created_at"`
+	ID            uuid.UUID `db:"id" json:"id"`
+	Name          string    `db:"name" json:"name"`
+	LineID        uuid.UUID `db:"line_id" json:"line_id"`
+	LineName      string    `db:"line_name" json:"line_name"`
+	Status        string    `db:"status" json:"status"`
+	Latitude      *float64  `db:"latitude" json:"latitude"`
+	Longitude     *float64  `db:"longitude" json:"longitude"`
+	CreatedAt     time.Time `db:"created_at" json:"created_at"`
+	IncidentCount int32     `db:"incident_count" json:"incident_count"`
+	// Position is the station's place in the sequence of the line it was
+	// listed for, its primary line unless ListStations filtered by line.
+	Position *int32           `db:"position" json:"position"`
+	Lines    []StationLineRef `db:"-" json:"lines"`
+}
+
+type StationLineRef struct {
+	StationID uuid.UUID `db:"station_id" json:"station_id"`
+	LineID    uuid.UUID `db:"line_id" json:"line_id"`
+	LineName  string    `db:"line_name" json:"line_name"`
+	IsPrimary bool      `db:"is_primary" json:"is_primary"`
+}
+
+type LineSummary struct {
+	LineID             uuid.UUID  `db:"line_id" json:"line_id"`
+	LineName           string     `db:"line_name" json:"line_name"`
+	StationCount       int32      `db:"station_count" json:"station_count"`
+	ActiveStationCount int32      `db:"active_station_count" json:"active_station_count"`
+	ClosedStationCount int32      `db:"closed_station_count" json:"closed_station_count"`
+	TotalIncidents     int32      `db:"total_incidents" json:"total_incidents"`
+	LastIncidentAt     *time.Time `db:"last_incident_at" json:"last_incident_at"`
+}
+
+// Sections of a NetworkOverview, each computed by its own query.
+const (
+	OverviewSectionLines     = "lines"
+	OverviewSectionStations  = "stations"
+	OverviewSectionIncidents = "incidents"
+	OverviewSectionMTBF      = "mtbf"
+)
+
+// NetworkOverview holds the network-wide KPIs shown on the dashboard
+// header. Line and station counts are current; the incident figures cover
+// the requested range. MTBFMinutes is nil when no line had two incidents in
+// the range. Sections listed in Failures could not be computed and their
+// fields are zero.
+type NetworkOverview struct {
+	TotalLines      int32            `db:"total_lines" json:"total_lines"`
+	TotalStations   int32            `db:"total_stations" json:"total_stations"`
+	TotalIncidents  int32            `db:"total_incidents" json:"total_incidents"`
+	DowntimeMinutes int64            `db:"downtime_minutes" json:"downtime_minutes"`
+	MTBFMinutes     *float64         `db:"mtbf_minutes" json:"mtbf_minutes"`
+	Failures        []SectionFailure `db:"-" json:"-"`
+}
+
+// SectionFailure records why one section of a combined result failed.
+type SectionFailure struct {
+	Section string
+	Err     error
+}
+
+// LineDurationPercentiles holds a line's incident duration percentiles, in
+// minutes, interpolated between durations as Postgres percentile_cont does.
+type LineDurationPercentiles struct {
+	LineName      string  `db:"line_name" json:"line_name"`
+	IncidentCount int32   `db:"incident_count" json:"incident_count"`
+	P50Minutes    float64 `db:"p50_minutes" json:"p50_minutes"`
+	P90Minutes    float64 `db:"p90_minutes" json:"p90_minutes"`
+	P95Minutes    float64 `db:"p95_minutes" json:"p95_minutes"`
+	P99Minutes    float64 `db:"p99_minutes" json:"p99_minutes"`
+}
+
+// LineSLABreaches counts a line's incidents that ran longer than an SLA
+// threshold. BreachRate is Breaches / TotalIncidents.
+type LineSLABreaches struct {
+	LineName       string  `db:"line_name" json:"line_name"`
+	TotalIncidents int32   `db:"total_incidents" json:"total_incidents"`
+	Breaches       int32   `db:"breaches" json:"breaches"`
+	BreachRate     float64 `db:"breach_rate" json:"breach_rate"`
+}
+
+// SchemaVersion is the migration state of the database. Dirty means a
+// migration failed part way and needs manual repair.
+type SchemaVersion struct {
+	Version uint `db:"version" json:"version"`
+	Dirty   bool `db:"dirty" json:"dirty"`
+}
+
+// LineQuietPeriod is the longest gap between consecutive incidents on a
+// line, from one incident's timestamp to the next.
+type LineQuietPeriod struct {
+	LineName   string    `db:"line_name" json:"line_name"`
+	GapMinutes float64   `db:"gap_minutes" json:"gap_minutes"`
+	StartTime  time.Time `db:"start_time" json:"start_time"`
+	EndTime    time.Time `db:"end_time" json:"end_time"`
+}
+
+type LineDeletionImpact struct {
+	LineID             uuid.UUID `db:"line_id" json:"line_id"`
+	LineName           string    `db:"line_name" json:"line_name"`
+	StationCount       int32     `db:"station_count" json:"station_count"`
+	SharedStationCount int32     `db:"shared_station_count" json:"shared_station_count"`
+	IncidentCount      int32     `db:"incident_count" json:"incident_count"`
+}
+
+type DailyRollingAverage struct {
+	LineName       string    `db:"line_name" json:"line_name"`
+	Day            time.Time `db:"day" json:"day"`
+	Count          int32     `db:"count" json:"count"`
+	RollingAverage float64   `db:"rolling_average" json:"rolling_average"`
+}
+
+type DailyCumulativeCount struct {
+	Day             time.Time `db:"day" json:"day"`
+	Count           int32     `db:"count" json:"count"`
+	CumulativeCount int32     `db:"cumulative_count" json:"cumulative_count"`
+}
+
+type LineTypeCount struct {
+	LineName     string  `db:"line_name" json:"line_name"`
+	IncidentType *string `db:"incident_type" json:"incident_type"`
+	Count        int32   `db:"count" json:"count"`
+}
+
+type StationIncidentAggregate struct {
+	StationName          string `db:"station_name" json:"station_name"`
+	LineName             string `db:"line_name" json:"line_name"`
+	IncidentCount        int32  `db:"incident_count" json:"incident_count"`
+	TotalDowntimeMinutes int32  `db:"total_downtime_minutes" json:"total_downtime_minutes"`
+}
+
+// StationLineComparison is a station's incident figures next to the
+// per-station average across its primary line.
+type StationLineComparison struct {
+	StationName            string  `db:"station_name" json:"station_name"`
+	LineName               string  `db:"line_name" json:"line_name"`
+	IncidentCount          int32   `db:"incident_count" json:"incident_count"`
+	TotalDowntimeMinutes   int32   `db:"total_downtime_minutes" json:"total_downtime_minutes"`
+	LineStationCount       int32   `db:"line_station_count" json:"line_station_count"`
+	LineAvgIncidentCount   float64 `db:"line_avg_incident_count" json:"line_avg_incident_count"`
+	LineAvgDowntimeMinutes float64 `db:"line_avg_downtime_minutes" json:"line_avg_downtime_minutes"`
+}
+
+type StationMTBF struct {
+	StationName     string   `db:"station_name" json:"station_name"`
+	LineName        string   `db:"line_name" json:"line_name"`
+	IncidentCount   int32    `db:"incident_count" json:"incident_count"`
+	DowntimeMinutes int32    `db:"downtime_minutes" json:"downtime_minutes"`
+	MTBFMinutes     *float64 `db:"mtbf_minutes" json:"mtbf_minutes"`
+}
+
+type LineLastIncident struct {
+	LineName       string     `db:"line_name" json:"line_name"`
+	LastIncidentAt *time.Time `db:"last_incident_at" json:"last_incident_at"`
+}
+
+type StatusCount struct {
+	Status string `db:"status" json:"status"`
+	Count  int32  `db:"count" json:"count"`
+}
+
+type LineStationStatusCount struct {
+	LineID   uuid.UUID `db:"line_id" json:"line_id"`
+	LineName string    `db:"line_name" json:"line_name"`
+	Status   *string   `db:"status" json:"status"`
+	Count    int32     `db:"count" json:"count"`
+}
+
+type TypeCount struct {
+	IncidentType string `db:"incident_type" json:"incident_type"`
+	Count        int32  `db:"count" json:"count"`
+}
+
+type HourTypeCount struct {
+	Hour         int32  `db:"hour" json:"hour"`
+	IncidentType string `db:"incident_type" json:"incident_type"`
+	Count        int32  `db:"count" json:"count"`
+}
+
+type BusiestPeriod struct {
+	WindowStart          time.Time `db:"window_start" json:"window_start"`
+	IncidentCount        int32     `db:"incident_count" json:"incident_count"`
+	TotalDowntimeMinutes int32     `db:"total_downtime_minutes" json:"total_downtime_minutes"`
+}
+
+type IncidentAuditEntry struct {
+	ID         uuid.UUID `db:"id" json:"id"`
+	IncidentID uuid.UUID `db:"incident_id" json:"incident_id"`
+	Action     string    `db:"action" json:"action"`
+	OldValues  *string   `db:"old_values" json:"old_values"`
+	NewValues  *string   `db:"new_values" json:"new_values"`
+	CreatedAt  time.Time `db:"created_at" json:"created_at"`
 }
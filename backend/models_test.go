@@ -0,0 +1,110 @@
+package backend
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestModels_JSONKeys(t *testing.T) {
+	tests := []struct {
+		name  string
+		value interface{}
+		keys  []string
+	}{
+		{"line", Line{ID: uuid.New(), Name: "Circle Line"}, []string{"id", "name", "color", "display_order", "created_at"}},
+		{"station", Station{ID: uuid.New(), Name: "Bishan"}, []string{"id", "name", "line_id", "status", "created_at"}},
+		{"incident", Incident{ID: uuid.New(), Timestamp: time.Now()}, []string{
+			"id", "station_id", "line_id", "timestamp", "duration_minutes", "incident_type", "status", "source", "created_at",
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := json.Marshal(tt.value)
+			require.NoError(t, err)
+
+			var fields map[string]json.RawMessage
+			require.NoError(t, json.Unmarshal(data, &fields))
+
+			keys := make([]string, 0, len(fields))
+			for k := range fields {
+				keys = append(keys, k)
+			}
+			assert.ElementsMatch(t, tt.keys, keys)
+		})
+	}
+}
+
+func TestModels_NullableJSON(t *testing.T) {
+	color := "#D42E12"
+	order := int32(2)
+	source := "scada"
+	lat, lng := 1.3521, 103.8198
+	oldValues := `{"status":"open"}`
+
+	tests := []struct {
+		name  string
+		value interface{}
+		want  map[string]interface{}
+	}{
+		{"line set", Line{Color: &color, DisplayOrder: &order}, map[string]interface{}{"color": "#D42E12", "display_order": float64(2)}},
+		{"line null", Line{}, map[string]interface{}{"color": nil, "display_order": nil}},
+		{"incident set", Incident{Source: &source}, map[string]interface{}{"source": "scada"}},
+		{"incident null", Incident{}, map[string]interface{}{"source": nil}},
+		{"station set", StationWithLine{Latitude: &lat, Longitude: &lng}, map[string]interface{}{"latitude": 1.3521, "longitude": 103.8198}},
+		{"station null", StationWithLine{}, map[string]interface{}{"latitude": nil, "longitude": nil, "position": nil}},
+		{"audit", IncidentAuditEntry{OldValues: &oldValues}, map[string]interface{}{"old_values": oldValues, "new_values": nil}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := json.Marshal(tt.value)
+			require.NoError(t, err)
+
+			var fields map[string]interface{}
+			require.NoError(t, json.Unmarshal(data, &fields))
+
+			for key, want := range tt.want {
+				assert.Equal(t, want, fields[key], key)
+			}
+		})
+	}
+}
+
+func TestLineSort_OrderBy(t *testing.T) {
+	tests := []struct {
+		sort LineSort
+		want string
+	}{
+		{LineSort{}, "l.display_order NULLS LAST, l.name"},
+		{LineSort{By: LineSortName, Descending: true}, "l.name DESC"},
+		{LineSort{By: LineSortIncidentCount}, "incident_count ASC, l.name"},
+		{LineSort{By: LineSortDowntime, Descending: true}, "downtime_minutes DESC, l.name"},
+		{LineSort{By: LineSortMTBF}, "mtbf_minutes ASC NULLS LAST, l.name"},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, tt.sort.orderBy())
+	}
+}
+
+func TestDisruptionSort_OrderBy(t *testing.T) {
+	tests := []struct {
+		sort DisruptionSort
+		want string
+	}{
+		{DisruptionSort{}, "i.ts DESC"},
+		{DisruptionSort{By: DisruptionSortTime, Ascending: true}, "i.ts ASC"},
+		{DisruptionSort{By: DisruptionSortDuration}, "i.duration_minutes DESC, i.ts DESC"},
+		{DisruptionSort{By: DisruptionSortDuration, Ascending: true}, "i.duration_minutes ASC, i.ts DESC"},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, tt.sort.orderBy("i.ts"))
+	}
+}
@@ -0,0 +1,58 @@
+package backend
+
+import "math"
+
+// WeightedMTBF is a line's plain MTBF alongside its duration-weighted MTBF.
+type WeightedMTBF struct {
+	LineName            string
+	IntervalCount       int32
+	MTBFMinutes         float64
+	WeightedMTBFMinutes float64
+}
+
+// computeWeightedMTBF groups intervals by line and computes, for each line,
+//
+//	MTBF          = Σ Δk / n
+//	weighted MTBF = Σ (dk · Δk) / Σ dk
+//
+// where Δk is the gap in minutes before incident k and dk is that incident's
+// duration in minutes. Gaps that end in long outages dominate the weighted
+// figure, so a line whose severe failures arrive in quick succession scores
+// lower than its plain MTBF suggests. Lines whose incidents all have zero
+// duration fall back to the plain MTBF. Intervals must be ordered by line.
+func computeWeightedMTBF(intervals []IncidentInterval) []WeightedMTBF {
+	var results []WeightedMTBF
+	var sumGap, sumWeighted, sumDuration float64
+
+	flush := func() {
+		last := &results[len(results)-1]
+		last.MTBFMinutes = round2(sumGap / float64(last.IntervalCount))
+		last.WeightedMTBFMinutes = last.MTBFMinutes
+		if sumDuration > 0 {
+			last.WeightedMTBFMinutes = round2(sumWeighted / sumDuration)
+		}
+	}
+
+	for _, interval := range intervals {
+		if len(results) == 0 || results[len(results)-1].LineName != interval.LineName {
+			if len(results) > 0 {
+				flush()
+			}
+			results = append(results, WeightedMTBF{LineName: interval.LineName})
+			sumGap, sumWeighted, sumDuration = 0, 0, 0
+		}
+		duration := float64(interval.DurationMinutes)
+		results[len(results)-1].IntervalCount++
+		sumGap += interval.MinutesBetween
+		sumWeighted += duration * interval.MinutesBetween
+		sumDuration += duration
+	}
+	if len(results) > 0 {
+		flush()
+	}
+	return results
+}
+
+func round2(v float64) float64 {
+	return math.Round(v*100) / 100
+}
@@ -0,0 +1,47 @@
+package backend
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestComputeWeightedMTBF(t *testing.T) {
+	intervals := []IncidentInterval{
+		{LineName: "Circle Line", MinutesBetween: 100, DurationMinutes: 10},
+		{LineName: "Circle Line", MinutesBetween: 20, DurationMinutes: 240},
+		{LineName: "East West Line", MinutesBetween: 60, DurationMinutes: 5},
+		{LineName: "East West Line", MinutesBetween: 90, DurationMinutes: 5},
+	}
+
+	results := computeWeightedMTBF(intervals)
+
+	require.Len(t, results, 2)
+
+	assert.Equal(t, "Circle Line", results[0].LineName)
+	assert.Equal(t, int32(2), results[0].IntervalCount)
+	assert.Equal(t, 60.0, results[0].MTBFMinutes)
+	// (10*100 + 240*20) / 250
+	assert.Equal(t, 23.2, results[0].WeightedMTBFMinutes)
+
+	// Equal durations make the weighted figure match the plain one.
+	assert.Equal(t, "East West Line", results[1].LineName)
+	assert.Equal(t, 75.0, results[1].MTBFMinutes)
+	assert.Equal(t, 75.0, results[1].WeightedMTBFMinutes)
+}
+
+func TestComputeWeightedMTBF_ZeroDurations(t *testing.T) {
+	results := computeWeightedMTBF([]IncidentInterval{
+		{LineName: "Circle Line", MinutesBetween: 30},
+		{LineName: "Circle Line", MinutesBetween: 50},
+	})
+
+	require.Len(t, results, 1)
+	assert.Equal(t, 40.0, results[0].MTBFMinutes)
+	assert.Equal(t, 40.0, results[0].WeightedMTBFMinutes)
+}
+
+func TestComputeWeightedMTBF_Empty(t *testing.T) {
+	assert.Empty(t, computeWeightedMTBF(nil))
+}
@@ -0,0 +1,58 @@
+package backend
+
+import (
+	"context"
+	"runtime/debug"
+
+	"github.com/go-coldbrew/log"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// RecoveryUnaryInterceptor converts panics raised by a unary handler into a
+// codes.Internal error so a single bad request cannot take down the server.
+func RecoveryUnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
+		defer func() {
+			if r := recover(); r != nil {
+				log.Error(ctx, "Recovered from panic in handler",
+					"method", info.FullMethod,
+					"panic", r,
+					"stack", string(debug.Stack()))
+				resp = nil
+				err = status.Error(codes.Internal, "internal error")
+			}
+		}()
+		return handler(ctx, req)
+	}
+}
+
+// WithPanicRecovery returns a copy of desc whose unary methods run the service
+// implementation behind RecoveryUnaryInterceptor. Wrapping at the method level
+// keeps recovery innermost, so server-wide interceptors observe the resulting
+// status error instead of the panic.
+func WithPanicRecovery(desc *grpc.ServiceDesc) *grpc.ServiceDesc {
+	recovery := RecoveryUnaryInterceptor()
+
+	wrapped := *desc
+	wrapped.Methods = make([]grpc.MethodDesc, len(desc.Methods))
+	for i, method := range desc.Methods {
+		handler := method.Handler
+		wrapped.Methods[i] = grpc.MethodDesc{
+			MethodName: method.MethodName,
+			Handler: func(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+				return handler(srv, ctx, dec, func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, next grpc.UnaryHandler) (interface{}, error) {
+					recovered := func(ctx context.Context, req interface{}) (interface{}, error) {
+						return recovery(ctx, req, info, next)
+					}
+					if interceptor == nil {
+						return recovered(ctx, req)
+					}
+					return interceptor(ctx, req, info, recovered)
+				})
+			},
+		}
+	}
+	return &wrapped
+}
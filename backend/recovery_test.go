@@ -0,0 +1,81 @@
+package backend
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+
+	pb "github.com/bluesg/transport-analytics/proto"
+)
+
+func TestWithPanicRecovery_ServerSurvivesPanic(t *testing.T) {
+	service, mockRepo := setupServiceWithMock()
+
+	// The outer interceptor stands in for the server-wide chain and records
+	// what it observes from the handler.
+	var observed []error
+	outer := func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		resp, err := handler(ctx, req)
+		observed = append(observed, err)
+		return resp, err
+	}
+
+	listener := bufconn.Listen(1024 * 1024)
+	server := grpc.NewServer(grpc.ChainUnaryInterceptor(outer))
+	server.RegisterService(WithPanicRecovery(&pb.TransportAnalytics_ServiceDesc), service)
+	go func() { _ = server.Serve(listener) }()
+	defer server.Stop()
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	defer conn.Close()
+	client := pb.NewTransportAnalyticsClient(conn)
+	ctx := context.Background()
+
+	mockRepo.CalculateMTBFFn = func(ctx context.Context, includeDeleted bool) ([]MTBFResult, *time.Time, error) {
+		panic("driver exploded")
+	}
+
+	_, err = client.GetMTBF(ctx, &pb.MTBFRequest{})
+	require.Error(t, err)
+	st, ok := status.FromError(err)
+	require.True(t, ok)
+	assert.Equal(t, codes.Internal, st.Code())
+
+	require.Len(t, observed, 1)
+	assert.Equal(t, codes.Internal, status.Code(observed[0]))
+
+	mockRepo.CalculateMTBFFn = func(ctx context.Context, includeDeleted bool) ([]MTBFResult, *time.Time, error) {
+		return []MTBFResult{{LineName: "Circle Line", MTBFMinutes: 120}}, nil, nil
+	}
+
+	resp, err := client.GetMTBF(ctx, &pb.MTBFRequest{})
+	require.NoError(t, err)
+	require.Len(t, resp.Lines, 1)
+	assert.Equal(t, "Circle Line", resp.Lines[0].Name)
+}
+
+func TestRecoveryUnaryInterceptor_PassesThrough(t *testing.T) {
+	interceptor := RecoveryUnaryInterceptor()
+	info := &grpc.UnaryServerInfo{FullMethod: "/test/Method"}
+
+	resp, err := interceptor(context.Background(), "req", info, func(ctx context.Context, req interface{}) (interface{}, error) {
+		return "ok", nil
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "ok", resp)
+}
@@ -3,110 +3,674 @@ package backend
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"strings"
+	"sync"
 	"time"
 
+	"github.com/go-coldbrew/log"
 	"github.com/google/uuid"
 	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
 )
 
 var (
 	ErrNotFound      = errors.New("not found")
 	ErrInvalidInput  = errors.New("invalid input")
 	ErrDatabaseError = errors.New("database error")
+	ErrAlreadyExists = errors.New("already exists")
+	// ErrLineNotFound is returned when a referenced line does not exist, as
+	// opposed to ErrNotFound for the entity being looked up itself.
+	ErrLineNotFound = errors.New("line not found")
+	// ErrStationLineMismatch is returned when a station is not on a line it
+	// must belong to, such as two stations being merged that do not share a
+	// primary line, or a station being positioned on a line it does not serve.
+	ErrStationLineMismatch = errors.New("stations are on different lines")
 )
 
+type RepositoryConfig struct {
+	Retry RetryConfig
+	// SlowQueryThreshold is how long a repository call may take before it is
+	// logged as slow. Zero disables slow query logging.
+	SlowQueryThreshold time.Duration
+	// Breaker, when set, is told about transient database failures seen by
+	// write queries after retries are exhausted.
+	Breaker *CircuitBreaker
+	// StrictIncidentInsert makes CreateIncident fail with ErrAlreadyExists
+	// on a duplicate (station, line, timestamp) instead of updating the
+	// existing incident.
+	StrictIncidentInsert bool
+}
+
 type Repository struct {
-	db *sqlx.DB
+	db     *sqlx.DB
+	readDB *sqlx.DB
+	cfg    RepositoryConfig
+}
+
+// NewRepository returns a repository writing to db. Analytics and list queries
+// go to readDB when it is non-nil, and to db otherwise.
+func NewRepository(db, readDB *sqlx.DB, cfg RepositoryConfig) *Repository {
+	if readDB == nil {
+		readDB = db
+	}
+	return &Repository{db: db, readDB: readDB, cfg: cfg}
+}
+
+func (r *Repository) withRetry(ctx context.Context, fn func() error) error {
+	err := withRetry(ctx, r.cfg.Retry, fn)
+	if r.cfg.Breaker != nil {
+		if isTransientError(err) {
+			r.cfg.Breaker.RecordFailure()
+		} else {
+			r.cfg.Breaker.RecordSuccess()
+		}
+	}
+	return err
+}
+
+func (r *Repository) logSlowQuery(ctx context.Context, name string, start time.Time) {
+	if r.cfg.SlowQueryThreshold <= 0 {
+		return
+	}
+	if elapsed := time.Since(start); elapsed >= r.cfg.SlowQueryThreshold {
+		log.Warn(ctx, "Slow query", "query", name, "duration_ms", elapsed.Milliseconds())
+	}
+}
+
+func isUniqueViolation(err error) bool {
+	var pqErr *pq.Error
+	return errors.As(err, &pqErr) && pqErr.Code == "23505"
+}
+
+func isForeignKeyViolation(err error) bool {
+	var pqErr *pq.Error
+	return errors.As(err, &pqErr) && pqErr.Code == "23503"
+}
+
+// isQueryCanceled reports whether Postgres cancelled the query, as it does
+// when statement_timeout is exceeded.
+func isQueryCanceled(err error) bool {
+	var pqErr *pq.Error
+	return errors.As(err, &pqErr) && pqErr.Code == "57014"
 }
 
-func NewRepository(db *sqlx.DB) *Repository {
-	return &Repository{db: db}
+// escapeLike escapes the LIKE wildcards in s so it matches literally in a
+// pattern using the default backslash escape character.
+func escapeLike(s string) string {
+	return strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(s)
 }
 
-func (r *Repository) GetOrCreateLine(ctx context.Context, name string) (*Line, error) {
-	tx, err := r.db.BeginTxx(ctx, nil)
+func (r *Repository) GetOrCreateLine(ctx context.Context, name string) (*Line, bool, error) {
+	defer r.logSlowQuery(ctx, "GetOrCreateLine", time.Now())
+
+	var line Line
+	var created bool
+	err := r.withRetry(ctx, func() error {
+		created = false
+		tx, err := r.db.BeginTxx(ctx, nil)
+		if err != nil {
+			return err
+		}
+		defer func() { _ = tx.Rollback() }()
+
+		err = tx.GetContext(ctx, &line, "SELECT id, name, created_at FROM lines WHERE LOWER(name) = LOWER($1)", name)
+		if err != sql.ErrNoRows {
+			return err
+		}
+
+		err = tx.GetContext(ctx, &line,
+			"INSERT INTO lines (name) VALUES ($1) RETURNING id, name, created_at",
+			name)
+		if err != nil {
+			return err
+		}
+
+		if err := tx.Commit(); err != nil {
+			return err
+		}
+		created = true
+		return nil
+	})
 	if err != nil {
-		return nil, fmt.Errorf("%w: %v", ErrDatabaseError, err)
+		return nil, false, fmt.Errorf("%w: %v", ErrDatabaseError, err)
 	}
-	defer func() { _ = tx.Rollback() }()
+
+	return &line, created, nil
+}
+
+func (r *Repository) FindLineByName(ctx context.Context, name string) (*Line, error) {
+	defer r.logSlowQuery(ctx, "FindLineByName", time.Now())
 
 	var line Line
-	err = tx.GetContext(ctx, &line, "SELECT id, name, created_at FROM lines WHERE name = $1", name)
-	if err == nil {
-		return &line, nil
+	err := r.db.GetContext(ctx, &line, "SELECT id, name, created_at FROM lines WHERE LOWER(name) = LOWER($1)", name)
+	if err == sql.ErrNoRows {
+		return nil, ErrNotFound
 	}
-	if err != sql.ErrNoRows {
+	if err != nil {
 		return nil, fmt.Errorf("%w: %v", ErrDatabaseError, err)
 	}
+	return &line, nil
+}
 
-	err = tx.GetContext(ctx, &line,
-		"INSERT INTO lines (name) VALUES ($1) RETURNING id, name, created_at",
-		name)
+func (r *Repository) FindStationByName(ctx context.Context, name string, lineID uuid.UUID) (*Station, error) {
+	defer r.logSlowQuery(ctx, "FindStationByName", time.Now())
+
+	var station Station
+	err := r.db.GetContext(ctx, &station,
+		"SELECT id, name, line_id, status, created_at FROM stations WHERE name = $1 AND line_id = $2 AND deleted_at IS NULL",
+		name, lineID)
+	if err == sql.ErrNoRows {
+		return nil, ErrNotFound
+	}
 	if err != nil {
 		return nil, fmt.Errorf("%w: %v", ErrDatabaseError, err)
 	}
+	return &station, nil
+}
+
+func (r *Repository) GetOrCreateStation(ctx context.Context, name string, lineID uuid.UUID) (*Station, bool, error) {
+	defer r.logSlowQuery(ctx, "GetOrCreateStation", time.Now())
+
+	var station Station
+	var created bool
+	err := r.withRetry(ctx, func() error {
+		created = false
+		tx, err := r.db.BeginTxx(ctx, nil)
+		if err != nil {
+			return err
+		}
+		defer func() { _ = tx.Rollback() }()
+
+		err = tx.GetContext(ctx, &station,
+			"SELECT id, name, line_id, status, created_at FROM stations WHERE name = $1 AND line_id = $2 AND deleted_at IS NULL",
+			name, lineID)
+		if err != sql.ErrNoRows {
+			return err
+		}
 
-	if err := tx.Commit(); err != nil {
+		err = tx.GetContext(ctx, &station,
+			"INSERT INTO stations (name, line_id) VALUES ($1, $2) RETURNING id, name, line_id, status, created_at",
+			name, lineID)
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.ExecContext(ctx,
+			"INSERT INTO station_lines (station_id, line_id) VALUES ($1, $2) ON CONFLICT DO NOTHING",
+			station.ID, lineID)
+		if err != nil {
+			return err
+		}
+
+		if err := tx.Commit(); err != nil {
+			return err
+		}
+		created = true
+		return nil
+	})
+	if err != nil {
+		return nil, false, fmt.Errorf("%w: %v", ErrDatabaseError, err)
+	}
+
+	return &station, created, nil
+}
+
+// CreateIncident stores an incident. ts is normalized to UTC before it is
+// written, and the returned incident's timestamp is UTC too, so an input
+// carrying an offset such as +08:00 keeps its instant and never its zone.
+func (r *Repository) CreateIncident(ctx context.Context, stationID, lineID uuid.UUID, ts time.Time, durationMinutes int32, incidentType, status, source string) (*Incident, error) {
+	defer r.logSlowQuery(ctx, "CreateIncident", time.Now())
+
+	var incident Incident
+	err := r.withRetry(ctx, func() error {
+		return r.insertIncident(ctx, r.db, &incident, stationID, lineID, ts, durationMinutes, incidentType, status, source)
+	})
+	if isUniqueViolation(err) {
+		return nil, ErrAlreadyExists
+	}
+	if err != nil {
 		return nil, fmt.Errorf("%w: %v", ErrDatabaseError, err)
 	}
+	incident.Timestamp = incident.Timestamp.UTC()
+	return &incident, nil
+}
 
-	return &line, nil
+// CreateIncidentWithOptions stores an incident like CreateIncident and, in
+// the same transaction, applies opts: it sets the station's status and
+// records the change in the station's status history, and attaches tags.
+// A soft-deleted station keeps its status. It returns every tag now on the
+// incident sorted by name.
+func (r *Repository) CreateIncidentWithOptions(ctx context.Context, stationID, lineID uuid.UUID, ts time.Time, durationMinutes int32, incidentType, status, source string, opts IncidentCreateOptions) (*IncidentCreateResult, error) {
+	defer r.logSlowQuery(ctx, "CreateIncidentWithOptions", time.Now())
+
+	var incident Incident
+	var result IncidentCreateResult
+	err := r.withRetry(ctx, func() error {
+		result = IncidentCreateResult{}
+		tx, err := r.db.BeginTxx(ctx, nil)
+		if err != nil {
+			return err
+		}
+		defer func() { _ = tx.Rollback() }()
+
+		if err := r.insertIncident(ctx, tx, &incident, stationID, lineID, ts, durationMinutes, incidentType, status, source); err != nil {
+			return err
+		}
+
+		if opts.StationStatus != "" {
+			res, err := tx.ExecContext(ctx,
+				"UPDATE stations SET status = $1 WHERE id = $2 AND deleted_at IS NULL",
+				opts.StationStatus, stationID)
+			if err != nil {
+				return err
+			}
+			rows, err := res.RowsAffected()
+			if err != nil {
+				return err
+			}
+			if rows > 0 {
+				if err := recordStationStatus(ctx, tx, stationID, opts.StationStatus); err != nil {
+					return err
+				}
+				result.StationStatusUpdated = true
+			}
+		}
+
+		if len(opts.Tags) > 0 {
+			if result.Tags, err = tagIncident(ctx, tx, incident.ID, opts.Tags); err != nil {
+				return err
+			}
+		}
+
+		return tx.Commit()
+	})
+	if isUniqueViolation(err) {
+		return nil, ErrAlreadyExists
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrDatabaseError, err)
+	}
+	incident.Timestamp = incident.Timestamp.UTC()
+	result.Incident = &incident
+	return &result, nil
+}
+
+// insertIncident runs the incident INSERT on q, normalizing ts to UTC. Unless
+// StrictIncidentInsert is set, an incident already recorded for the same
+// station, line and timestamp is updated in place.
+func (r *Repository) insertIncident(ctx context.Context, q sqlx.QueryerContext, incident *Incident, stationID, lineID uuid.UUID, ts time.Time, durationMinutes int32, incidentType, status, source string) error {
+	onConflict := `ON CONFLICT (station_id, line_id, ts) DO UPDATE
+			 SET duration_minutes = EXCLUDED.duration_minutes, incident_type = EXCLUDED.incident_type,
+			     source = COALESCE(EXCLUDED.source, incidents.source)`
+	if r.cfg.StrictIncidentInsert {
+		onConflict = ""
+	}
+
+	return sqlx.GetContext(ctx, q, incident,
+		`INSERT INTO incidents (station_id, line_id, ts, duration_minutes, incident_type, status, source)
+		 VALUES ($1, $2, $3, $4, $5, $6, NULLIF($7, ''))
+		 `+onConflict+`
+		 RETURNING id, station_id, line_id, ts, duration_minutes, incident_type, status, source, created_at`,
+		stationID, lineID, ts.UTC(), durationMinutes, incidentType, status, source)
+}
+
+// tagIncident attaches tags to an incident within tx and returns every tag
+// now on the incident sorted by name.
+func tagIncident(ctx context.Context, tx *sqlx.Tx, incidentID uuid.UUID, tags []string) ([]string, error) {
+	// The no-op update makes RETURNING yield existing tags as well.
+	_, err := tx.ExecContext(ctx,
+		`WITH tag_ids AS (
+			INSERT INTO tags (name) SELECT unnest($2::text[])
+			ON CONFLICT (name) DO UPDATE SET name = EXCLUDED.name
+			RETURNING id
+		)
+		INSERT INTO incident_tags (incident_id, tag_id)
+		SELECT $1, id FROM tag_ids
+		ON CONFLICT DO NOTHING`,
+		incidentID, pq.Array(tags))
+	if err != nil {
+		return nil, err
+	}
+
+	var names []string
+	err = tx.SelectContext(ctx, &names,
+		`SELECT t.name FROM incident_tags it JOIN tags t ON it.tag_id = t.id
+		 WHERE it.incident_id = $1 ORDER BY t.name`,
+		incidentID)
+	if err != nil {
+		return nil, err
+	}
+	return names, nil
 }
 
-func (r *Repository) GetOrCreateStation(ctx context.Context, name string, lineID uuid.UUID) (*Station, error) {
-	tx, err := r.db.BeginTxx(ctx, nil)
+func (r *Repository) ListIncidentsByTag(ctx context.Context, tag string, limit int32, timeField TimeField) ([]IncidentWithDetails, error) {
+	defer r.logSlowQuery(ctx, "ListIncidentsByTag", time.Now())
+
+	var results []IncidentWithDetails
+	err := r.readDB.SelectContext(ctx, &results,
+		`SELECT i.id, i.station_id, i.line_id, i.ts, i.duration_minutes, i.incident_type, i.status, i.source, i.created_at,
+		        l.name as line_name, s.name as station_name,
+		        ARRAY(SELECT t2.name FROM incident_tags it2 JOIN tags t2 ON it2.tag_id = t2.id
+		              WHERE it2.incident_id = i.id ORDER BY t2.name) as tags
+		 FROM tags t
+		 JOIN incident_tags it ON it.tag_id = t.id
+		 JOIN incidents i ON it.incident_id = i.id
+		 JOIN lines l ON i.line_id = l.id
+		 JOIN stations s ON i.station_id = s.id
+		 WHERE t.name = $1
+		 ORDER BY `+timeField.column()+` DESC
+		 LIMIT $2`,
+		tag, limit)
 	if err != nil {
 		return nil, fmt.Errorf("%w: %v", ErrDatabaseError, err)
 	}
-	defer func() { _ = tx.Rollback() }()
+	return results, nil
+}
 
-	var station Station
-	err = tx.GetContext(ctx, &station,
-		"SELECT id, name, line_id, status, created_at FROM stations WHERE name = $1 AND line_id = $2",
-		name, lineID)
-	if err == nil {
-		return &station, nil
+func (r *Repository) UpdateIncidentStatus(ctx context.Context, id uuid.UUID, status, action string) (*IncidentWithDetails, error) {
+	defer r.logSlowQuery(ctx, "UpdateIncidentStatus", time.Now())
+
+	err := r.withRetry(ctx, func() error {
+		tx, err := r.db.BeginTxx(ctx, nil)
+		if err != nil {
+			return err
+		}
+		defer func() { _ = tx.Rollback() }()
+
+		before, err := lockIncident(ctx, tx, id)
+		if err != nil {
+			return err
+		}
+
+		after := *before
+		after.Status = status
+		_, err = tx.ExecContext(ctx, "UPDATE incidents SET status = $1 WHERE id = $2", status, id)
+		if err != nil {
+			return err
+		}
+
+		if err := writeIncidentAudit(ctx, tx, id, action, snapshotIncident(before), snapshotIncident(&after)); err != nil {
+			return err
+		}
+
+		return tx.Commit()
+	})
+	if err == sql.ErrNoRows {
+		return nil, ErrNotFound
 	}
-	if err != sql.ErrNoRows {
+	if err != nil {
 		return nil, fmt.Errorf("%w: %v", ErrDatabaseError, err)
 	}
+	return r.GetIncidentWithDetails(ctx, id)
+}
 
-	err = tx.GetContext(ctx, &station,
-		"INSERT INTO stations (name, line_id) VALUES ($1, $2) RETURNING id, name, line_id, status, created_at",
-		name, lineID)
+func (r *Repository) UpdateIncident(ctx context.Context, id uuid.UUID, durationMinutes *int32, incidentType *string) (*IncidentWithDetails, error) {
+	defer r.logSlowQuery(ctx, "UpdateIncident", time.Now())
+
+	err := r.withRetry(ctx, func() error {
+		tx, err := r.db.BeginTxx(ctx, nil)
+		if err != nil {
+			return err
+		}
+		defer func() { _ = tx.Rollback() }()
+
+		before, err := lockIncident(ctx, tx, id)
+		if err != nil {
+			return err
+		}
+
+		after := *before
+		if durationMinutes != nil {
+			after.DurationMinutes = *durationMinutes
+		}
+		if incidentType != nil {
+			after.IncidentType = *incidentType
+		}
+		_, err = tx.ExecContext(ctx,
+			"UPDATE incidents SET duration_minutes = $1, incident_type = $2 WHERE id = $3",
+			after.DurationMinutes, after.IncidentType, id)
+		if err != nil {
+			return err
+		}
+
+		if err := writeIncidentAudit(ctx, tx, id, "update", snapshotIncident(before), snapshotIncident(&after)); err != nil {
+			return err
+		}
+
+		return tx.Commit()
+	})
+	if err == sql.ErrNoRows {
+		return nil, ErrNotFound
+	}
 	if err != nil {
 		return nil, fmt.Errorf("%w: %v", ErrDatabaseError, err)
 	}
+	return r.GetIncidentWithDetails(ctx, id)
+}
+
+// ReassignIncident moves an incident to lineID. The incident's station must
+// be on that line, either as its primary line or through station_lines.
+func (r *Repository) ReassignIncident(ctx context.Context, id, lineID uuid.UUID) (*IncidentWithDetails, error) {
+	defer r.logSlowQuery(ctx, "ReassignIncident", time.Now())
+
+	err := r.withRetry(ctx, func() error {
+		tx, err := r.db.BeginTxx(ctx, nil)
+		if err != nil {
+			return err
+		}
+		defer func() { _ = tx.Rollback() }()
+
+		before, err := lockIncident(ctx, tx, id)
+		if err == sql.ErrNoRows {
+			return ErrNotFound
+		}
+		if err != nil {
+			return err
+		}
+
+		var lineExists bool
+		if err := tx.GetContext(ctx, &lineExists, "SELECT EXISTS (SELECT 1 FROM lines WHERE id = $1)", lineID); err != nil {
+			return err
+		}
+		if !lineExists {
+			return ErrLineNotFound
+		}
+
+		var onLine bool
+		err = tx.GetContext(ctx, &onLine,
+			`SELECT EXISTS (
+				SELECT 1 FROM stations s
+				WHERE s.id = $1 AND (s.line_id = $2 OR EXISTS (
+					SELECT 1 FROM station_lines sl WHERE sl.station_id = s.id AND sl.line_id = $2))
+			)`,
+			before.StationID, lineID)
+		if err != nil {
+			return err
+		}
+		if !onLine {
+			return ErrStationLineMismatch
+		}
+
+		if _, err := tx.ExecContext(ctx, "UPDATE incidents SET line_id = $1 WHERE id = $2", lineID, id); err != nil {
+			return err
+		}
+
+		after := *before
+		after.LineID = lineID
+		if err := writeIncidentAudit(ctx, tx, id, "reassign", snapshotIncident(before), snapshotIncident(&after)); err != nil {
+			return err
+		}
 
-	if err := tx.Commit(); err != nil {
+		return tx.Commit()
+	})
+	if err == ErrNotFound || err == ErrLineNotFound || err == ErrStationLineMismatch {
+		return nil, err
+	}
+	if isUniqueViolation(err) {
+		return nil, ErrAlreadyExists
+	}
+	if err != nil {
 		return nil, fmt.Errorf("%w: %v", ErrDatabaseError, err)
 	}
+	return r.GetIncidentWithDetails(ctx, id)
+}
 
-	return &station, nil
+// PurgeIncidentsBefore deletes incidents whose timestamp is before cutoff,
+// batchSize rows per statement so no single transaction holds locks on a
+// large part of the table. It returns the number of incidents removed, which
+// is non-zero even on error if earlier batches succeeded. batchSize must be
+// positive, since a LIMIT 0 batch would never finish the purge.
+func (r *Repository) PurgeIncidentsBefore(ctx context.Context, cutoff time.Time, batchSize int) (int64, error) {
+	defer r.logSlowQuery(ctx, "PurgeIncidentsBefore", time.Now())
+
+	if batchSize <= 0 {
+		return 0, fmt.Errorf("%w: batch size must be positive, got %d", ErrInvalidInput, batchSize)
+	}
+
+	var total int64
+	for {
+		var deleted int64
+		err := r.withRetry(ctx, func() error {
+			result, err := r.db.ExecContext(ctx,
+				`DELETE FROM incidents WHERE id IN (
+					SELECT id FROM incidents WHERE ts < $1 LIMIT $2
+				)`,
+				cutoff, batchSize)
+			if err != nil {
+				return err
+			}
+			deleted, err = result.RowsAffected()
+			return err
+		})
+		if err != nil {
+			return total, fmt.Errorf("%w: %v", ErrDatabaseError, err)
+		}
+		total += deleted
+		if deleted < int64(batchSize) {
+			return total, nil
+		}
+		if err := ctx.Err(); err != nil {
+			return total, fmt.Errorf("%w: %v", ErrDatabaseError, err)
+		}
+	}
 }
 
-func (r *Repository) CreateIncident(ctx context.Context, stationID, lineID uuid.UUID, ts time.Time, durationMinutes int32, incidentType string) (*Incident, error) {
-	var incident Incident
-	err := r.db.GetContext(ctx, &incident,
-		`INSERT INTO incidents (station_id, line_id, ts, duration_minutes, incident_type)
-		 VALUES ($1, $2, $3, $4, $5)
-		 ON CONFLICT (station_id, line_id, ts) DO UPDATE
-		 SET duration_minutes = EXCLUDED.duration_minutes, incident_type = EXCLUDED.incident_type
-		 RETURNING id, station_id, line_id, ts, duration_minutes, incident_type, status, created_at`,
-		stationID, lineID, ts, durationMinutes, incidentType)
+func (r *Repository) DeleteIncident(ctx context.Context, id uuid.UUID) error {
+	defer r.logSlowQuery(ctx, "DeleteIncident", time.Now())
+
+	err := r.withRetry(ctx, func() error {
+		tx, err := r.db.BeginTxx(ctx, nil)
+		if err != nil {
+			return err
+		}
+		defer func() { _ = tx.Rollback() }()
+
+		before, err := lockIncident(ctx, tx, id)
+		if err != nil {
+			return err
+		}
+
+		if _, err := tx.ExecContext(ctx, "DELETE FROM incidents WHERE id = $1", id); err != nil {
+			return err
+		}
+
+		if err := writeIncidentAudit(ctx, tx, id, "delete", snapshotIncident(before), nil); err != nil {
+			return err
+		}
+
+		return tx.Commit()
+	})
+	if err == sql.ErrNoRows {
+		return ErrNotFound
+	}
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrDatabaseError, err)
+	}
+	return nil
+}
+
+func (r *Repository) GetIncidentHistory(ctx context.Context, id uuid.UUID) ([]IncidentAuditEntry, error) {
+	defer r.logSlowQuery(ctx, "GetIncidentHistory", time.Now())
+
+	var entries []IncidentAuditEntry
+	err := r.db.SelectContext(ctx, &entries,
+		`SELECT id, incident_id, action, old_values, new_values, created_at
+		 FROM incident_audit
+		 WHERE incident_id = $1
+		 ORDER BY created_at, id`, id)
 	if err != nil {
 		return nil, fmt.Errorf("%w: %v", ErrDatabaseError, err)
 	}
+	return entries, nil
+}
+
+type incidentSnapshot struct {
+	LineID          uuid.UUID `json:"line_id"`
+	DurationMinutes int32     `json:"duration_minutes"`
+	IncidentType    string    `json:"incident_type"`
+	Status          string    `json:"status"`
+}
+
+func snapshotIncident(incident *Incident) *incidentSnapshot {
+	return &incidentSnapshot{
+		LineID:          incident.LineID,
+		DurationMinutes: incident.DurationMinutes,
+		IncidentType:    incident.IncidentType,
+		Status:          incident.Status,
+	}
+}
+
+func lockIncident(ctx context.Context, tx *sqlx.Tx, id uuid.UUID) (*Incident, error) {
+	var incident Incident
+	err := tx.GetContext(ctx, &incident,
+		`SELECT id, station_id, line_id, ts, duration_minutes, incident_type, status, source, created_at
+		 FROM incidents WHERE id = $1 FOR UPDATE`, id)
+	if err != nil {
+		return nil, err
+	}
 	return &incident, nil
 }
 
+func writeIncidentAudit(ctx context.Context, tx *sqlx.Tx, incidentID uuid.UUID, action string, oldValues, newValues *incidentSnapshot) error {
+	var oldJSON, newJSON []byte
+	var err error
+	if oldValues != nil {
+		if oldJSON, err = json.Marshal(oldValues); err != nil {
+			return err
+		}
+	}
+	if newValues != nil {
+		if newJSON, err = json.Marshal(newValues); err != nil {
+			return err
+		}
+	}
+
+	_, err = tx.ExecContext(ctx,
+		`INSERT INTO incident_audit (incident_id, action, old_values, new_values)
+		 VALUES ($1, $2, $3, $4)`,
+		incidentID, action, nullableJSON(oldJSON), nullableJSON(newJSON))
+	return err
+}
+
+func nullableJSON(data []byte) interface{} {
+	if data == nil {
+		return nil
+	}
+	return string(data)
+}
+
 func (r *Repository) GetIncidentWithDetails(ctx context.Context, incidentID uuid.UUID) (*IncidentWithDetails, error) {
+	defer r.logSlowQuery(ctx, "GetIncidentWithDetails", time.Now())
+
 	var incident IncidentWithDetails
 	err := r.db.GetContext(ctx, &incident,
-		`SELECT i.id, i.station_id, i.line_id, i.ts, i.duration_minutes, i.incident_type, i.status,
-		        l.name as line_name, s.name as station_name
+		`SELECT i.id, i.station_id, i.line_id, i.ts, i.duration_minutes, i.incident_type, i.status, i.source, i.created_at,
+		        l.name as line_name, s.name as station_name,
+		        ARRAY(SELECT t.name FROM incident_tags it JOIN tags t ON it.tag_id = t.id
+		              WHERE it.incident_id = i.id ORDER BY t.name) as tags
 		 FROM incidents i
 		 JOIN lines l ON i.line_id = l.id
 		 JOIN stations s ON i.station_id = s.id
@@ -121,136 +685,1059 @@ func (r *Repository) GetIncidentWithDetails(ctx context.Context, incidentID uuid
 	return &incident, nil
 }
 
-func (r *Repository) GetTopBreakdownsByLine(ctx context.Context, limit int32) ([]BreakdownCount, error) {
+// liveStation is the predicate analytics queries use to skip incidents at
+// soft-deleted stations. It refers to the incidents table as i. With
+// includeDeleted it matches every incident. Every analytics query takes
+// includeDeleted; those that list stations themselves also drop deleted
+// station rows unless it is set.
+func liveStation(includeDeleted bool) string {
+	if includeDeleted {
+		return "TRUE"
+	}
+	return "EXISTS (SELECT 1 FROM stations live WHERE live.id = i.station_id AND live.deleted_at IS NULL)"
+}
+
+// GetTopBreakdownsByLine ranks lines by incident count and returns the
+// newest incident timestamp the ranking covers. Incidents at soft-deleted
+// stations are not counted unless includeDeleted is set.
+func (r *Repository) GetTopBreakdownsByLine(ctx context.Context, limit int32, includeDeleted bool) ([]BreakdownCount, *time.Time, error) {
+	defer r.logSlowQuery(ctx, "GetTopBreakdownsByLine", time.Now())
+
+	latest, err := r.latestIncidentTime(ctx, includeDeleted)
+	if err != nil {
+		return nil, nil, err
+	}
+
 	var results []BreakdownCount
-	err := r.db.SelectContext(ctx, &results,
+	err = r.readDB.SelectContext(ctx, &results,
 		`SELECT l.name, COUNT(i.id)::int as count
 		 FROM lines l
-		 LEFT JOIN incidents i ON l.id = i.line_id
+		 LEFT JOIN incidents i ON l.id = i.line_id AND `+liveStation(includeDeleted)+`
 		 GROUP BY l.name
-		 ORDER BY count DESC
+		 ORDER BY count DESC, l.name ASC
 		 LIMIT $1`,
 		limit)
 	if err != nil {
-		return nil, fmt.Errorf("%w: %v", ErrDatabaseError, err)
+		return nil, nil, fmt.Errorf("%w: %v", ErrDatabaseError, err)
 	}
-	return results, nil
+	return results, latest, nil
 }
 
-func (r *Repository) GetTopBreakdownsByStation(ctx context.Context, limit int32) ([]BreakdownCount, error) {
+// GetTopBreakdownsByStation ranks stations by incident count and returns the
+// newest incident timestamp the ranking covers. When lineID is set only that
+// line's stations are ranked. Soft-deleted stations are left out unless
+// includeDeleted is set.
+func (r *Repository) GetTopBreakdownsByStation(ctx context.Context, limit int32, lineID *uuid.UUID, includeDeleted bool) ([]BreakdownCount, *time.Time, error) {
+	defer r.logSlowQuery(ctx, "GetTopBreakdownsByStation", time.Now())
+
+	latest, err := r.latestIncidentTime(ctx, includeDeleted)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	where := "WHERE TRUE"
+	args := []interface{}{limit}
+	if !includeDeleted {
+		where += " AND s.deleted_at IS NULL"
+	}
+	if lineID != nil {
+		where += " AND s.line_id = $2"
+		args = append(args, *lineID)
+	}
+
 	var results []BreakdownCount
-	err := r.db.SelectContext(ctx, &results,
+	err = r.readDB.SelectContext(ctx, &results,
 		`SELECT s.name, COUNT(i.id)::int as count
 		 FROM stations s
 		 LEFT JOIN incidents i ON s.id = i.station_id
+		 `+where+`
 		 GROUP BY s.name
-		 ORDER BY count DESC
+		 ORDER BY count DESC, s.name ASC
 		 LIMIT $1`,
-		limit)
+		args...)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w: %v", ErrDatabaseError, err)
+	}
+	return results, latest, nil
+}
+
+// latestIncidentTime returns the newest incident timestamp visible to
+// analytics queries, or nil when there are no incidents. Incidents at
+// soft-deleted stations are skipped unless includeDeleted is set. Callers
+// read it before their own query, so it never names an incident their
+// results miss.
+func (r *Repository) latestIncidentTime(ctx context.Context, includeDeleted bool) (*time.Time, error) {
+	defer r.logSlowQuery(ctx, "latestIncidentTime", time.Now())
+
+	var latest *time.Time
+	err := r.readDB.GetContext(ctx, &latest, "SELECT MAX(i.ts) FROM incidents i WHERE "+liveStation(includeDeleted))
 	if err != nil {
 		return nil, fmt.Errorf("%w: %v", ErrDatabaseError, err)
 	}
-	return results, nil
+	return latest, nil
 }
 
-func (r *Repository) CalculateMTBF(ctx context.Context) ([]MTBFResult, error) {
+// CalculateMTBF returns each line's mean minutes between consecutive
+// incidents and the newest incident timestamp they cover. Incidents at
+// soft-deleted stations are skipped unless includeDeleted is set.
+func (r *Repository) CalculateMTBF(ctx context.Context, includeDeleted bool) ([]MTBFResult, *time.Time, error) {
+	defer r.logSlowQuery(ctx, "CalculateMTBF", time.Now())
+
+	latest, err := r.latestIncidentTime(ctx, includeDeleted)
+	if err != nil {
+		return nil, nil, err
+	}
+
 	var results []MTBFResult
+	// The window is partitioned on incidents.line_id so Postgres can read rows
+	// in (line_id, ts) index order instead of sorting the whole table; line
+	// names are joined in only after aggregation.
 	query := `
 		WITH line_incidents AS (
 			SELECT
-				l.name as line_name,
+				i.line_id,
 				i.ts,
-				LAG(i.ts) OVER (PARTITION BY l.id ORDER BY i.ts) as prev_ts
+				LAG(i.ts) OVER (PARTITION BY i.line_id ORDER BY i.ts) as prev_ts
 			FROM incidents i
-			JOIN lines l ON i.line_id = l.id
-			ORDER BY l.id, i.ts
+			WHERE ` + liveStation(includeDeleted) + `
 		),
 		time_deltas AS (
 			SELECT
-				line_name,
+				line_id,
 				EXTRACT(EPOCH FROM (ts - prev_ts)) / 60.0 as minutes_between
 			FROM line_incidents
 			WHERE prev_ts IS NOT NULL
 		),
 		line_stats AS (
 			SELECT
-				line_name,
+				line_id,
 				COUNT(*) as incident_count,
 				AVG(minutes_between) as avg_minutes_between
 			FROM time_deltas
-			GROUP BY line_name
+			GROUP BY line_id
+		)
+		SELECT
+			l.name as line_name,
+			ROUND(ls.avg_minutes_between::numeric, 2)::float8 as mtbf_minutes
+		FROM line_stats ls
+		JOIN lines l ON l.id = ls.line_id
+		WHERE ls.incident_count >= 1
+		ORDER BY l.name`
+
+	err = r.readDB.SelectContext(ctx, &results, query)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w: %v", ErrDatabaseError, err)
+	}
+	return results, latest, nil
+}
+
+// GetStationMTBF returns the incident count, total downtime and mean minutes
+// between consecutive incidents at a single station. MTBFMinutes is nil when the
+// station has fewer than two incidents.
+func (r *Repository) GetStationMTBF(ctx context.Context, stationID uuid.UUID) (*StationMTBF, error) {
+	defer r.logSlowQuery(ctx, "GetStationMTBF", time.Now())
+
+	var result StationMTBF
+	err := r.readDB.GetContext(ctx, &result,
+		`WITH station_incidents AS (
+			SELECT
+				i.ts,
+				i.duration_minutes,
+				LAG(i.ts) OVER (ORDER BY i.ts) as prev_ts
+			FROM incidents i
+			WHERE i.station_id = $1
+		)
+		SELECT
+			s.name as station_name,
+			l.name as line_name,
+			(SELECT COUNT(*) FROM station_incidents)::int as incident_count,
+			(SELECT COALESCE(SUM(duration_minutes), 0) FROM station_incidents)::int as downtime_minutes,
+			(SELECT ROUND(AVG(EXTRACT(EPOCH FROM (ts - prev_ts)) / 60.0)::numeric, 2)::float8
+			 FROM station_incidents
+			 WHERE prev_ts IS NOT NULL) as mtbf_minutes
+		FROM stations s
+		JOIN lines l ON l.id = s.line_id
+		WHERE s.id = $1 AND s.deleted_at IS NULL`,
+		stationID)
+	if err == sql.ErrNoRows {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrDatabaseError, err)
+	}
+	return &result, nil
+}
+
+// GetIncidentIntervals returns, per line, the minutes since the previous
+// incident and the duration of each incident after the first in the range,
+// ordered by line name and time.
+func (r *Repository) GetIncidentIntervals(ctx context.Context, lineName string, start, end time.Time, includeDeleted bool) ([]IncidentInterval, error) {
+	defer r.logSlowQuery(ctx, "GetIncidentIntervals", time.Now())
+
+	var results []IncidentInterval
+	err := r.readDB.SelectContext(ctx, &results,
+		`WITH line_incidents AS (
+			SELECT
+				l.name as line_name,
+				i.ts,
+				i.duration_minutes,
+				LAG(i.ts) OVER (PARTITION BY i.line_id ORDER BY i.ts) as prev_ts
+			FROM incidents i
+			JOIN lines l ON l.id = i.line_id
+			WHERE i.ts >= $1 AND i.ts < $2
+			  AND ($3 = '' OR l.name = $3)
+			  AND `+liveStation(includeDeleted)+`
+		)
+		SELECT
+			line_name,
+			(EXTRACT(EPOCH FROM (ts - prev_ts)) / 60.0)::float8 as minutes_between,
+			duration_minutes
+		FROM line_incidents
+		WHERE prev_ts IS NOT NULL
+		ORDER BY line_name, ts`,
+		start, end, lineName)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrDatabaseError, err)
+	}
+	return results, nil
+}
+
+func (r *Repository) GetRecentDisruptions(ctx context.Context, filter IncidentFilter, limit int32) ([]IncidentWithDetails, error) {
+	defer r.logSlowQuery(ctx, "GetRecentDisruptions", time.Now())
+
+	var results []IncidentWithDetails
+
+	query := `
+		SELECT i.id, i.station_id, i.line_id, i.ts, i.duration_minutes, i.incident_type, i.status, i.source, i.created_at,
+		       l.name as line_name, s.name as station_name
+		FROM incidents i
+		JOIN lines l ON i.line_id = l.id
+		JOIN stations s ON i.station_id = s.id
+		WHERE 1=1`
+
+	if !filter.IncludeDeleted {
+		query += " AND s.deleted_at IS NULL"
+	}
+
+	args := []interface{}{}
+	argPos := 1
+
+	if len(filter.LineNames) > 0 {
+		query += fmt.Sprintf(" AND l.name = ANY($%d)", argPos)
+		args = append(args, pq.Array(filter.LineNames))
+		argPos++
+	} else if filter.LineName != "" {
+		query += fmt.Sprintf(" AND l.name = $%d", argPos)
+		args = append(args, filter.LineName)
+		argPos++
+	}
+
+	if filter.StationName != "" {
+		query += fmt.Sprintf(" AND s.name = $%d", argPos)
+		args = append(args, filter.StationName)
+		argPos++
+	}
+
+	if filter.StationID != nil {
+		query += fmt.Sprintf(" AND i.station_id = $%d", argPos)
+		args = append(args, *filter.StationID)
+		argPos++
+	}
+
+	if filter.Source != "" {
+		query += fmt.Sprintf(" AND i.source = $%d", argPos)
+		args = append(args, filter.Source)
+		argPos++
+	}
+
+	if filter.MinDuration != nil {
+		query += fmt.Sprintf(" AND i.duration_minutes >= $%d", argPos)
+		args = append(args, *filter.MinDuration)
+		argPos++
+	}
+
+	if filter.MaxDuration != nil {
+		query += fmt.Sprintf(" AND i.duration_minutes <= $%d", argPos)
+		args = append(args, *filter.MaxDuration)
+		argPos++
+	}
+
+	if filter.OnlyActive {
+		query += fmt.Sprintf(" AND i.status != $%d", argPos)
+		args = append(args, filter.ResolvedStatus)
+		argPos++
+	}
+
+	column := filter.TimeField.column()
+
+	if filter.Start != nil {
+		query += fmt.Sprintf(" AND %s >= $%d", column, argPos)
+		args = append(args, *filter.Start)
+		argPos++
+	}
+
+	if filter.End != nil {
+		query += fmt.Sprintf(" AND %s < $%d", column, argPos)
+		args = append(args, *filter.End)
+		argPos++
+	}
+
+	query += " ORDER BY " + filter.Sort.orderBy(column)
+
+	if limit > 0 {
+		query += fmt.Sprintf(" LIMIT $%d", argPos)
+		args = append(args, limit)
+	}
+
+	err := r.readDB.SelectContext(ctx, &results, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrDatabaseError, err)
+	}
+	return results, nil
+}
+
+func (r *Repository) GetSegmentIncidentCounts(ctx context.Context, lineName string, stationNames []string, start, end *time.Time, includeDeleted bool) ([]BreakdownCount, error) {
+	defer r.logSlowQuery(ctx, "GetSegmentIncidentCounts", time.Now())
+
+	var results []BreakdownCount
+
+	join := "LEFT JOIN incidents i ON i.station_id = s.id"
+	args := []interface{}{lineName, pq.Array(stationNames), includeDeleted}
+	argPos := 4
+
+	if start != nil {
+		join += fmt.Sprintf(" AND i.ts >= $%d", argPos)
+		args = append(args, *start)
+		argPos++
+	}
+
+	if end != nil {
+		join += fmt.Sprintf(" AND i.ts < $%d", argPos)
+		args = append(args, *end)
+	}
+
+	query := `
+		SELECT s.name, COUNT(i.id)::int as count
+		FROM stations s
+		JOIN lines l ON s.line_id = l.id
+		` + join + `
+		WHERE l.name = $1 AND s.name = ANY($2)
+		  AND ($3 OR s.deleted_at IS NULL)
+		GROUP BY s.name`
+
+	err := r.readDB.SelectContext(ctx, &results, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrDatabaseError, err)
+	}
+	return results, nil
+}
+
+func (r *Repository) GetIncidentTypeCountsByLine(ctx context.Context, start, end *time.Time, includeDeleted bool) ([]LineTypeCount, error) {
+	defer r.logSlowQuery(ctx, "GetIncidentTypeCountsByLine", time.Now())
+
+	var results []LineTypeCount
+
+	join := "LEFT JOIN incidents i ON i.line_id = l.id AND " + liveStation(includeDeleted)
+	args := []interface{}{}
+	argPos := 1
+
+	if start != nil {
+		join += fmt.Sprintf(" AND i.ts >= $%d", argPos)
+		args = append(args, *start)
+		argPos++
+	}
+
+	if end != nil {
+		join += fmt.Sprintf(" AND i.ts < $%d", argPos)
+		args = append(args, *end)
+	}
+
+	query := `
+		SELECT l.name as line_name, i.incident_type, COUNT(i.id)::int as count
+		FROM lines l
+		` + join + `
+		GROUP BY l.name, i.incident_type
+		ORDER BY l.name`
+
+	err := r.readDB.SelectContext(ctx, &results, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrDatabaseError, err)
+	}
+	return results, nil
+}
+
+func (r *Repository) GetLineIncidentTypeCounts(ctx context.Context, lineID uuid.UUID, start, end time.Time, includeDeleted bool) ([]TypeCount, error) {
+	defer r.logSlowQuery(ctx, "GetLineIncidentTypeCounts", time.Now())
+
+	var results []TypeCount
+	err := r.readDB.SelectContext(ctx, &results,
+		`SELECT i.incident_type, COUNT(*)::int as count
+		 FROM incidents i
+		 WHERE i.line_id = $1 AND i.ts >= $2 AND i.ts < $3
+		   AND `+liveStation(includeDeleted)+`
+		 GROUP BY i.incident_type
+		 ORDER BY i.incident_type`,
+		lineID, start, end)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrDatabaseError, err)
+	}
+	return results, nil
+}
+
+func (r *Repository) GetLastIncidentTimes(ctx context.Context, lineName string, includeDeleted bool) ([]LineLastIncident, error) {
+	defer r.logSlowQuery(ctx, "GetLastIncidentTimes", time.Now())
+
+	var results []LineLastIncident
+	err := r.readDB.SelectContext(ctx, &results,
+		`SELECT l.name as line_name, MAX(i.ts) as last_incident_at
+		 FROM lines l
+		 LEFT JOIN incidents i ON i.line_id = l.id AND `+liveStation(includeDeleted)+`
+		 WHERE ($1 = '' OR l.name = $1)
+		 GROUP BY l.name
+		 ORDER BY l.name`,
+		lineName)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrDatabaseError, err)
+	}
+	return results, nil
+}
+
+// GetTopIncidentTypeByLine returns one row per line holding its most frequent
+// incident type in the range, ties broken alphabetically. Lines without
+// incidents get a NULL type and a count of zero.
+func (r *Repository) GetTopIncidentTypeByLine(ctx context.Context, start, end time.Time, includeDeleted bool) ([]LineTypeCount, error) {
+	defer r.logSlowQuery(ctx, "GetTopIncidentTypeByLine", time.Now())
+
+	var results []LineTypeCount
+	err := r.readDB.SelectContext(ctx, &results,
+		`SELECT DISTINCT ON (l.name)
+		        l.name as line_name, i.incident_type, COUNT(i.id)::int as count
+		 FROM lines l
+		 LEFT JOIN incidents i ON i.line_id = l.id AND i.ts >= $1 AND i.ts < $2
+		   AND `+liveStation(includeDeleted)+`
+		 GROUP BY l.name, i.incident_type
+		 ORDER BY l.name, count DESC, i.incident_type`,
+		start, end)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrDatabaseError, err)
+	}
+	return results, nil
+}
+
+func (r *Repository) GetIncidentStatusCounts(ctx context.Context, lineName string, start, end *time.Time, includeDeleted bool) ([]StatusCount, error) {
+	defer r.logSlowQuery(ctx, "GetIncidentStatusCounts", time.Now())
+
+	var results []StatusCount
+
+	query := `
+		SELECT i.status, COUNT(*)::int as count
+		FROM incidents i
+		JOIN lines l ON i.line_id = l.id
+		WHERE ($1 = '' OR l.name = $1)
+		  AND ` + liveStation(includeDeleted)
+	args := []interface{}{lineName}
+	argPos := 2
+
+	if start != nil {
+		query += fmt.Sprintf(" AND i.ts >= $%d", argPos)
+		args = append(args, *start)
+		argPos++
+	}
+
+	if end != nil {
+		query += fmt.Sprintf(" AND i.ts < $%d", argPos)
+		args = append(args, *end)
+	}
+
+	query += " GROUP BY i.status ORDER BY i.status"
+
+	err := r.readDB.SelectContext(ctx, &results, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrDatabaseError, err)
+	}
+	return results, nil
+}
+
+// GetLongestIncidents returns each line's longest incident in the range,
+// breaking ties by the most recent, longest first. Lines without incidents in
+// the range are omitted.
+func (r *Repository) GetLongestIncidents(ctx context.Context, start, end time.Time, includeDeleted bool) ([]IncidentWithDetails, error) {
+	defer r.logSlowQuery(ctx, "GetLongestIncidents", time.Now())
+
+	var results []IncidentWithDetails
+	err := r.readDB.SelectContext(ctx, &results,
+		`SELECT * FROM (
+			SELECT DISTINCT ON (i.line_id)
+			       i.id, i.station_id, i.line_id, i.ts, i.duration_minutes, i.incident_type, i.status, i.source, i.created_at,
+			       l.name as line_name, s.name as station_name
+			FROM incidents i
+			JOIN lines l ON i.line_id = l.id
+			JOIN stations s ON i.station_id = s.id
+			WHERE i.ts >= $1 AND i.ts < $2
+			  AND `+liveStation(includeDeleted)+`
+			ORDER BY i.line_id, i.duration_minutes DESC, i.ts DESC
+		) longest
+		ORDER BY duration_minutes DESC, line_name`,
+		start, end)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrDatabaseError, err)
+	}
+	return results, nil
+}
+
+// GetConcurrentIncidents returns pairs of incidents on the same line that
+// both start in [start, end) and whose [ts, ts+duration) intervals overlap,
+// ordered by when the overlap begins. Zero-length incidents never overlap.
+func (r *Repository) GetConcurrentIncidents(ctx context.Context, lineName string, start, end time.Time, limit int32, includeDeleted bool) ([]ConcurrentIncidentPair, error) {
+	defer r.logSlowQuery(ctx, "GetConcurrentIncidents", time.Now())
+
+	var results []ConcurrentIncidentPair
+	err := r.readDB.SelectContext(ctx, &results,
+		`WITH spans AS (
+			SELECT i.id, i.station_id, i.line_id, i.ts, i.duration_minutes, i.incident_type,
+			       i.status, i.source, i.created_at, l.name as line_name, s.name as station_name,
+			       tstzrange(i.ts, i.ts + make_interval(mins => i.duration_minutes)) as span
+			FROM incidents i
+			JOIN lines l ON i.line_id = l.id
+			JOIN stations s ON i.station_id = s.id
+			WHERE i.ts >= $1 AND i.ts < $2
+			  AND ($3 = '' OR l.name = $3)
+			  AND `+liveStation(includeDeleted)+`
+		)
+		SELECT
+			a.id as "first.id", a.station_id as "first.station_id", a.line_id as "first.line_id",
+			a.ts as "first.ts", a.duration_minutes as "first.duration_minutes",
+			a.incident_type as "first.incident_type", a.status as "first.status",
+			a.source as "first.source", a.created_at as "first.created_at",
+			a.line_name as "first.line_name", a.station_name as "first.station_name",
+			b.id as "second.id", b.station_id as "second.station_id", b.line_id as "second.line_id",
+			b.ts as "second.ts", b.duration_minutes as "second.duration_minutes",
+			b.incident_type as "second.incident_type", b.status as "second.status",
+			b.source as "second.source", b.created_at as "second.created_at",
+			b.line_name as "second.line_name", b.station_name as "second.station_name",
+			lower(a.span * b.span) as overlap_start,
+			upper(a.span * b.span) as overlap_end
+		FROM spans a
+		JOIN spans b ON b.line_id = a.line_id
+		 AND (b.ts, b.id) > (a.ts, a.id)
+		 AND a.span && b.span
+		ORDER BY overlap_start, a.line_name, a.ts, b.ts
+		LIMIT $4`,
+		start, end, lineName, limit)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrDatabaseError, err)
+	}
+	return results, nil
+}
+
+// GetNetworkOverview returns the network-wide dashboard totals for incidents
+// in [start, end). The aggregates are independent, so each runs as its own
+// query on the read pool concurrently with the others. MTBF is the mean gap
+// between consecutive incidents on the same line, pooled across all lines.
+//
+// A failing query does not fail the call: its section is listed in Failures
+// and its fields are left zero, so the caller decides whether a partial
+// overview is acceptable.
+func (r *Repository) GetNetworkOverview(ctx context.Context, start, end time.Time, includeDeleted bool) (*NetworkOverview, error) {
+	defer r.logSlowQuery(ctx, "GetNetworkOverview", time.Now())
+
+	var overview NetworkOverview
+	sections := []struct {
+		name  string
+		query func() error
+	}{
+		{OverviewSectionLines, func() error {
+			return r.readDB.GetContext(ctx, &overview.TotalLines, "SELECT COUNT(*)::int FROM lines")
+		}},
+		{OverviewSectionStations, func() error {
+			return r.readDB.GetContext(ctx, &overview.TotalStations,
+				"SELECT COUNT(*)::int FROM stations WHERE $1 OR deleted_at IS NULL", includeDeleted)
+		}},
+		{OverviewSectionIncidents, func() error {
+			return r.readDB.QueryRowxContext(ctx,
+				`SELECT COUNT(*)::int, COALESCE(SUM(i.duration_minutes), 0)::bigint
+				 FROM incidents i
+				 WHERE i.ts >= $1 AND i.ts < $2
+				   AND `+liveStation(includeDeleted), start, end).
+				Scan(&overview.TotalIncidents, &overview.DowntimeMinutes)
+		}},
+		{OverviewSectionMTBF, func() error {
+			return r.readDB.GetContext(ctx, &overview.MTBFMinutes,
+				`SELECT ROUND(AVG(minutes_between)::numeric, 2)::float8
+				 FROM (
+					SELECT EXTRACT(EPOCH FROM (i.ts - LAG(i.ts) OVER (PARTITION BY i.line_id ORDER BY i.ts))) / 60.0 as minutes_between
+					FROM incidents i
+					WHERE i.ts >= $1 AND i.ts < $2
+					  AND `+liveStation(includeDeleted)+`
+				 ) gaps`, start, end)
+		}},
+	}
+
+	errs := make([]error, len(sections))
+	var wg sync.WaitGroup
+	for i, section := range sections {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			errs[i] = section.query()
+		}()
+	}
+	wg.Wait()
+
+	for i, err := range errs {
+		if err != nil {
+			overview.Failures = append(overview.Failures, SectionFailure{
+				Section: sections[i].name,
+				Err:     fmt.Errorf("%w: %w", ErrDatabaseError, err),
+			})
+		}
+	}
+	return &overview, nil
+}
+
+// GetDurationPercentiles returns the p50, p90, p95 and p99 incident
+// durations of each line with incidents in [start, end), ordered by line
+// name.
+func (r *Repository) GetDurationPercentiles(ctx context.Context, start, end time.Time, includeDeleted bool) ([]LineDurationPercentiles, error) {
+	defer r.logSlowQuery(ctx, "GetDurationPercentiles", time.Now())
+
+	var results []LineDurationPercentiles
+	err := r.readDB.SelectContext(ctx, &results,
+		`SELECT l.name as line_name,
+		        p.incident_count,
+		        ROUND(p.p50::numeric, 2)::float8 as p50_minutes,
+		        ROUND(p.p90::numeric, 2)::float8 as p90_minutes,
+		        ROUND(p.p95::numeric, 2)::float8 as p95_minutes,
+		        ROUND(p.p99::numeric, 2)::float8 as p99_minutes
+		 FROM (
+			SELECT i.line_id,
+			       COUNT(*)::int as incident_count,
+			       percentile_cont(0.50) WITHIN GROUP (ORDER BY i.duration_minutes) as p50,
+			       percentile_cont(0.90) WITHIN GROUP (ORDER BY i.duration_minutes) as p90,
+			       percentile_cont(0.95) WITHIN GROUP (ORDER BY i.duration_minutes) as p95,
+			       percentile_cont(0.99) WITHIN GROUP (ORDER BY i.duration_minutes) as p99
+			FROM incidents i
+			WHERE i.ts >= $1 AND i.ts < $2
+			  AND `+liveStation(includeDeleted)+`
+			GROUP BY i.line_id
+		 ) p
+		 JOIN lines l ON l.id = p.line_id
+		 ORDER BY l.name`,
+		start, end)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrDatabaseError, err)
+	}
+	return results, nil
+}
+
+// GetLongestQuietPeriods returns, for each line with at least two incidents
+// in [start, end), the longest gap between consecutive incident timestamps,
+// longest first. Of equally long gaps the earliest is reported.
+func (r *Repository) GetLongestQuietPeriods(ctx context.Context, start, end time.Time, includeDeleted bool) ([]LineQuietPeriod, error) {
+	defer r.logSlowQuery(ctx, "GetLongestQuietPeriods", time.Now())
+
+	var results []LineQuietPeriod
+	err := r.readDB.SelectContext(ctx, &results,
+		`SELECT l.name as line_name,
+		        ROUND((EXTRACT(EPOCH FROM (q.end_time - q.start_time)) / 60.0)::numeric, 2)::float8 as gap_minutes,
+		        q.start_time,
+		        q.end_time
+		 FROM (
+			SELECT DISTINCT ON (line_id) line_id, prev_ts as start_time, ts as end_time
+			FROM (
+				SELECT i.line_id, i.ts, LAG(i.ts) OVER (PARTITION BY i.line_id ORDER BY i.ts) as prev_ts
+				FROM incidents i
+				WHERE i.ts >= $1 AND i.ts < $2
+				  AND `+liveStation(includeDeleted)+`
+			) gaps
+			WHERE prev_ts IS NOT NULL
+			ORDER BY line_id, ts - prev_ts DESC, prev_ts
+		 ) q
+		 JOIN lines l ON l.id = q.line_id
+		 ORDER BY gap_minutes DESC, l.name`,
+		start, end)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrDatabaseError, err)
+	}
+	return results, nil
+}
+
+// GetSLABreaches returns, for each line with incidents in [start, end), how
+// many lasted longer than thresholdMinutes, highest breach rate first.
+func (r *Repository) GetSLABreaches(ctx context.Context, thresholdMinutes int32, start, end time.Time, includeDeleted bool) ([]LineSLABreaches, error) {
+	defer r.logSlowQuery(ctx, "GetSLABreaches", time.Now())
+
+	var results []LineSLABreaches
+	err := r.readDB.SelectContext(ctx, &results,
+		`SELECT l.name as line_name,
+		        COUNT(*)::int as total_incidents,
+		        COUNT(*) FILTER (WHERE i.duration_minutes > $1)::int as breaches,
+		        ROUND((COUNT(*) FILTER (WHERE i.duration_minutes > $1))::numeric / COUNT(*), 4)::float8 as breach_rate
+		 FROM incidents i
+		 JOIN lines l ON l.id = i.line_id
+		 WHERE i.ts >= $2 AND i.ts < $3
+		   AND `+liveStation(includeDeleted)+`
+		 GROUP BY l.name
+		 ORDER BY breach_rate DESC, l.name`,
+		thresholdMinutes, start, end)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrDatabaseError, err)
+	}
+	return results, nil
+}
+
+func (r *Repository) GetIncidentTypeCountsByHour(ctx context.Context, lineName string, start, end time.Time, includeDeleted bool) ([]HourTypeCount, error) {
+	defer r.logSlowQuery(ctx, "GetIncidentTypeCountsByHour", time.Now())
+
+	var results []HourTypeCount
+	err := r.readDB.SelectContext(ctx, &results,
+		`SELECT EXTRACT(HOUR FROM i.ts AT TIME ZONE 'UTC')::int as hour,
+		        i.incident_type,
+		        COUNT(*)::int as count
+		 FROM incidents i
+		 JOIN lines l ON i.line_id = l.id
+		 WHERE i.ts >= $1 AND i.ts < $2
+		   AND ($3 = '' OR l.name = $3)
+		   AND `+liveStation(includeDeleted)+`
+		 GROUP BY hour, i.incident_type
+		 ORDER BY hour, i.incident_type`,
+		start, end, lineName)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrDatabaseError, err)
+	}
+	return results, nil
+}
+
+func (r *Repository) GetRollingIncidentAverage(ctx context.Context, lineName string, start, end time.Time, includeDeleted bool) ([]DailyRollingAverage, error) {
+	defer r.logSlowQuery(ctx, "GetRollingIncidentAverage", time.Now())
+
+	var results []DailyRollingAverage
+
+	// Days are generated from six days before start so the first rows in range
+	// still average over a full trailing window.
+	query := `
+		WITH days AS (
+			SELECT generate_series(
+				date_trunc('day', $1::timestamptz) - interval '6 days',
+				date_trunc('day', $2::timestamptz),
+				interval '1 day') as day
+		),
+		daily AS (
+			SELECT i.line_id, date_trunc('day', i.ts) as day, COUNT(*) as cnt
+			FROM incidents i
+			WHERE i.ts >= date_trunc('day', $1::timestamptz) - interval '6 days'
+			  AND i.ts < date_trunc('day', $2::timestamptz) + interval '1 day'
+			  AND ` + liveStation(includeDeleted) + `
+			GROUP BY i.line_id, date_trunc('day', i.ts)
+		),
+		filled AS (
+			SELECT l.name as line_name, d.day, COALESCE(daily.cnt, 0) as cnt
+			FROM lines l
+			CROSS JOIN days d
+			LEFT JOIN daily ON daily.line_id = l.id AND daily.day = d.day
+			WHERE $3 = '' OR l.name = $3
+		),
+		averaged AS (
+			SELECT
+				line_name,
+				day,
+				cnt,
+				AVG(cnt) OVER (PARTITION BY line_name ORDER BY day ROWS BETWEEN 6 PRECEDING AND CURRENT ROW) as rolling_average
+			FROM filled
 		)
 		SELECT
 			line_name,
-			ROUND(avg_minutes_between::numeric, 2)::float8 as mtbf_minutes
-		FROM line_stats
-		WHERE incident_count >= 1
-		ORDER BY line_name`
+			day,
+			cnt::int as count,
+			ROUND(rolling_average::numeric, 2)::float8 as rolling_average
+		FROM averaged
+		WHERE day >= date_trunc('day', $1::timestamptz)
+		ORDER BY line_name, day`
+
+	err := r.readDB.SelectContext(ctx, &results, query, start, end, lineName)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrDatabaseError, err)
+	}
+	return results, nil
+}
+
+// GetCumulativeIncidents returns one row per day from start to end with that
+// day's incident count and the running total since start. Days without
+// incidents are filled with zero so the running total is continuous. When
+// lineID is set only that line's incidents are counted.
+func (r *Repository) GetCumulativeIncidents(ctx context.Context, lineID *uuid.UUID, start, end time.Time, includeDeleted bool) ([]DailyCumulativeCount, error) {
+	defer r.logSlowQuery(ctx, "GetCumulativeIncidents", time.Now())
+
+	var results []DailyCumulativeCount
+
+	lineFilter := ""
+	args := []interface{}{start, end}
+	if lineID != nil {
+		lineFilter = "AND i.line_id = $3"
+		args = append(args, *lineID)
+	}
+
+	query := `
+		WITH days AS (
+			SELECT generate_series(
+				date_trunc('day', $1::timestamptz),
+				date_trunc('day', $2::timestamptz),
+				interval '1 day') as day
+		),
+		daily AS (
+			SELECT date_trunc('day', i.ts) as day, COUNT(*) as cnt
+			FROM incidents i
+			WHERE i.ts >= $1 AND i.ts < $2
+			  AND ` + liveStation(includeDeleted) + `
+			  ` + lineFilter + `
+			GROUP BY date_trunc('day', i.ts)
+		)
+		SELECT
+			d.day,
+			COALESCE(daily.cnt, 0)::int as count,
+			SUM(COALESCE(daily.cnt, 0)) OVER (ORDER BY d.day)::int as cumulative_count
+		FROM days d
+		LEFT JOIN daily ON daily.day = d.day
+		ORDER BY d.day`
+
+	err := r.readDB.SelectContext(ctx, &results, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrDatabaseError, err)
+	}
+	return results, nil
+}
+
+func (r *Repository) GetBusiestPeriod(ctx context.Context, lineName string, start, end time.Time, window time.Duration, includeDeleted bool) (*BusiestPeriod, error) {
+	defer r.logSlowQuery(ctx, "GetBusiestPeriod", time.Now())
+
+	var period BusiestPeriod
+
+	// Windows are aligned to the Unix epoch, so a one-hour window matches
+	// date_trunc('hour', ts) in UTC.
+	err := r.readDB.GetContext(ctx, &period,
+		`SELECT
+			to_timestamp(floor(extract(epoch FROM i.ts) / $4) * $4) as window_start,
+			COUNT(*)::int as incident_count,
+			COALESCE(SUM(i.duration_minutes), 0)::int as total_downtime_minutes
+		 FROM incidents i
+		 JOIN lines l ON i.line_id = l.id
+		 WHERE l.name = $1
+		   AND i.ts >= $2
+		   AND i.ts < $3
+		   AND `+liveStation(includeDeleted)+`
+		 GROUP BY 1
+		 ORDER BY incident_count DESC, total_downtime_minutes DESC, window_start
+		 LIMIT 1`,
+		lineName, start, end, int64(window/time.Second))
+	if err == sql.ErrNoRows {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrDatabaseError, err)
+	}
+	return &period, nil
+}
+
+func (r *Repository) GetStationIncidentAggregates(ctx context.Context, lineName string, start, end time.Time, includeDeleted bool) ([]StationIncidentAggregate, error) {
+	defer r.logSlowQuery(ctx, "GetStationIncidentAggregates", time.Now())
 
-	err := r.db.SelectContext(ctx, &results, query)
+	var results []StationIncidentAggregate
+	err := r.readDB.SelectContext(ctx, &results,
+		`SELECT s.name as station_name, l.name as line_name,
+		        COUNT(i.id)::int as incident_count,
+		        COALESCE(SUM(i.duration_minutes), 0)::int as total_downtime_minutes
+		 FROM stations s
+		 JOIN lines l ON s.line_id = l.id
+		 LEFT JOIN incidents i ON i.station_id = s.id AND i.ts >= $1 AND i.ts < $2
+		 WHERE ($3 = '' OR l.name = $3)
+		   AND ($4 OR s.deleted_at IS NULL)
+		 GROUP BY s.id, s.name, l.name
+		 ORDER BY total_downtime_minutes DESC, incident_count DESC, s.name`,
+		start, end, lineName, includeDeleted)
 	if err != nil {
 		return nil, fmt.Errorf("%w: %v", ErrDatabaseError, err)
 	}
 	return results, nil
 }
 
-func (r *Repository) GetRecentDisruptions(ctx context.Context, lineName, stationName string, limit int32) ([]IncidentWithDetails, error) {
-	var results []IncidentWithDetails
+// GetIncidentsDuringMaintenance counts, per station, the incidents that fell
+// inside a maintenance window. A window runs from a change to 'maintenance'
+// until the station's next recorded status change, or is still open.
+func (r *Repository) GetIncidentsDuringMaintenance(ctx context.Context, lineName string, start, end time.Time, includeDeleted bool) ([]StationIncidentAggregate, error) {
+	defer r.logSlowQuery(ctx, "GetIncidentsDuringMaintenance", time.Now())
 
-	query := `
-		SELECT i.id, i.station_id, i.line_id, i.ts, i.duration_minutes, i.incident_type, i.status,
-		       l.name as line_name, s.name as station_name
-		FROM incidents i
-		JOIN lines l ON i.line_id = l.id
+	var results []StationIncidentAggregate
+	err := r.readDB.SelectContext(ctx, &results,
+		`WITH changes AS (
+			SELECT station_id, status, changed_at,
+			       LEAD(changed_at) OVER (PARTITION BY station_id ORDER BY changed_at) as next_changed_at
+			FROM station_status_history
+		),
+		windows AS (
+			SELECT station_id, changed_at as started_at,
+			       COALESCE(next_changed_at, 'infinity'::timestamptz) as ended_at
+			FROM changes
+			WHERE status = 'maintenance'
+		)
+		SELECT s.name as station_name, l.name as line_name,
+		       COUNT(i.id)::int as incident_count,
+		       COALESCE(SUM(i.duration_minutes), 0)::int as total_downtime_minutes
+		FROM windows w
+		JOIN incidents i ON i.station_id = w.station_id
+		                AND i.ts >= w.started_at AND i.ts < w.ended_at
 		JOIN stations s ON i.station_id = s.id
-		WHERE 1=1`
+		JOIN lines l ON s.line_id = l.id
+		WHERE i.ts >= $1 AND i.ts < $2
+		  AND ($3 = '' OR l.name = $3)
+		  AND ($4 OR s.deleted_at IS NULL)
+		GROUP BY s.id, s.name, l.name
+		ORDER BY incident_count DESC, s.name`,
+		start, end, lineName, includeDeleted)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrDatabaseError, err)
+	}
+	return results, nil
+}
 
-	args := []interface{}{}
-	argPos := 1
+func (r *Repository) GetIncidentsForMap(ctx context.Context, lineName string, start, end time.Time, includeMissingGeo, includeDeleted bool) ([]MapIncident, error) {
+	defer r.logSlowQuery(ctx, "GetIncidentsForMap", time.Now())
 
-	if lineName != "" {
-		query += fmt.Sprintf(" AND l.name = $%d", argPos)
-		args = append(args, lineName)
-		argPos++
+	var results []MapIncident
+	err := r.readDB.SelectContext(ctx, &results,
+		`SELECT i.id, l.name as line_name, s.name as station_name, i.ts,
+		        i.duration_minutes, i.incident_type, i.status, s.latitude, s.longitude
+		 FROM incidents i
+		 JOIN lines l ON i.line_id = l.id
+		 JOIN stations s ON i.station_id = s.id
+		 WHERE i.ts >= $1 AND i.ts < $2
+		   AND ($3 = '' OR l.name = $3)
+		   AND ($4 OR (s.latitude IS NOT NULL AND s.longitude IS NOT NULL))
+		   AND ($5 OR s.deleted_at IS NULL)
+		 ORDER BY i.ts DESC`,
+		start, end, lineName, includeMissingGeo, includeDeleted)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrDatabaseError, err)
 	}
+	return results, nil
+}
 
-	if stationName != "" {
-		query += fmt.Sprintf(" AND s.name = $%d", argPos)
-		args = append(args, stationName)
-		argPos++
+// GetStationStatusCounts counts the stations of every line by status, using
+// each station's primary line and skipping deleted stations. A line without
+// stations has a single row with a nil status and zero count. Missing or
+// blank statuses are counted as active.
+func (r *Repository) GetStationStatusCounts(ctx context.Context) ([]LineStationStatusCount, error) {
+	defer r.logSlowQuery(ctx, "GetStationStatusCounts", time.Now())
+
+	var results []LineStationStatusCount
+	err := r.readDB.SelectContext(ctx, &results,
+		`SELECT l.id as line_id, l.name as line_name,
+		     CASE WHEN s.id IS NULL THEN NULL ELSE COALESCE(NULLIF(s.status, ''), 'active') END as status,
+		     COUNT(s.id)::int as count
+		 FROM lines l
+		 LEFT JOIN stations s ON s.line_id = l.id AND s.deleted_at IS NULL
+		 GROUP BY l.id, l.name, 3
+		 ORDER BY l.display_order NULLS LAST, l.name`)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrDatabaseError, err)
 	}
+	return results, nil
+}
 
-	query += " ORDER BY i.ts DESC"
+func (r *Repository) GetStationLineComparison(ctx context.Context, stationID uuid.UUID, start, end time.Time) (*StationLineComparison, error) {
+	defer r.logSlowQuery(ctx, "GetStationLineComparison", time.Now())
 
-	if limit > 0 {
-		query += fmt.Sprintf(" LIMIT $%d", argPos)
-		args = append(args, limit)
+	var result StationLineComparison
+	err := r.readDB.GetContext(ctx, &result,
+		`WITH per_station AS (
+			SELECT s.id,
+			       COUNT(i.id)::int as incident_count,
+			       COALESCE(SUM(i.duration_minutes), 0)::int as total_downtime_minutes
+			FROM stations s
+			LEFT JOIN incidents i ON i.station_id = s.id AND i.ts >= $2 AND i.ts < $3
+			WHERE s.line_id = (SELECT line_id FROM stations WHERE id = $1)
+			  AND s.deleted_at IS NULL
+			GROUP BY s.id
+		),
+		line_avg AS (
+			SELECT COUNT(*)::int as line_station_count,
+			       AVG(incident_count)::float8 as line_avg_incident_count,
+			       AVG(total_downtime_minutes)::float8 as line_avg_downtime_minutes
+			FROM per_station
+		)
+		SELECT s.name as station_name, l.name as line_name,
+		       ps.incident_count, ps.total_downtime_minutes,
+		       la.line_station_count, la.line_avg_incident_count, la.line_avg_downtime_minutes
+		FROM per_station ps
+		JOIN stations s ON s.id = ps.id
+		JOIN lines l ON l.id = s.line_id
+		CROSS JOIN line_avg la
+		WHERE ps.id = $1`,
+		stationID, start, end)
+	if err == sql.ErrNoRows {
+		return nil, ErrNotFound
 	}
-
-	err := r.db.SelectContext(ctx, &results, query, args...)
 	if err != nil {
 		return nil, fmt.Errorf("%w: %v", ErrDatabaseError, err)
 	}
-	return results, nil
+	return &result, nil
 }
 
-func (r *Repository) CreateLine(ctx context.Context, name string) (*Line, error) {
+// CreateLine creates a line, or returns the existing line with that name after
+// applying whichever of attrs are set to it.
+func (r *Repository) CreateLine(ctx context.Context, name string, attrs LineAttributes) (*Line, error) {
+	defer r.logSlowQuery(ctx, "CreateLine", time.Now())
+
 	var line Line
-	err := r.db.GetContext(ctx, &line,
-		`INSERT INTO lines (name) VALUES ($1)
-		 ON CONFLICT (name) DO UPDATE SET name = EXCLUDED.name
-		 RETURNING id, name, created_at`,
-		name)
+	err := r.withRetry(ctx, func() error {
+		return r.db.GetContext(ctx, &line,
+			`INSERT INTO lines (name, color, display_order) VALUES ($1, NULLIF($2, ''), $3)
+			 ON CONFLICT (name) DO UPDATE
+			 SET color = COALESCE(EXCLUDED.color, lines.color),
+			     display_order = COALESCE(EXCLUDED.display_order, lines.display_order)
+			 RETURNING id, name, color, display_order, created_at`,
+			name, attrs.Color, attrs.DisplayOrder)
+	})
+	if isUniqueViolation(err) {
+		return nil, ErrAlreadyExists
+	}
 	if err != nil {
 		return nil, fmt.Errorf("%w: %v", ErrDatabaseError, err)
 	}
 	return &line, nil
 }
 
-func (r *Repository) ListLines(ctx context.Context) ([]Line, error) {
+// ListLines returns lines whose name contains nameContains (case-insensitive),
+// or all lines when it is empty, in the given order. With includeStats each
+// line also carries its incident count, downtime, MTBF and latest incident
+// time, at the cost of aggregating over incidents.
+func (r *Repository) ListLines(ctx context.Context, nameContains string, includeStats bool, order LineSort) ([]Line, error) {
+	defer r.logSlowQuery(ctx, "ListLines", time.Now())
+
+	if order.By.needsStats() && !includeStats {
+		return nil, fmt.Errorf("%w: sorting lines by %s requires stats", ErrInvalidInput, order.By)
+	}
+
+	query := `SELECT l.id, l.name, l.color, l.display_order, l.created_at FROM lines l
+		 WHERE ($1 = '' OR l.name ILIKE '%' || $1 || '%')
+		 ORDER BY ` + order.orderBy()
+	if includeStats {
+		// The mean gap between consecutive incidents telescopes to the span
+		// between the first and last divided by the number of gaps.
+		query = `SELECT l.id, l.name, l.color, l.display_order, l.created_at,
+		     COUNT(i.id)::int as incident_count, MAX(i.ts) as last_incident_at,
+		     COALESCE(SUM(i.duration_minutes), 0)::int as downtime_minutes,
+		     CASE WHEN COUNT(i.id) > 1 THEN
+		         ROUND((EXTRACT(EPOCH FROM (MAX(i.ts) - MIN(i.ts))) / 60.0 / (COUNT(i.id) - 1))::numeric, 2)::float8
+		     END as mtbf_minutes
+		 FROM lines l
+		 LEFT JOIN incidents i ON i.line_id = l.id
+		 WHERE ($1 = '' OR l.name ILIKE '%' || $1 || '%')
+		 GROUP BY l.id
+		 ORDER BY ` + order.orderBy()
+	}
+
 	var lines []Line
-	err := r.db.SelectContext(ctx, &lines,
-		"SELECT id, name, created_at FROM lines ORDER BY name")
+	err := r.readDB.SelectContext(ctx, &lines, query, escapeLike(nameContains))
 	if err != nil {
 		return nil, fmt.Errorf("%w: %v", ErrDatabaseError, err)
 	}
@@ -258,9 +1745,11 @@ func (r *Repository) ListLines(ctx context.Context) ([]Line, error) {
 }
 
 func (r *Repository) GetLine(ctx context.Context, id uuid.UUID) (*Line, error) {
+	defer r.logSlowQuery(ctx, "GetLine", time.Now())
+
 	var line Line
 	err := r.db.GetContext(ctx, &line,
-		"SELECT id, name, created_at FROM lines WHERE id = $1", id)
+		"SELECT id, name, color, display_order, created_at FROM lines WHERE id = $1", id)
 	if err == sql.ErrNoRows {
 		return nil, ErrNotFound
 	}
@@ -270,14 +1759,26 @@ func (r *Repository) GetLine(ctx context.Context, id uuid.UUID) (*Line, error) {
 	return &line, nil
 }
 
-func (r *Repository) UpdateLine(ctx context.Context, id uuid.UUID, name string) (*Line, error) {
+func (r *Repository) UpdateLine(ctx context.Context, id uuid.UUID, name string, attrs LineAttributes) (*Line, error) {
+	defer r.logSlowQuery(ctx, "UpdateLine", time.Now())
+
 	var line Line
-	err := r.db.GetContext(ctx, &line,
-		"UPDATE lines SET name = $1 WHERE id = $2 RETURNING id, name, created_at",
-		name, id)
+	err := r.withRetry(ctx, func() error {
+		return r.db.GetContext(ctx, &line,
+			`UPDATE lines
+			 SET name = $1,
+			     color = CASE WHEN $2::text IS NULL THEN color ELSE NULLIF($2, '') END,
+			     display_order = COALESCE($3, display_order)
+			 WHERE id = $4
+			 RETURNING id, name, color, display_order, created_at`,
+			name, attrs.Color, attrs.DisplayOrder, id)
+	})
 	if err == sql.ErrNoRows {
 		return nil, ErrNotFound
 	}
+	if isUniqueViolation(err) {
+		return nil, ErrAlreadyExists
+	}
 	if err != nil {
 		return nil, fmt.Errorf("%w: %v", ErrDatabaseError, err)
 	}
@@ -285,11 +1786,17 @@ func (r *Repository) UpdateLine(ctx context.Context, id uuid.UUID, name string)
 }
 
 func (r *Repository) DeleteLine(ctx context.Context, id uuid.UUID) error {
-	result, err := r.db.ExecContext(ctx, "DELETE FROM lines WHERE id = $1", id)
-	if err != nil {
-		return fmt.Errorf("%w: %v", ErrDatabaseError, err)
-	}
-	rows, err := result.RowsAffected()
+	defer r.logSlowQuery(ctx, "DeleteLine", time.Now())
+
+	var rows int64
+	err := r.withRetry(ctx, func() error {
+		result, err := r.db.ExecContext(ctx, "DELETE FROM lines WHERE id = $1", id)
+		if err != nil {
+			return err
+		}
+		rows, err = result.RowsAffected()
+		return err
+	})
 	if err != nil {
 		return fmt.Errorf("%w: %v", ErrDatabaseError, err)
 	}
@@ -299,72 +1806,291 @@ func (r *Repository) DeleteLine(ctx context.Context, id uuid.UUID) error {
 	return nil
 }
 
-func (r *Repository) CreateStation(ctx context.Context, name string, lineID uuid.UUID, status string) (*StationWithLine, error) {
-	var lineExists bool
-	err := r.db.GetContext(ctx, &lineExists, "SELECT EXISTS(SELECT 1 FROM lines WHERE id = $1)", lineID)
+func (r *Repository) GetLineSummary(ctx context.Context, id uuid.UUID) (*LineSummary, error) {
+	defer r.logSlowQuery(ctx, "GetLineSummary", time.Now())
+
+	line, err := r.GetLine(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	summary := LineSummary{
+		LineID:   line.ID,
+		LineName: line.Name,
+	}
+
+	err = r.db.QueryRowxContext(ctx,
+		`SELECT COUNT(*)::int,
+		        COUNT(*) FILTER (WHERE status = 'active')::int,
+		        COUNT(*) FILTER (WHERE status = 'closed')::int
+		 FROM stations
+		 WHERE line_id = $1 AND deleted_at IS NULL`, id).
+		Scan(&summary.StationCount, &summary.ActiveStationCount, &summary.ClosedStationCount)
 	if err != nil {
 		return nil, fmt.Errorf("%w: %v", ErrDatabaseError, err)
 	}
-	if !lineExists {
-		return nil, ErrNotFound
+
+	err = r.db.QueryRowxContext(ctx,
+		`SELECT COUNT(*)::int, MAX(ts)
+		 FROM incidents
+		 WHERE line_id = $1`, id).
+		Scan(&summary.TotalIncidents, &summary.LastIncidentAt)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrDatabaseError, err)
+	}
+
+	return &summary, nil
+}
+
+// PreviewLineDeletion counts the rows DeleteLine would remove through the
+// foreign-key cascades: stations whose primary line is id, every incident
+// recorded against the line or one of those stations, and secondary
+// station_lines links that would be dropped from stations on other lines.
+func (r *Repository) PreviewLineDeletion(ctx context.Context, id uuid.UUID) (*LineDeletionImpact, error) {
+	defer r.logSlowQuery(ctx, "PreviewLineDeletion", time.Now())
+
+	line, err := r.GetLine(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	impact := LineDeletionImpact{
+		LineID:   line.ID,
+		LineName: line.Name,
+	}
+
+	err = r.db.QueryRowxContext(ctx,
+		`SELECT (SELECT COUNT(*) FROM stations WHERE line_id = $1)::int,
+		        (SELECT COUNT(*)
+		         FROM station_lines sl
+		         JOIN stations s ON sl.station_id = s.id
+		         WHERE sl.line_id = $1 AND s.line_id <> $1)::int,
+		        (SELECT COUNT(*)
+		         FROM incidents i
+		         WHERE i.line_id = $1
+		            OR i.station_id IN (SELECT id FROM stations WHERE line_id = $1))::int`, id).
+		Scan(&impact.StationCount, &impact.SharedStationCount, &impact.IncidentCount)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrDatabaseError, err)
+	}
+
+	return &impact, nil
+}
+
+func (r *Repository) CreateStation(ctx context.Context, name string, lineID uuid.UUID, status string, additionalLineIDs []uuid.UUID, coords *StationCoordinates) (*StationWithLine, error) {
+	defer r.logSlowQuery(ctx, "CreateStation", time.Now())
+
+	lineIDs := []string{lineID.String()}
+	for _, id := range additionalLineIDs {
+		if id != lineID {
+			lineIDs = append(lineIDs, id.String())
+		}
+	}
+
+	var existing int
+	err := r.db.GetContext(ctx, &existing,
+		"SELECT COUNT(*) FROM lines WHERE id = ANY($1::uuid[])", pq.Array(lineIDs))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrDatabaseError, err)
+	}
+	if existing != len(lineIDs) {
+		return nil, ErrLineNotFound
 	}
 
 	if status == "" {
 		status = "active"
 	}
 
+	var latitude, longitude sql.NullFloat64
+	if coords != nil {
+		latitude = sql.NullFloat64{Float64: coords.Latitude, Valid: true}
+		longitude = sql.NullFloat64{Float64: coords.Longitude, Valid: true}
+	}
+
 	var station StationWithLine
-	err = r.db.GetContext(ctx, &station,
-		`INSERT INTO stations (name, line_id, status)
-		 VALUES ($1, $2, $3)
-		 ON CONFLICT (name, line_id) DO UPDATE SET status = EXCLUDED.status
-		 RETURNING id, name, line_id, status, created_at,
-		 (SELECT name FROM lines WHERE id = $2) as line_name`,
-		name, lineID, status)
+	err = r.withRetry(ctx, func() error {
+		tx, err := r.db.BeginTxx(ctx, nil)
+		if err != nil {
+			return err
+		}
+		defer func() { _ = tx.Rollback() }()
+
+		err = tx.GetContext(ctx, &station,
+			`INSERT INTO stations (name, line_id, status, latitude, longitude)
+			 VALUES ($1, $2, $3, $4, $5)
+			 ON CONFLICT (name, line_id) WHERE deleted_at IS NULL DO UPDATE SET
+			     status = EXCLUDED.status,
+			     latitude = COALESCE(EXCLUDED.latitude, stations.latitude),
+			     longitude = COALESCE(EXCLUDED.longitude, stations.longitude)
+			 RETURNING id, name, line_id, status, latitude, longitude, created_at,
+			 (SELECT name FROM lines WHERE id = $2) as line_name`,
+			name, lineID, status, latitude, longitude)
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.ExecContext(ctx,
+			`INSERT INTO station_lines (station_id, line_id)
+			 SELECT $1, unnest($2::uuid[])
+			 ON CONFLICT DO NOTHING`,
+			station.ID, pq.Array(lineIDs))
+		if err != nil {
+			return err
+		}
+
+		if err := recordStationStatus(ctx, tx, station.ID, station.Status); err != nil {
+			return err
+		}
+
+		return tx.Commit()
+	})
+	if isForeignKeyViolation(err) {
+		// A line was deleted between the existence check and the insert.
+		return nil, ErrLineNotFound
+	}
 	if err != nil {
 		return nil, fmt.Errorf("%w: %v", ErrDatabaseError, err)
 	}
+
+	lines, err := r.getStationLines(ctx, r.db, []uuid.UUID{station.ID})
+	if err != nil {
+		return nil, err
+	}
+	station.Lines = lines[station.ID]
+
 	return &station, nil
 }
 
-func (r *Repository) ListStations(ctx context.Context, lineID *uuid.UUID) ([]StationWithLine, error) {
+func (r *Repository) getStationLines(ctx context.Context, q sqlx.QueryerContext, stationIDs []uuid.UUID) (map[uuid.UUID][]StationLineRef, error) {
+	ids := make([]string, len(stationIDs))
+	for i, id := range stationIDs {
+		ids[i] = id.String()
+	}
+
+	var refs []StationLineRef
+	err := sqlx.SelectContext(ctx, q, &refs,
+		`SELECT sl.station_id, sl.line_id, l.name as line_name, (sl.line_id = s.line_id) as is_primary
+		 FROM station_lines sl
+		 JOIN stations s ON sl.station_id = s.id
+		 JOIN lines l ON sl.line_id = l.id
+		 WHERE sl.station_id = ANY($1::uuid[])
+		 ORDER BY is_primary DESC, l.name`,
+		pq.Array(ids))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrDatabaseError, err)
+	}
+
+	result := make(map[uuid.UUID][]StationLineRef, len(stationIDs))
+	for _, ref := range refs {
+		result[ref.StationID] = append(result[ref.StationID], ref)
+	}
+	return result, nil
+}
+
+func (r *Repository) ListStations(ctx context.Context, filter StationFilter, includeIncidentCount bool) ([]StationWithLine, error) {
+	defer r.logSlowQuery(ctx, "ListStations", time.Now())
+
 	var stations []StationWithLine
 	query := `
-		SELECT s.id, s.name, s.line_id, l.name as line_name, s.status, s.created_at
+		SELECT s.id, s.name, s.line_id, l.name as line_name, s.status, s.latitude, s.longitude, s.created_at,
+		       sp.position`
+	if includeIncidentCount {
+		query += `, COUNT(i.id)::int as incident_count`
+	}
+	// Positions are per line: those of the filtered line, else of each
+	// station's primary line. The filter line is always $1.
+	positionLine := "s.line_id"
+	if filter.LineID != nil {
+		positionLine = "$1"
+	}
+	query += `
 		FROM stations s
-		JOIN lines l ON s.line_id = l.id`
+		JOIN lines l ON s.line_id = l.id
+		LEFT JOIN station_lines sp ON sp.station_id = s.id AND sp.line_id = ` + positionLine
+	if includeIncidentCount {
+		query += `
+		LEFT JOIN incidents i ON i.station_id = s.id`
+	}
 
+	query += " WHERE s.deleted_at IS NULL"
 	args := []interface{}{}
-	if lineID != nil {
-		query += " WHERE s.line_id = $1"
-		args = append(args, *lineID)
+	argPos := 1
+	if filter.LineID != nil {
+		query += fmt.Sprintf(` AND (s.line_id = $%d OR EXISTS (
+			SELECT 1 FROM station_lines sl WHERE sl.station_id = s.id AND sl.line_id = $%d))`, argPos, argPos)
+		args = append(args, *filter.LineID)
+		argPos++
+	}
+	if filter.CreatedFrom != nil {
+		query += fmt.Sprintf(" AND s.created_at >= $%d", argPos)
+		args = append(args, *filter.CreatedFrom)
+		argPos++
+	}
+	if filter.CreatedTo != nil {
+		query += fmt.Sprintf(" AND s.created_at <= $%d", argPos)
+		args = append(args, *filter.CreatedTo)
+	}
+	if includeIncidentCount {
+		query += " GROUP BY s.id, l.name, sp.position"
+	}
+	if filter.LineID != nil {
+		query += " ORDER BY sp.position NULLS LAST, s.name"
+	} else {
+		query += " ORDER BY l.name, sp.position NULLS LAST, s.name"
 	}
-	query += " ORDER BY l.name, s.name"
 
-	err := r.db.SelectContext(ctx, &stations, query, args...)
+	err := r.readDB.SelectContext(ctx, &stations, query, args...)
 	if err != nil {
 		return nil, fmt.Errorf("%w: %v", ErrDatabaseError, err)
 	}
+
+	if len(stations) == 0 {
+		return stations, nil
+	}
+
+	stationIDs := make([]uuid.UUID, len(stations))
+	for i := range stations {
+		stationIDs[i] = stations[i].ID
+	}
+	lines, err := r.getStationLines(ctx, r.readDB, stationIDs)
+	if err != nil {
+		return nil, err
+	}
+	for i := range stations {
+		stations[i].Lines = lines[stations[i].ID]
+	}
+
 	return stations, nil
 }
 
 func (r *Repository) GetStation(ctx context.Context, id uuid.UUID) (*StationWithLine, error) {
+	defer r.logSlowQuery(ctx, "GetStation", time.Now())
+
 	var station StationWithLine
 	err := r.db.GetContext(ctx, &station,
-		`SELECT s.id, s.name, s.line_id, l.name as line_name, s.status, s.created_at
+		`SELECT s.id, s.name, s.line_id, l.name as line_name, s.status, s.latitude, s.longitude, s.created_at
 		 FROM stations s
 		 JOIN lines l ON s.line_id = l.id
-		 WHERE s.id = $1`, id)
+		 WHERE s.id = $1 AND s.deleted_at IS NULL`, id)
 	if err == sql.ErrNoRows {
 		return nil, ErrNotFound
 	}
 	if err != nil {
 		return nil, fmt.Errorf("%w: %v", ErrDatabaseError, err)
 	}
+
+	lines, err := r.getStationLines(ctx, r.db, []uuid.UUID{station.ID})
+	if err != nil {
+		return nil, err
+	}
+	station.Lines = lines[station.ID]
+
 	return &station, nil
 }
 
-func (r *Repository) UpdateStation(ctx context.Context, id uuid.UUID, name, status *string) (*StationWithLine, error) {
+func (r *Repository) UpdateStation(ctx context.Context, id uuid.UUID, name, status *string, coords *StationCoordinates) (*StationWithLine, error) {
+	defer r.logSlowQuery(ctx, "UpdateStation", time.Now())
+
 	current, err := r.GetStation(ctx, id)
 	if err != nil {
 		return nil, err
@@ -378,30 +2104,81 @@ func (r *Repository) UpdateStation(ctx context.Context, id uuid.UUID, name, stat
 	if status != nil && *status != "" {
 		newStatus = *status
 	}
+	newLatitude, newLongitude := current.Latitude, current.Longitude
+	if coords != nil {
+		newLatitude, newLongitude = &coords.Latitude, &coords.Longitude
+	}
 
 	var station StationWithLine
-	err = r.db.GetContext(ctx, &station,
-		`UPDATE stations
-		 SET name = $1, status = $2
-		 WHERE id = $3
-		 RETURNING id, name, line_id, status, created_at,
-		 (SELECT name FROM lines WHERE id = line_id) as line_name`,
-		newName, newStatus, id)
+	err = r.withRetry(ctx, func() error {
+		tx, err := r.db.BeginTxx(ctx, nil)
+		if err != nil {
+			return err
+		}
+		defer func() { _ = tx.Rollback() }()
+
+		err = tx.GetContext(ctx, &station,
+			`UPDATE stations
+			 SET name = $1, status = $2, latitude = $3, longitude = $4
+			 WHERE id = $5 AND deleted_at IS NULL
+			 RETURNING id, name, line_id, status, latitude, longitude, created_at,
+			 (SELECT name FROM lines WHERE id = line_id) as line_name`,
+			newName, newStatus, newLatitude, newLongitude, id)
+		if err != nil {
+			return err
+		}
+
+		if err := recordStationStatus(ctx, tx, station.ID, station.Status); err != nil {
+			return err
+		}
+
+		return tx.Commit()
+	})
 	if err == sql.ErrNoRows {
 		return nil, ErrNotFound
 	}
 	if err != nil {
 		return nil, fmt.Errorf("%w: %v", ErrDatabaseError, err)
 	}
+	station.Lines = current.Lines
 	return &station, nil
 }
 
-func (r *Repository) DeleteStation(ctx context.Context, id uuid.UUID) error {
-	result, err := r.db.ExecContext(ctx, "DELETE FROM stations WHERE id = $1", id)
-	if err != nil {
-		return fmt.Errorf("%w: %v", ErrDatabaseError, err)
+// recordStationStatus appends status to the station's history unless it is
+// already the latest recorded status.
+func recordStationStatus(ctx context.Context, tx *sqlx.Tx, stationID uuid.UUID, status string) error {
+	_, err := tx.ExecContext(ctx,
+		`INSERT INTO station_status_history (station_id, status)
+		 SELECT $1, $2
+		 WHERE $2 IS DISTINCT FROM (
+		     SELECT status FROM station_status_history
+		     WHERE station_id = $1
+		     ORDER BY changed_at DESC
+		     LIMIT 1)`,
+		stationID, status)
+	return err
+}
+
+// DeleteStation marks a station as deleted, hiding it from lists and lookups
+// while keeping its incidents. With force the row is removed instead, which
+// cascades to its incidents and also purges an already soft-deleted station.
+func (r *Repository) DeleteStation(ctx context.Context, id uuid.UUID, force bool) error {
+	defer r.logSlowQuery(ctx, "DeleteStation", time.Now())
+
+	query := "UPDATE stations SET deleted_at = NOW() WHERE id = $1 AND deleted_at IS NULL"
+	if force {
+		query = "DELETE FROM stations WHERE id = $1"
 	}
-	rows, err := result.RowsAffected()
+
+	var rows int64
+	err := r.withRetry(ctx, func() error {
+		result, err := r.db.ExecContext(ctx, query, id)
+		if err != nil {
+			return err
+		}
+		rows, err = result.RowsAffected()
+		return err
+	})
 	if err != nil {
 		return fmt.Errorf("%w: %v", ErrDatabaseError, err)
 	}
@@ -410,3 +2187,293 @@ func (r *Repository) DeleteStation(ctx context.Context, id uuid.UUID) error {
 	}
 	return nil
 }
+
+// MergeStations moves every incident and secondary line link from source to
+// target in one transaction, then deletes source as DeleteStation would, and
+// returns how many incidents were moved. Both stations must exist and share a
+// primary line. An incident that would duplicate one already recorded at
+// target (same line and timestamp) aborts the merge with ErrAlreadyExists.
+func (r *Repository) MergeStations(ctx context.Context, sourceID, targetID uuid.UUID, force bool) (int64, error) {
+	defer r.logSlowQuery(ctx, "MergeStations", time.Now())
+
+	var moved int64
+	err := r.withRetry(ctx, func() error {
+		tx, err := r.db.BeginTxx(ctx, nil)
+		if err != nil {
+			return err
+		}
+		defer func() { _ = tx.Rollback() }()
+
+		var stations []Station
+		err = tx.SelectContext(ctx, &stations,
+			`SELECT id, name, line_id, status, created_at
+			 FROM stations
+			 WHERE id IN ($1, $2) AND deleted_at IS NULL
+			 ORDER BY id
+			 FOR UPDATE`,
+			sourceID, targetID)
+		if err != nil {
+			return err
+		}
+		if len(stations) != 2 {
+			return ErrNotFound
+		}
+		if stations[0].LineID != stations[1].LineID {
+			return ErrStationLineMismatch
+		}
+
+		result, err := tx.ExecContext(ctx,
+			"UPDATE incidents SET station_id = $1 WHERE station_id = $2", targetID, sourceID)
+		if err != nil {
+			return err
+		}
+		if moved, err = result.RowsAffected(); err != nil {
+			return err
+		}
+
+		_, err = tx.ExecContext(ctx,
+			`INSERT INTO station_lines (station_id, line_id)
+			 SELECT $1, line_id FROM station_lines WHERE station_id = $2
+			 ON CONFLICT DO NOTHING`,
+			targetID, sourceID)
+		if err != nil {
+			return err
+		}
+
+		deleteQuery := "UPDATE stations SET deleted_at = NOW() WHERE id = $1"
+		if force {
+			deleteQuery = "DELETE FROM stations WHERE id = $1"
+		}
+		if _, err := tx.ExecContext(ctx, deleteQuery, sourceID); err != nil {
+			return err
+		}
+
+		return tx.Commit()
+	})
+	if err == ErrNotFound || err == ErrStationLineMismatch {
+		return 0, err
+	}
+	if isUniqueViolation(err) {
+		return 0, ErrAlreadyExists
+	}
+	if err != nil {
+		return 0, fmt.Errorf("%w: %v", ErrDatabaseError, err)
+	}
+	return moved, nil
+}
+
+// ReorderStations sets the sequence of stations along a line: stationIDs[0]
+// gets position 0 and so on. Stations of the line left out of stationIDs lose
+// their position and are listed after the ordered ones. Every station must be
+// on the line, as primary or secondary line, and not deleted.
+func (r *Repository) ReorderStations(ctx context.Context, lineID uuid.UUID, stationIDs []uuid.UUID) error {
+	defer r.logSlowQuery(ctx, "ReorderStations", time.Now())
+
+	ids := make([]string, len(stationIDs))
+	for i, id := range stationIDs {
+		ids[i] = id.String()
+	}
+
+	err := r.withRetry(ctx, func() error {
+		tx, err := r.db.BeginTxx(ctx, nil)
+		if err != nil {
+			return err
+		}
+		defer func() { _ = tx.Rollback() }()
+
+		var lineExists bool
+		if err := tx.GetContext(ctx, &lineExists, "SELECT EXISTS (SELECT 1 FROM lines WHERE id = $1)", lineID); err != nil {
+			return err
+		}
+		if !lineExists {
+			return ErrLineNotFound
+		}
+
+		var onLine int
+		err = tx.GetContext(ctx, &onLine,
+			`SELECT COUNT(*)
+			 FROM station_lines sl
+			 JOIN stations s ON s.id = sl.station_id
+			 WHERE sl.line_id = $1 AND sl.station_id = ANY($2::uuid[]) AND s.deleted_at IS NULL`,
+			lineID, pq.Array(ids))
+		if err != nil {
+			return err
+		}
+		if onLine != len(ids) {
+			return ErrStationLineMismatch
+		}
+
+		_, err = tx.ExecContext(ctx,
+			"UPDATE station_lines SET position = NULL WHERE line_id = $1", lineID)
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.ExecContext(ctx,
+			`UPDATE station_lines sl SET position = p.ord - 1
+			 FROM unnest($2::uuid[]) WITH ORDINALITY AS p(station_id, ord)
+			 WHERE sl.line_id = $1 AND sl.station_id = p.station_id`,
+			lineID, pq.Array(ids))
+		if err != nil {
+			return err
+		}
+
+		return tx.Commit()
+	})
+	if err == ErrLineNotFound || err == ErrStationLineMismatch {
+		return err
+	}
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrDatabaseError, err)
+	}
+	return nil
+}
+
+// RefreshSummaries recomputes the materialized summary views. CONCURRENTLY
+// keeps them readable during the refresh.
+func (r *Repository) RefreshSummaries(ctx context.Context) error {
+	defer r.logSlowQuery(ctx, "RefreshSummaries", time.Now())
+
+	err := r.withRetry(ctx, func() error {
+		_, err := r.db.ExecContext(ctx, "REFRESH MATERIALIZED VIEW CONCURRENTLY monthly_line_incidents")
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrDatabaseError, err)
+	}
+	return nil
+}
+
+// NormalizeStationStatuses sets a NULL or blank station status to 'active'
+// and returns how many rows changed. The current schema forbids both, so this
+// only finds rows in databases that predate the constraint.
+func (r *Repository) NormalizeStationStatuses(ctx context.Context) (int64, error) {
+	defer r.logSlowQuery(ctx, "NormalizeStationStatuses", time.Now())
+
+	var rows int64
+	err := r.withRetry(ctx, func() error {
+		result, err := r.db.ExecContext(ctx,
+			"UPDATE stations SET status = 'active' WHERE status IS NULL OR TRIM(status) = ''")
+		if err != nil {
+			return err
+		}
+		rows, err = result.RowsAffected()
+		return err
+	})
+	if err != nil {
+		return 0, fmt.Errorf("%w: %v", ErrDatabaseError, err)
+	}
+	return rows, nil
+}
+
+// FindOrphanedIncidents lists incidents whose line_id or station_id does not
+// resolve to an existing row. The foreign keys should make this impossible, so
+// any result points to rows deleted with constraints disabled.
+func (r *Repository) FindOrphanedIncidents(ctx context.Context, limit int32) ([]OrphanedIncident, error) {
+	defer r.logSlowQuery(ctx, "FindOrphanedIncidents", time.Now())
+
+	var results []OrphanedIncident
+	err := r.readDB.SelectContext(ctx, &results,
+		`SELECT i.id, i.station_id, i.line_id, i.ts, i.incident_type, i.status,
+		        l.id IS NULL as missing_line, s.id IS NULL as missing_station
+		 FROM incidents i
+		 LEFT JOIN lines l ON i.line_id = l.id
+		 LEFT JOIN stations s ON i.station_id = s.id
+		 WHERE l.id IS NULL OR s.id IS NULL
+		 ORDER BY i.ts DESC
+		 LIMIT $1`,
+		limit)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrDatabaseError, err)
+	}
+	return results, nil
+}
+
+func (r *Repository) StreamIncidents(ctx context.Context, start, end *time.Time, timeField TimeField, fn func(*IncidentWithDetails) error) error {
+	query := `
+		SELECT i.id, i.station_id, i.line_id, i.ts, i.duration_minutes, i.incident_type, i.status, i.source, i.created_at,
+		       l.name as line_name, s.name as station_name
+		FROM incidents i
+		JOIN lines l ON i.line_id = l.id
+		JOIN stations s ON i.station_id = s.id
+		WHERE 1=1`
+
+	args := []interface{}{}
+	argPos := 1
+	column := timeField.column()
+
+	if start != nil {
+		query += fmt.Sprintf(" AND %s >= $%d", column, argPos)
+		args = append(args, *start)
+		argPos++
+	}
+
+	if end != nil {
+		query += fmt.Sprintf(" AND %s < $%d", column, argPos)
+		args = append(args, *end)
+	}
+
+	query += " ORDER BY " + column
+
+	rows, err := r.readDB.QueryxContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrDatabaseError, err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		var incident IncidentWithDetails
+		if err := rows.StructScan(&incident); err != nil {
+			return fmt.Errorf("%w: %v", ErrDatabaseError, err)
+		}
+		if err := fn(&incident); err != nil {
+			return err
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("%w: %v", ErrDatabaseError, err)
+	}
+	return nil
+}
+
+// Ping checks that the primary database and, when configured, the read
+// replica accept connections.
+func (r *Repository) Ping(ctx context.Context) error {
+	if err := r.db.PingContext(ctx); err != nil {
+		return fmt.Errorf("%w: primary: %v", ErrDatabaseError, err)
+	}
+	if r.readDB != r.db {
+		if err := r.readDB.PingContext(ctx); err != nil {
+			return fmt.Errorf("%w: replica: %v", ErrDatabaseError, err)
+		}
+	}
+	return nil
+}
+
+// GetSchemaVersion returns the migration state recorded by RunMigrations, or
+// nil when the schema_migrations table does not exist because the schema was
+// created some other way, such as database/init.sql.
+func (r *Repository) GetSchemaVersion(ctx context.Context) (*SchemaVersion, error) {
+	defer r.logSlowQuery(ctx, "GetSchemaVersion", time.Now())
+
+	var exists bool
+	if err := r.db.GetContext(ctx, &exists, "SELECT to_regclass('schema_migrations') IS NOT NULL"); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrDatabaseError, err)
+	}
+	if !exists {
+		return nil, nil
+	}
+
+	var version SchemaVersion
+	err := r.db.GetContext(ctx, &version, "SELECT version, dirty FROM schema_migrations LIMIT 1")
+	if err == sql.ErrNoRows {
+		return &SchemaVersion{}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrDatabaseError, err)
+	}
+	return &version, nil
+}
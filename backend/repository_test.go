@@ -0,0 +1,871 @@
+package backend
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsUniqueViolation(t *testing.T) {
+	assert.True(t, isUniqueViolation(&pq.Error{Code: "23505"}))
+	assert.False(t, isUniqueViolation(&pq.Error{Code: "23503"}))
+	assert.False(t, isUniqueViolation(sql.ErrNoRows))
+	assert.False(t, isUniqueViolation(nil))
+}
+
+func TestEscapeLike(t *testing.T) {
+	assert.Equal(t, "circle", escapeLike("circle"))
+	assert.Equal(t, `100\%`, escapeLike("100%"))
+	assert.Equal(t, `north\_south`, escapeLike("north_south"))
+	assert.Equal(t, `a\\b`, escapeLike(`a\b`))
+}
+
+func TestNewRepository_ReadReplica(t *testing.T) {
+	primary := sqlx.NewDb(&sql.DB{}, "postgres")
+	replica := sqlx.NewDb(&sql.DB{}, "postgres")
+
+	repo := NewRepository(primary, nil, RepositoryConfig{})
+	assert.Same(t, primary, repo.readDB)
+
+	repo = NewRepository(primary, replica, RepositoryConfig{})
+	assert.Same(t, primary, repo.db)
+	assert.Same(t, replica, repo.readDB)
+}
+
+func openTestDB(tb testing.TB) *sqlx.DB {
+	dsn := os.Getenv("TEST_DATABASE_URL")
+	if dsn == "" {
+		tb.Skip("TEST_DATABASE_URL not set")
+	}
+
+	db, err := sqlx.Connect("postgres", dsn)
+	if err != nil {
+		tb.Fatalf("connect: %v", err)
+	}
+	tb.Cleanup(func() { db.Close() })
+	return db
+}
+
+// newTestLineStation creates a line and an active station on it, both named
+// after prefix, and removes them when the test ends.
+func newTestLineStation(t *testing.T, repo *Repository, prefix string) (*Line, *StationWithLine) {
+	t.Helper()
+	ctx := context.Background()
+
+	suffix := time.Now().Format("150405.000000")
+	line, err := repo.CreateLine(ctx, prefix+" "+suffix, LineAttributes{})
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = repo.DeleteLine(ctx, line.ID) })
+	station, err := repo.CreateStation(ctx, prefix+" station "+suffix, line.ID, "active", nil, nil)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = repo.DeleteStation(ctx, station.ID, true) })
+	return line, station
+}
+
+func TestRepositoryWithRetry_FeedsBreaker(t *testing.T) {
+	breaker := NewCircuitBreaker(CircuitBreakerConfig{FailureThreshold: 2, Cooldown: time.Hour}, nil)
+	repo := NewRepository(nil, nil, RepositoryConfig{Breaker: breaker})
+	ctx := context.Background()
+
+	connErr := &pq.Error{Code: "08006"}
+	_ = repo.withRetry(ctx, func() error { return connErr })
+	_ = repo.withRetry(ctx, func() error { return &pq.Error{Code: "23505"} })
+	_ = repo.withRetry(ctx, func() error { return connErr })
+	assert.True(t, breaker.Allow(ctx), "a non-transient error resets the count")
+
+	_ = repo.withRetry(ctx, func() error { return connErr })
+	assert.False(t, breaker.Allow(ctx))
+}
+
+func TestGetTopBreakdownsByLine_StableOrdering(t *testing.T) {
+	repo := NewRepository(openTestDB(t), nil, RepositoryConfig{})
+	ctx := context.Background()
+
+	suffix := time.Now().Format("150405.000000")
+	for _, name := range []string{"zz tie c " + suffix, "zz tie a " + suffix, "zz tie b " + suffix} {
+		line, err := repo.CreateLine(ctx, name, LineAttributes{})
+		require.NoError(t, err)
+		t.Cleanup(func() { _ = repo.DeleteLine(ctx, line.ID) })
+	}
+
+	for i := 0; i < 3; i++ {
+		results, _, err := repo.GetTopBreakdownsByLine(ctx, 1000, false)
+		require.NoError(t, err)
+
+		for j := 1; j < len(results); j++ {
+			prev, cur := results[j-1], results[j]
+			if prev.Count == cur.Count {
+				assert.Less(t, prev.Name, cur.Name, "lines with equal counts must be ordered by name")
+			} else {
+				assert.Greater(t, prev.Count, cur.Count)
+			}
+		}
+	}
+}
+
+func TestDeleteStation_SoftDeleteFreesName(t *testing.T) {
+	repo := NewRepository(openTestDB(t), nil, RepositoryConfig{})
+	ctx := context.Background()
+
+	line, err := repo.CreateLine(ctx, "soft delete "+time.Now().Format("150405.000000"), LineAttributes{})
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = repo.DeleteLine(ctx, line.ID) })
+
+	first, err := repo.CreateStation(ctx, "Reused", line.ID, "active", nil, nil)
+	require.NoError(t, err)
+	require.NoError(t, repo.DeleteStation(ctx, first.ID, false))
+
+	_, err = repo.GetStation(ctx, first.ID)
+	assert.Equal(t, ErrNotFound, err)
+	assert.Equal(t, ErrNotFound, repo.DeleteStation(ctx, first.ID, false))
+
+	second, err := repo.CreateStation(ctx, "Reused", line.ID, "active", nil, nil)
+	require.NoError(t, err)
+	assert.NotEqual(t, first.ID, second.ID)
+
+	require.NoError(t, repo.DeleteStation(ctx, first.ID, true))
+}
+
+func TestReadiness_PingAndSchemaVersion(t *testing.T) {
+	repo := NewRepository(openTestDB(t), nil, RepositoryConfig{})
+	ctx := context.Background()
+
+	require.NoError(t, repo.Ping(ctx))
+
+	version, err := repo.GetSchemaVersion(ctx)
+	require.NoError(t, err)
+	if version != nil {
+		assert.False(t, version.Dirty)
+	}
+}
+
+func TestCreateIncident_StrictInsert(t *testing.T) {
+	db := openTestDB(t)
+	repo := NewRepository(db, nil, RepositoryConfig{})
+	strict := NewRepository(db, nil, RepositoryConfig{StrictIncidentInsert: true})
+	ctx := context.Background()
+
+	line, err := repo.CreateLine(ctx, "strict insert "+time.Now().Format("150405.000000"), LineAttributes{})
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = repo.DeleteLine(ctx, line.ID) })
+	station, err := repo.CreateStation(ctx, "Strict", line.ID, "active", nil, nil)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = repo.DeleteStation(ctx, station.ID, true) })
+
+	ts := time.Now().UTC().Add(-time.Hour).Truncate(time.Second)
+	_, err = strict.CreateIncident(ctx, station.ID, line.ID, ts, 10, "power", "open", "")
+	require.NoError(t, err)
+
+	_, err = strict.CreateIncident(ctx, station.ID, line.ID, ts, 20, "power", "open", "")
+	assert.Equal(t, ErrAlreadyExists, err)
+
+	updated, err := repo.CreateIncident(ctx, station.ID, line.ID, ts, 20, "power", "open", "")
+	require.NoError(t, err)
+	assert.Equal(t, int32(20), updated.DurationMinutes)
+}
+
+func TestCreateIncidentWithOptions(t *testing.T) {
+	db := openTestDB(t)
+	strict := NewRepository(db, nil, RepositoryConfig{StrictIncidentInsert: true})
+	ctx := context.Background()
+
+	line, err := strict.CreateLine(ctx, "auto status "+time.Now().Format("150405.000000"), LineAttributes{})
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = strict.DeleteLine(ctx, line.ID) })
+	station, err := strict.CreateStation(ctx, "Auto Status", line.ID, "active", nil, nil)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = strict.DeleteStation(ctx, station.ID, true) })
+
+	ts := time.Now().UTC().Add(-time.Hour).Truncate(time.Second)
+	_, err = strict.CreateIncident(ctx, station.ID, line.ID, ts, 90, "power", "open", "")
+	require.NoError(t, err)
+
+	// A rejected incident must not leave the station status changed.
+	opts := IncidentCreateOptions{StationStatus: "maintenance", Tags: []string{"auto-status"}}
+	_, err = strict.CreateIncidentWithOptions(ctx, station.ID, line.ID, ts, 90, "power", "open", "", opts)
+	assert.Equal(t, ErrAlreadyExists, err)
+	unchanged, err := strict.GetStation(ctx, station.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "active", unchanged.Status)
+
+	result, err := strict.CreateIncidentWithOptions(ctx, station.ID, line.ID, ts.Add(time.Minute), 90, "power", "open", "", opts)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"auto-status"}, result.Tags)
+	assert.True(t, result.StationStatusUpdated)
+	updated, err := strict.GetStation(ctx, station.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "maintenance", updated.Status)
+	details, err := strict.GetIncidentWithDetails(ctx, result.Incident.ID)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"auto-status"}, []string(details.Tags))
+}
+
+func TestCreateIncidentWithOptions_DeletedStation(t *testing.T) {
+	repo := NewRepository(openTestDB(t), nil, RepositoryConfig{})
+	ctx := context.Background()
+
+	line, station := newTestLineStation(t, repo, "status deleted")
+	require.NoError(t, repo.DeleteStation(ctx, station.ID, false))
+
+	opts := IncidentCreateOptions{StationStatus: "maintenance"}
+	result, err := repo.CreateIncidentWithOptions(ctx, station.ID, line.ID, time.Now().Add(-time.Hour), 90, "power", "open", "", opts)
+	require.NoError(t, err)
+	assert.False(t, result.StationStatusUpdated)
+}
+
+func TestCreateIncident_ConfiguredStatus(t *testing.T) {
+	repo := NewRepository(openTestDB(t), nil, RepositoryConfig{})
+	ctx := context.Background()
+
+	line, station := newTestLineStation(t, repo, "custom status")
+
+	// Statuses come from INCIDENT_STATUSES, so the schema must not restrict them.
+	incident, err := repo.CreateIncident(ctx, station.ID, line.ID, time.Now().Add(-time.Hour), 5, "signal", "acknowledged", "")
+	require.NoError(t, err)
+	updated, err := repo.UpdateIncidentStatus(ctx, incident.ID, "done", "resolve")
+	require.NoError(t, err)
+	assert.Equal(t, "done", updated.Status)
+}
+
+func TestCreateIncident_NormalizesToUTC(t *testing.T) {
+	repo := NewRepository(openTestDB(t), nil, RepositoryConfig{})
+	ctx := context.Background()
+
+	line, station := newTestLineStation(t, repo, "utc")
+
+	singapore := time.FixedZone("SGT", 8*60*60)
+	local := time.Date(2024, 1, 15, 16, 30, 0, 0, singapore)
+	want := time.Date(2024, 1, 15, 8, 30, 0, 0, time.UTC)
+
+	created, err := repo.CreateIncident(ctx, station.ID, line.ID, local, 10, "signal", "open", "")
+	require.NoError(t, err)
+	assert.Equal(t, want, created.Timestamp)
+	assert.Equal(t, time.UTC, created.Timestamp.Location())
+
+	stored, err := repo.GetIncidentWithDetails(ctx, created.ID)
+	require.NoError(t, err)
+	assert.True(t, want.Equal(stored.Timestamp), "stored %s, want %s", stored.Timestamp, want)
+}
+
+func TestReassignIncident(t *testing.T) {
+	repo := NewRepository(openTestDB(t), nil, RepositoryConfig{})
+	ctx := context.Background()
+
+	suffix := time.Now().Format("150405.000000")
+	wrong, err := repo.CreateLine(ctx, "reassign wrong "+suffix, LineAttributes{})
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = repo.DeleteLine(ctx, wrong.ID) })
+	right, err := repo.CreateLine(ctx, "reassign right "+suffix, LineAttributes{})
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = repo.DeleteLine(ctx, right.ID) })
+	other, err := repo.CreateLine(ctx, "reassign other "+suffix, LineAttributes{})
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = repo.DeleteLine(ctx, other.ID) })
+
+	station, err := repo.CreateStation(ctx, "Interchange", wrong.ID, "active", []uuid.UUID{right.ID}, nil)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = repo.DeleteStation(ctx, station.ID, true) })
+
+	incident, err := repo.CreateIncident(ctx, station.ID, wrong.ID, time.Now().UTC().Add(-time.Hour), 10, "signal", "open", "")
+	require.NoError(t, err)
+
+	_, err = repo.ReassignIncident(ctx, incident.ID, other.ID)
+	assert.Equal(t, ErrStationLineMismatch, err)
+
+	moved, err := repo.ReassignIncident(ctx, incident.ID, right.ID)
+	require.NoError(t, err)
+	assert.Equal(t, right.ID, moved.LineID)
+	assert.Equal(t, right.Name, moved.LineName)
+}
+
+func TestPurgeIncidentsBefore_RejectsNonPositiveBatch(t *testing.T) {
+	repo := NewRepository(nil, nil, RepositoryConfig{})
+
+	for _, batchSize := range []int{0, -1} {
+		deleted, err := repo.PurgeIncidentsBefore(context.Background(), time.Now(), batchSize)
+		assert.ErrorIs(t, err, ErrInvalidInput)
+		assert.Zero(t, deleted)
+	}
+}
+
+func TestCreateLine_ExistingNameUpdatesAttributes(t *testing.T) {
+	repo := NewRepository(openTestDB(t), nil, RepositoryConfig{})
+	ctx := context.Background()
+	name := "recreate " + time.Now().Format("150405.000000")
+
+	line, err := repo.CreateLine(ctx, name, LineAttributes{Color: ptr("#ff0000"), DisplayOrder: ptr(int32(1))})
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = repo.DeleteLine(ctx, line.ID) })
+
+	again, err := repo.CreateLine(ctx, name, LineAttributes{Color: ptr("#00ff00")})
+	require.NoError(t, err)
+	assert.Equal(t, line.ID, again.ID)
+	assert.Equal(t, ptr("#00ff00"), again.Color)
+	assert.Equal(t, ptr(int32(1)), again.DisplayOrder)
+}
+
+func TestListLines_StatsSortWithoutStats(t *testing.T) {
+	repo := NewRepository(nil, nil, RepositoryConfig{})
+
+	lines, err := repo.ListLines(context.Background(), "", false, LineSort{By: LineSortIncidentCount})
+	assert.ErrorIs(t, err, ErrInvalidInput)
+	assert.Nil(t, lines)
+}
+
+func TestPurgeIncidentsBefore_Batches(t *testing.T) {
+	repo := NewRepository(openTestDB(t), nil, RepositoryConfig{})
+	ctx := context.Background()
+
+	line, station := newTestLineStation(t, repo, "purge")
+
+	old := time.Date(1990, 1, 1, 0, 0, 0, 0, time.UTC)
+	for i := 0; i < 5; i++ {
+		_, err := repo.CreateIncident(ctx, station.ID, line.ID, old.Add(time.Duration(i)*time.Hour), 10, "signal", "resolved", "")
+		require.NoError(t, err)
+	}
+	recent, err := repo.CreateIncident(ctx, station.ID, line.ID, time.Now().UTC().Add(-time.Hour), 10, "signal", "open", "")
+	require.NoError(t, err)
+
+	deleted, err := repo.PurgeIncidentsBefore(ctx, old.AddDate(1, 0, 0), 2)
+	require.NoError(t, err)
+	assert.Equal(t, int64(5), deleted)
+
+	_, err = repo.GetIncidentWithDetails(ctx, recent.ID)
+	assert.NoError(t, err, "incidents after the cutoff are kept")
+}
+
+func TestGetCumulativeIncidents_FillsGapDays(t *testing.T) {
+	repo := NewRepository(openTestDB(t), nil, RepositoryConfig{})
+	ctx := context.Background()
+
+	line, station := newTestLineStation(t, repo, "cumulative")
+
+	start := time.Date(2020, 2, 1, 0, 0, 0, 0, time.UTC)
+	for _, ts := range []time.Time{start.Add(time.Hour), start.Add(2 * time.Hour), start.AddDate(0, 0, 2)} {
+		_, err := repo.CreateIncident(ctx, station.ID, line.ID, ts, 10, "signal", "resolved", "")
+		require.NoError(t, err)
+	}
+
+	results, err := repo.GetCumulativeIncidents(ctx, &line.ID, start, start.AddDate(0, 0, 3), false)
+	require.NoError(t, err)
+	require.Len(t, results, 4)
+	counts := make([]int32, len(results))
+	totals := make([]int32, len(results))
+	for i, r := range results {
+		counts[i] = r.Count
+		totals[i] = r.CumulativeCount
+	}
+	assert.Equal(t, []int32{2, 0, 1, 0}, counts)
+	assert.Equal(t, []int32{2, 2, 3, 3}, totals)
+}
+
+func TestRangeQueries_EndExclusive(t *testing.T) {
+	repo := NewRepository(openTestDB(t), nil, RepositoryConfig{})
+	ctx := context.Background()
+
+	line, station := newTestLineStation(t, repo, "end exclusive")
+
+	start := time.Date(2020, 3, 1, 0, 0, 0, 0, time.UTC)
+	end := start.AddDate(0, 0, 1)
+	for _, ts := range []time.Time{start, end} {
+		_, err := repo.CreateIncident(ctx, station.ID, line.ID, ts, 10, "signal", "resolved", "")
+		require.NoError(t, err)
+	}
+
+	// An incident at exactly end belongs to the next range.
+	statuses, err := repo.GetIncidentStatusCounts(ctx, line.Name, &start, &end, false)
+	require.NoError(t, err)
+	assert.Equal(t, []StatusCount{{Status: "resolved", Count: 1}}, statuses)
+
+	types, err := repo.GetIncidentTypeCountsByLine(ctx, &start, &end, false)
+	require.NoError(t, err)
+	for _, tc := range types {
+		if tc.LineName == line.Name {
+			assert.Equal(t, int32(1), tc.Count)
+		}
+	}
+
+	daily, err := repo.GetCumulativeIncidents(ctx, &line.ID, start, end, false)
+	require.NoError(t, err)
+	require.Len(t, daily, 2)
+	assert.Equal(t, int32(1), daily[1].CumulativeCount)
+
+	recent, err := repo.GetRecentDisruptions(ctx, IncidentFilter{LineName: line.Name, Start: &start, End: &end}, 10)
+	require.NoError(t, err)
+	assert.Len(t, recent, 1)
+
+	streamed := 0
+	err = repo.StreamIncidents(ctx, &start, &end, TimeFieldEvent, func(inc *IncidentWithDetails) error {
+		if inc.LineID == line.ID {
+			streamed++
+		}
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 1, streamed)
+}
+
+func TestGetRecentDisruptions_TimeField(t *testing.T) {
+	repo := NewRepository(openTestDB(t), nil, RepositoryConfig{})
+	ctx := context.Background()
+
+	line, station := newTestLineStation(t, repo, "time field")
+
+	// Backfilled today for an incident that happened years ago.
+	occurred := time.Date(2020, 6, 1, 8, 0, 0, 0, time.UTC)
+	incident, err := repo.CreateIncident(ctx, station.ID, line.ID, occurred, 15, "power", "resolved", "")
+	require.NoError(t, err)
+
+	since := time.Now().Add(-time.Hour)
+	filter := IncidentFilter{LineName: line.Name, Start: &since}
+
+	byEvent, err := repo.GetRecentDisruptions(ctx, filter, 10)
+	require.NoError(t, err)
+	assert.Empty(t, byEvent)
+
+	filter.TimeField = TimeFieldIngested
+	byIngestion, err := repo.GetRecentDisruptions(ctx, filter, 10)
+	require.NoError(t, err)
+	require.Len(t, byIngestion, 1)
+	assert.Equal(t, incident.ID, byIngestion[0].ID)
+	assert.True(t, occurred.Equal(byIngestion[0].Timestamp))
+}
+
+func TestGetRecentDisruptions_LineNames(t *testing.T) {
+	repo := NewRepository(openTestDB(t), nil, RepositoryConfig{})
+	ctx := context.Background()
+
+	suffix := time.Now().Format("150405.000000")
+	ts := time.Date(2020, 7, 1, 8, 0, 0, 0, time.UTC)
+	var names []string
+	for _, name := range []string{"corridor a ", "corridor b ", "corridor c "} {
+		line, err := repo.CreateLine(ctx, name+suffix, LineAttributes{})
+		require.NoError(t, err)
+		t.Cleanup(func() { _ = repo.DeleteLine(ctx, line.ID) })
+		station, err := repo.CreateStation(ctx, "Corridor", line.ID, "active", nil, nil)
+		require.NoError(t, err)
+		t.Cleanup(func() { _ = repo.DeleteStation(ctx, station.ID, true) })
+		_, err = repo.CreateIncident(ctx, station.ID, line.ID, ts, 10, "signal", "resolved", "")
+		require.NoError(t, err)
+		names = append(names, line.Name)
+	}
+
+	// The repeated filter wins over the single line name.
+	results, err := repo.GetRecentDisruptions(ctx, IncidentFilter{LineName: names[2], LineNames: names[:2]}, 10)
+	require.NoError(t, err)
+	var got []string
+	for _, r := range results {
+		got = append(got, r.LineName)
+	}
+	assert.ElementsMatch(t, names[:2], got)
+}
+
+func TestGetRecentDisruptions_SortedQuery(t *testing.T) {
+	repo := NewRepository(openTestDB(t), nil, RepositoryConfig{})
+	ctx := context.Background()
+
+	suffix := time.Now().Format("150405.000000")
+	line, err := repo.CreateLine(ctx, "disruption sort "+suffix, LineAttributes{})
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = repo.DeleteLine(ctx, line.ID) })
+	station, err := repo.CreateStation(ctx, "Disruption Sort", line.ID, "active", nil, nil)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = repo.DeleteStation(ctx, station.ID, true) })
+
+	start := time.Date(2020, 8, 1, 8, 0, 0, 0, time.UTC)
+	for i, duration := range []int32{30, 5, 60} {
+		_, err := repo.CreateIncident(ctx, station.ID, line.ID, start.Add(time.Duration(i)*time.Hour), duration, "signal", "resolved", "")
+		require.NoError(t, err)
+	}
+
+	durations := func(sort DisruptionSort) []int32 {
+		results, err := repo.GetRecentDisruptions(ctx, IncidentFilter{LineName: line.Name, Sort: sort}, 10)
+		require.NoError(t, err)
+		var got []int32
+		for _, r := range results {
+			got = append(got, r.DurationMinutes)
+		}
+		return got
+	}
+
+	assert.Equal(t, []int32{60, 5, 30}, durations(DisruptionSort{}))
+	assert.Equal(t, []int32{30, 5, 60}, durations(DisruptionSort{Ascending: true}))
+	assert.Equal(t, []int32{60, 30, 5}, durations(DisruptionSort{By: DisruptionSortDuration}))
+	assert.Equal(t, []int32{5, 30, 60}, durations(DisruptionSort{By: DisruptionSortDuration, Ascending: true}))
+}
+
+func TestGetConcurrentIncidents(t *testing.T) {
+	repo := NewRepository(openTestDB(t), nil, RepositoryConfig{})
+	ctx := context.Background()
+
+	line, station := newTestLineStation(t, repo, "concurrent")
+
+	start := time.Date(2020, 3, 1, 8, 0, 0, 0, time.UTC)
+	long, err := repo.CreateIncident(ctx, station.ID, line.ID, start, 60, "power", "resolved", "")
+	require.NoError(t, err)
+	inside, err := repo.CreateIncident(ctx, station.ID, line.ID, start.Add(30*time.Minute), 45, "signal", "resolved", "")
+	require.NoError(t, err)
+	// Starts exactly when the second incident ends, so overlaps neither.
+	_, err = repo.CreateIncident(ctx, station.ID, line.ID, start.Add(75*time.Minute), 10, "signal", "resolved", "")
+	require.NoError(t, err)
+
+	pairs, err := repo.GetConcurrentIncidents(ctx, line.Name, start, start.AddDate(0, 0, 1), 100, false)
+	require.NoError(t, err)
+	require.Len(t, pairs, 1)
+	assert.Equal(t, long.ID, pairs[0].First.ID)
+	assert.Equal(t, inside.ID, pairs[0].Second.ID)
+	assert.Equal(t, station.Name, pairs[0].Second.StationName)
+	assert.True(t, start.Add(30*time.Minute).Equal(pairs[0].OverlapStart))
+	assert.True(t, start.Add(time.Hour).Equal(pairs[0].OverlapEnd))
+}
+
+func TestGetNetworkOverview(t *testing.T) {
+	repo := NewRepository(openTestDB(t), nil, RepositoryConfig{})
+	ctx := context.Background()
+
+	line, station := newTestLineStation(t, repo, "overview")
+
+	start := time.Date(2003, 3, 3, 8, 0, 0, 0, time.UTC)
+	_, err := repo.CreateIncident(ctx, station.ID, line.ID, start, 10, "power", "resolved", "")
+	require.NoError(t, err)
+	_, err = repo.CreateIncident(ctx, station.ID, line.ID, start.Add(30*time.Minute), 20, "signal", "resolved", "")
+	require.NoError(t, err)
+	// Outside the range, so neither counted nor part of a gap.
+	_, err = repo.CreateIncident(ctx, station.ID, line.ID, start.Add(2*time.Hour), 5, "signal", "resolved", "")
+	require.NoError(t, err)
+
+	overview, err := repo.GetNetworkOverview(ctx, start, start.Add(time.Hour), false)
+	require.NoError(t, err)
+	assert.Empty(t, overview.Failures)
+	assert.GreaterOrEqual(t, overview.TotalLines, int32(1))
+	assert.GreaterOrEqual(t, overview.TotalStations, int32(1))
+	assert.Equal(t, int32(2), overview.TotalIncidents)
+	assert.Equal(t, int64(30), overview.DowntimeMinutes)
+	require.NotNil(t, overview.MTBFMinutes)
+	assert.Equal(t, 30.0, *overview.MTBFMinutes)
+}
+
+func TestGetDurationPercentiles(t *testing.T) {
+	repo := NewRepository(openTestDB(t), nil, RepositoryConfig{})
+	ctx := context.Background()
+
+	suffix := time.Now().Format("150405.000000")
+	line, err := repo.CreateLine(ctx, "percentiles "+suffix, LineAttributes{})
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = repo.DeleteLine(ctx, line.ID) })
+	quiet, err := repo.CreateLine(ctx, "percentiles quiet "+suffix, LineAttributes{})
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = repo.DeleteLine(ctx, quiet.ID) })
+	station, err := repo.CreateStation(ctx, "Percentiles "+suffix, line.ID, "active", nil, nil)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = repo.DeleteStation(ctx, station.ID, true) })
+
+	start := time.Date(2004, 4, 4, 0, 0, 0, 0, time.UTC)
+	for i, duration := range []int32{10, 20, 30, 40, 50} {
+		_, err = repo.CreateIncident(ctx, station.ID, line.ID, start.Add(time.Duration(i)*time.Hour), duration, "signal", "resolved", "")
+		require.NoError(t, err)
+	}
+
+	results, err := repo.GetDurationPercentiles(ctx, start, start.AddDate(0, 0, 1), false)
+	require.NoError(t, err)
+
+	var got *LineDurationPercentiles
+	for i := range results {
+		assert.NotEqual(t, quiet.Name, results[i].LineName, "lines without incidents are skipped")
+		if results[i].LineName == line.Name {
+			got = &results[i]
+		}
+	}
+	require.NotNil(t, got)
+	assert.Equal(t, int32(5), got.IncidentCount)
+	assert.Equal(t, 30.0, got.P50Minutes)
+	assert.Equal(t, 46.0, got.P90Minutes)
+	assert.Equal(t, 48.0, got.P95Minutes)
+	assert.Equal(t, 49.6, got.P99Minutes)
+}
+
+func TestListLines_SortByStats(t *testing.T) {
+	repo := NewRepository(openTestDB(t), nil, RepositoryConfig{})
+	ctx := context.Background()
+
+	suffix := time.Now().Format("150405.000000")
+	start := time.Date(2003, 3, 3, 0, 0, 0, 0, time.UTC)
+	var names []string
+	for i, durations := range [][]int32{{10, 10, 10}, {100}, {5, 5}} {
+		line, err := repo.CreateLine(ctx, fmt.Sprintf("sortable %d %s", i, suffix), LineAttributes{})
+		require.NoError(t, err)
+		t.Cleanup(func() { _ = repo.DeleteLine(ctx, line.ID) })
+		station, err := repo.CreateStation(ctx, fmt.Sprintf("Sortable %d %s", i, suffix), line.ID, "active", nil, nil)
+		require.NoError(t, err)
+		t.Cleanup(func() { _ = repo.DeleteStation(ctx, station.ID, true) })
+		for j, d := range durations {
+			_, err = repo.CreateIncident(ctx, station.ID, line.ID, start.Add(time.Duration(j*(i+1))*time.Hour), d, "signal", "resolved", "")
+			require.NoError(t, err)
+		}
+		names = append(names, line.Name)
+	}
+
+	lineNames := func(lines []Line) []string {
+		var out []string
+		for _, l := range lines {
+			out = append(out, l.Name)
+		}
+		return out
+	}
+
+	lines, err := repo.ListLines(ctx, suffix, true, LineSort{By: LineSortIncidentCount, Descending: true})
+	require.NoError(t, err)
+	assert.Equal(t, []string{names[0], names[2], names[1]}, lineNames(lines))
+
+	lines, err = repo.ListLines(ctx, suffix, true, LineSort{By: LineSortDowntime, Descending: true})
+	require.NoError(t, err)
+	assert.Equal(t, []string{names[1], names[0], names[2]}, lineNames(lines))
+	assert.Equal(t, int32(30), *lines[1].DowntimeMinutes)
+
+	// The line with a single incident has no MTBF and sorts last.
+	lines, err = repo.ListLines(ctx, suffix, true, LineSort{By: LineSortMTBF})
+	require.NoError(t, err)
+	assert.Equal(t, []string{names[0], names[2], names[1]}, lineNames(lines))
+	assert.Equal(t, 60.0, *lines[0].MTBFMinutes)
+	assert.Equal(t, 180.0, *lines[1].MTBFMinutes)
+	assert.Nil(t, lines[2].MTBFMinutes)
+
+	_, err = repo.ListLines(ctx, suffix, false, LineSort{By: LineSortMTBF})
+	assert.Error(t, err)
+}
+
+func TestGetLongestQuietPeriods(t *testing.T) {
+	repo := NewRepository(openTestDB(t), nil, RepositoryConfig{})
+	ctx := context.Background()
+
+	suffix := time.Now().Format("150405.000000")
+	line, err := repo.CreateLine(ctx, "quiet "+suffix, LineAttributes{})
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = repo.DeleteLine(ctx, line.ID) })
+	single, err := repo.CreateLine(ctx, "quiet single "+suffix, LineAttributes{})
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = repo.DeleteLine(ctx, single.ID) })
+	station, err := repo.CreateStation(ctx, "Quiet "+suffix, line.ID, "active", []uuid.UUID{single.ID}, nil)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = repo.DeleteStation(ctx, station.ID, true) })
+
+	start := time.Date(2002, 2, 2, 0, 0, 0, 0, time.UTC)
+	// Gaps of 1h, 3h, 3h and 2h: the first 3h gap is reported.
+	for _, hours := range []int{0, 1, 4, 7, 9} {
+		_, err = repo.CreateIncident(ctx, station.ID, line.ID, start.Add(time.Duration(hours)*time.Hour), 5, "signal", "resolved", "")
+		require.NoError(t, err)
+	}
+	_, err = repo.CreateIncident(ctx, station.ID, single.ID, start, 5, "signal", "resolved", "")
+	require.NoError(t, err)
+
+	results, err := repo.GetLongestQuietPeriods(ctx, start, start.AddDate(0, 0, 1), false)
+	require.NoError(t, err)
+
+	var got *LineQuietPeriod
+	for i := range results {
+		assert.NotEqual(t, single.Name, results[i].LineName, "lines with one incident have no gap")
+		if results[i].LineName == line.Name {
+			got = &results[i]
+		}
+	}
+	require.NotNil(t, got)
+	assert.Equal(t, 180.0, got.GapMinutes)
+	assert.True(t, got.StartTime.Equal(start.Add(time.Hour)))
+	assert.True(t, got.EndTime.Equal(start.Add(4*time.Hour)))
+}
+
+func TestGetSLABreaches(t *testing.T) {
+	repo := NewRepository(openTestDB(t), nil, RepositoryConfig{})
+	ctx := context.Background()
+
+	line, station := newTestLineStation(t, repo, "sla")
+
+	start := time.Date(2003, 3, 3, 0, 0, 0, 0, time.UTC)
+	// 30 is exactly the threshold and so does not breach it.
+	for i, duration := range []int32{10, 30, 31, 90} {
+		_, err := repo.CreateIncident(ctx, station.ID, line.ID, start.Add(time.Duration(i)*time.Hour), duration, "signal", "resolved", "")
+		require.NoError(t, err)
+	}
+
+	results, err := repo.GetSLABreaches(ctx, 30, start, start.AddDate(0, 0, 1), false)
+	require.NoError(t, err)
+
+	var got *LineSLABreaches
+	for i := range results {
+		if results[i].LineName == line.Name {
+			got = &results[i]
+		}
+	}
+	require.NotNil(t, got)
+	assert.Equal(t, int32(4), got.TotalIncidents)
+	assert.Equal(t, int32(2), got.Breaches)
+	assert.Equal(t, 0.5, got.BreachRate)
+}
+
+func TestAnalytics_SkipSoftDeletedStations(t *testing.T) {
+	repo := NewRepository(openTestDB(t), nil, RepositoryConfig{})
+	ctx := context.Background()
+
+	line, kept := newTestLineStation(t, repo, "soft deleted")
+	deleted, err := repo.CreateStation(ctx, "Deleted "+line.Name, line.ID, "active", nil, nil)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = repo.DeleteStation(ctx, deleted.ID, true) })
+
+	start := time.Date(2001, 1, 1, 0, 0, 0, 0, time.UTC)
+	for i, stationID := range []uuid.UUID{kept.ID, deleted.ID, kept.ID} {
+		_, err = repo.CreateIncident(ctx, stationID, line.ID, start.Add(time.Duration(i)*time.Hour), 5, "signal", "resolved", "")
+		require.NoError(t, err)
+	}
+	require.NoError(t, repo.DeleteStation(ctx, deleted.ID, false))
+
+	lineCount := func(includeDeleted bool) int32 {
+		results, _, err := repo.GetTopBreakdownsByLine(ctx, 100000, includeDeleted)
+		require.NoError(t, err)
+		for _, r := range results {
+			if r.Name == line.Name {
+				return r.Count
+			}
+		}
+		t.Fatalf("line %s missing from breakdowns", line.Name)
+		return 0
+	}
+	assert.Equal(t, int32(2), lineCount(false))
+	assert.Equal(t, int32(3), lineCount(true))
+
+	stationNames := func(includeDeleted bool) []string {
+		results, _, err := repo.GetTopBreakdownsByStation(ctx, 100, &line.ID, includeDeleted)
+		require.NoError(t, err)
+		var names []string
+		for _, r := range results {
+			names = append(names, r.Name)
+		}
+		return names
+	}
+	assert.Equal(t, []string{kept.Name}, stationNames(false))
+	assert.ElementsMatch(t, []string{kept.Name, deleted.Name}, stationNames(true))
+
+	lineMTBF := func(includeDeleted bool) float64 {
+		results, _, err := repo.CalculateMTBF(ctx, includeDeleted)
+		require.NoError(t, err)
+		for _, r := range results {
+			if r.LineName == line.Name {
+				return r.MTBFMinutes
+			}
+		}
+		t.Fatalf("line %s missing from MTBF", line.Name)
+		return 0
+	}
+	assert.Equal(t, 120.0, lineMTBF(false))
+	assert.Equal(t, 60.0, lineMTBF(true))
+
+	disruptions, err := repo.GetRecentDisruptions(ctx, IncidentFilter{LineName: line.Name}, 10)
+	require.NoError(t, err)
+	assert.Len(t, disruptions, 2)
+	disruptions, err = repo.GetRecentDisruptions(ctx, IncidentFilter{LineName: line.Name, IncludeDeleted: true}, 10)
+	require.NoError(t, err)
+	assert.Len(t, disruptions, 3)
+}
+
+func TestCalculateMTBF_DataAsOfSkipsSoftDeletedStations(t *testing.T) {
+	repo := NewRepository(openTestDB(t), nil, RepositoryConfig{})
+	ctx := context.Background()
+
+	line, station := newTestLineStation(t, repo, "latest deleted")
+
+	// Far enough in the future to be the newest incident in a shared database.
+	ts := time.Date(2999, 1, 1, 0, 0, 0, 0, time.UTC)
+	_, err := repo.CreateIncident(ctx, station.ID, line.ID, ts, 5, "signal", "resolved", "")
+	require.NoError(t, err)
+	require.NoError(t, repo.DeleteStation(ctx, station.ID, false))
+
+	_, latest, err := repo.CalculateMTBF(ctx, false)
+	require.NoError(t, err)
+	if latest != nil {
+		assert.True(t, latest.Before(ts), "incident at deleted station counted in data_as_of")
+	}
+
+	_, latest, err = repo.CalculateMTBF(ctx, true)
+	require.NoError(t, err)
+	require.NotNil(t, latest)
+	assert.True(t, latest.Equal(ts))
+}
+
+func TestGetStationLineComparison_SkipsSoftDeletedStations(t *testing.T) {
+	repo := NewRepository(openTestDB(t), nil, RepositoryConfig{})
+	ctx := context.Background()
+
+	line, kept := newTestLineStation(t, repo, "comparison deleted")
+	deleted, err := repo.CreateStation(ctx, "Deleted "+line.Name, line.ID, "active", nil, nil)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = repo.DeleteStation(ctx, deleted.ID, true) })
+
+	start := time.Date(2001, 1, 1, 0, 0, 0, 0, time.UTC)
+	_, err = repo.CreateIncident(ctx, kept.ID, line.ID, start, 10, "signal", "resolved", "")
+	require.NoError(t, err)
+	for i := 0; i < 3; i++ {
+		_, err = repo.CreateIncident(ctx, deleted.ID, line.ID, start.Add(time.Duration(i+1)*time.Hour), 30, "signal", "resolved", "")
+		require.NoError(t, err)
+	}
+	require.NoError(t, repo.DeleteStation(ctx, deleted.ID, false))
+
+	cmp, err := repo.GetStationLineComparison(ctx, kept.ID, start, start.Add(24*time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, int32(1), cmp.LineStationCount)
+	assert.Equal(t, 1.0, cmp.LineAvgIncidentCount)
+	assert.Equal(t, 10.0, cmp.LineAvgDowntimeMinutes)
+}
+
+func TestReorderStations(t *testing.T) {
+	repo := NewRepository(openTestDB(t), nil, RepositoryConfig{})
+	ctx := context.Background()
+
+	suffix := time.Now().Format("150405.000000")
+	line, err := repo.CreateLine(ctx, "reorder "+suffix, LineAttributes{})
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = repo.DeleteLine(ctx, line.ID) })
+
+	var ids []uuid.UUID
+	for _, name := range []string{"Alpha", "Bravo", "Charlie"} {
+		station, err := repo.CreateStation(ctx, name+" "+suffix, line.ID, "active", nil, nil)
+		require.NoError(t, err)
+		t.Cleanup(func() { _ = repo.DeleteStation(ctx, station.ID, true) })
+		ids = append(ids, station.ID)
+	}
+
+	// Charlie is left out, so it keeps no position and sorts last.
+	require.NoError(t, repo.ReorderStations(ctx, line.ID, []uuid.UUID{ids[1], ids[0]}))
+
+	stations, err := repo.ListStations(ctx, StationFilter{LineID: &line.ID}, false)
+	require.NoError(t, err)
+	require.Len(t, stations, 3)
+	assert.Equal(t, []uuid.UUID{ids[1], ids[0], ids[2]}, []uuid.UUID{stations[0].ID, stations[1].ID, stations[2].ID})
+	require.NotNil(t, stations[0].Position)
+	assert.Equal(t, int32(0), *stations[0].Position)
+	assert.Nil(t, stations[2].Position)
+
+	assert.Equal(t, ErrStationLineMismatch, repo.ReorderStations(ctx, line.ID, []uuid.UUID{uuid.New()}))
+	assert.Equal(t, ErrLineNotFound, repo.ReorderStations(ctx, uuid.New(), nil))
+}
+
+// BenchmarkCalculateMTBF runs the MTBF query against the database named by
+// TEST_DATABASE_URL, e.g. one seeded with scripts/populate-data.
+func BenchmarkCalculateMTBF(b *testing.B) {
+	repo := NewRepository(openTestDB(b), nil, RepositoryConfig{})
+	ctx := context.Background()
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, _, err := repo.CalculateMTBF(ctx, false); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
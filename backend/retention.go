@@ -0,0 +1,38 @@
+package backend
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-coldbrew/log"
+)
+
+// purgeBatchSize is how many incidents PurgeIncidentsBefore deletes per
+// transaction.
+const purgeBatchSize = 1000
+
+// RunIncidentPurge calls purge every interval until ctx is cancelled. A
+// failed purge is logged and retried on the next tick.
+func RunIncidentPurge(ctx context.Context, interval time.Duration, purge func(context.Context) error) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	log.Info(ctx, "Incident purge job started", "interval", interval.String())
+
+	for {
+		select {
+		case <-ctx.Done():
+			log.Info(ctx, "Incident purge job stopped")
+			return
+		case <-ticker.C:
+		}
+
+		if err := purge(ctx); err != nil {
+			if ctx.Err() != nil {
+				log.Info(ctx, "Incident purge job stopped")
+				return
+			}
+			log.Error(ctx, "Failed to purge old incidents", "error", err)
+		}
+	}
+}
@@ -0,0 +1,37 @@
+package backend
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRunIncidentPurge_PurgesUntilCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	var calls atomic.Int32
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		RunIncidentPurge(ctx, time.Millisecond, func(ctx context.Context) error {
+			// A failing purge must not stop the loop.
+			if calls.Add(1) == 1 {
+				return errors.New("purge failed")
+			}
+			return nil
+		})
+	}()
+
+	require.Eventually(t, func() bool { return calls.Load() >= 3 }, time.Second, time.Millisecond)
+	cancel()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("purge job did not stop after cancellation")
+	}
+}
@@ -0,0 +1,75 @@
+package backend
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"net"
+	"syscall"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+type RetryConfig struct {
+	MaxAttempts int
+	BaseDelay   time.Duration
+}
+
+// withRetry runs fn until it succeeds, returns a non-transient error, or the
+// configured attempts are exhausted. The delay doubles after every attempt.
+func withRetry(ctx context.Context, cfg RetryConfig, fn func() error) error {
+	return retryIf(ctx, cfg, isTransientError, fn)
+}
+
+// retryIf is withRetry with the decision of which errors are worth retrying
+// left to retryable.
+func retryIf(ctx context.Context, cfg RetryConfig, retryable func(error) bool, fn func() error) error {
+	attempts := cfg.MaxAttempts
+	if attempts < 1 {
+		attempts = 1
+	}
+	delay := cfg.BaseDelay
+
+	for attempt := 1; ; attempt++ {
+		err := fn()
+		if err == nil || attempt >= attempts || !retryable(err) {
+			return err
+		}
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return err
+		case <-timer.C:
+		}
+		delay *= 2
+	}
+}
+
+func isTransientError(err error) bool {
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) {
+		switch {
+		case pqErr.Code.Class() == "08":
+			return true
+		case pqErr.Code == "40001", pqErr.Code == "40P01":
+			return true
+		case pqErr.Code == "57P01", pqErr.Code == "57P02", pqErr.Code == "57P03":
+			return true
+		}
+		return false
+	}
+
+	if errors.Is(err, driver.ErrBadConn) ||
+		errors.Is(err, io.ErrUnexpectedEOF) ||
+		errors.Is(err, syscall.ECONNRESET) ||
+		errors.Is(err, syscall.ECONNREFUSED) {
+		return true
+	}
+
+	var netErr *net.OpError
+	return errors.As(err, &netErr)
+}
@@ -0,0 +1,106 @@
+package backend
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithRetry_SucceedsAfterTransientFailure(t *testing.T) {
+	cfg := RetryConfig{MaxAttempts: 3, BaseDelay: time.Millisecond}
+
+	calls := 0
+	err := withRetry(context.Background(), cfg, func() error {
+		calls++
+		if calls < 3 {
+			return &pq.Error{Code: "40001"}
+		}
+		return nil
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, 3, calls)
+}
+
+func TestWithRetry_ExhaustsAttempts(t *testing.T) {
+	cfg := RetryConfig{MaxAttempts: 2, BaseDelay: time.Millisecond}
+
+	calls := 0
+	err := withRetry(context.Background(), cfg, func() error {
+		calls++
+		return &pq.Error{Code: "08006"}
+	})
+
+	require.Error(t, err)
+	assert.Equal(t, 2, calls)
+}
+
+func TestWithRetry_DoesNotRetryConstraintViolation(t *testing.T) {
+	cfg := RetryConfig{MaxAttempts: 5, BaseDelay: time.Millisecond}
+
+	calls := 0
+	err := withRetry(context.Background(), cfg, func() error {
+		calls++
+		return &pq.Error{Code: "23505"}
+	})
+
+	require.Error(t, err)
+	assert.Equal(t, 1, calls)
+}
+
+func TestWithRetry_DoesNotRetryNoRows(t *testing.T) {
+	cfg := RetryConfig{MaxAttempts: 5, BaseDelay: time.Millisecond}
+
+	calls := 0
+	err := withRetry(context.Background(), cfg, func() error {
+		calls++
+		return sql.ErrNoRows
+	})
+
+	assert.Equal(t, sql.ErrNoRows, err)
+	assert.Equal(t, 1, calls)
+}
+
+func TestWithRetry_StopsOnContextCancel(t *testing.T) {
+	cfg := RetryConfig{MaxAttempts: 5, BaseDelay: time.Hour}
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	calls := 0
+	err := withRetry(ctx, cfg, func() error {
+		calls++
+		return &pq.Error{Code: "40P01"}
+	})
+
+	require.Error(t, err)
+	assert.Equal(t, 1, calls)
+}
+
+func TestIsTransientError(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{"serialization failure", &pq.Error{Code: "40001"}, true},
+		{"deadlock", &pq.Error{Code: "40P01"}, true},
+		{"connection failure", &pq.Error{Code: "08006"}, true},
+		{"admin shutdown", &pq.Error{Code: "57P01"}, true},
+		{"unique violation", &pq.Error{Code: "23505"}, false},
+		{"check violation", &pq.Error{Code: "23514"}, false},
+		{"no rows", sql.ErrNoRows, false},
+		{"generic", errors.New("boom"), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, isTransientError(tt.err))
+		})
+	}
+}
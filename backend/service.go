@@ -3,13 +3,20 @@ package backend
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"math"
+	"regexp"
+	"sort"
 	"strings"
+	"sync/atomic"
 	"time"
 
 	"github.com/go-coldbrew/log"
 	"github.com/google/uuid"
+	"golang.org/x/sync/errgroup"
 	"google.golang.org/genproto/googleapis/api/httpbody"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
@@ -19,43 +26,196 @@ import (
 )
 
 type RepositoryInterface interface {
-	CreateLine(ctx context.Context, name string) (*Line, error)
-	ListLines(ctx context.Context) ([]Line, error)
+	CreateLine(ctx context.Context, name string, attrs LineAttributes) (*Line, error)
+	ListLines(ctx context.Context, nameContains string, includeStats bool, order LineSort) ([]Line, error)
 	GetLine(ctx context.Context, id uuid.UUID) (*Line, error)
-	UpdateLine(ctx context.Context, id uuid.UUID, name string) (*Line, error)
+	UpdateLine(ctx context.Context, id uuid.UUID, name string, attrs LineAttributes) (*Line, error)
 	DeleteLine(ctx context.Context, id uuid.UUID) error
-	GetOrCreateLine(ctx context.Context, name string) (*Line, error)
+	GetOrCreateLine(ctx context.Context, name string) (*Line, bool, error)
+	FindLineByName(ctx context.Context, name string) (*Line, error)
+	GetLineSummary(ctx context.Context, id uuid.UUID) (*LineSummary, error)
+	PreviewLineDeletion(ctx context.Context, id uuid.UUID) (*LineDeletionImpact, error)
 
-	CreateStation(ctx context.Context, name string, lineID uuid.UUID, status string) (*StationWithLine, error)
-	ListStations(ctx context.Context, lineID *uuid.UUID) ([]StationWithLine, error)
+	CreateStation(ctx context.Context, name string, lineID uuid.UUID, status string, additionalLineIDs []uuid.UUID, coords *StationCoordinates) (*StationWithLine, error)
+	ListStations(ctx context.Context, filter StationFilter, includeIncidentCount bool) ([]StationWithLine, error)
 	GetStation(ctx context.Context, id uuid.UUID) (*StationWithLine, error)
-	UpdateStation(ctx context.Context, id uuid.UUID, name, status *string) (*StationWithLine, error)
-	DeleteStation(ctx context.Context, id uuid.UUID) error
-	GetOrCreateStation(ctx context.Context, name string, lineID uuid.UUID) (*Station, error)
-
-	CreateIncident(ctx context.Context, stationID, lineID uuid.UUID, ts time.Time, durationMinutes int32, incidentType string) (*Incident, error)
+	UpdateStation(ctx context.Context, id uuid.UUID, name, status *string, coords *StationCoordinates) (*StationWithLine, error)
+	DeleteStation(ctx context.Context, id uuid.UUID, force bool) error
+	MergeStations(ctx context.Context, sourceID, targetID uuid.UUID, force bool) (int64, error)
+	ReorderStations(ctx context.Context, lineID uuid.UUID, stationIDs []uuid.UUID) error
+	GetOrCreateStation(ctx context.Context, name string, lineID uuid.UUID) (*Station, bool, error)
+	FindStationByName(ctx context.Context, name string, lineID uuid.UUID) (*Station, error)
+
+	CreateIncident(ctx context.Context, stationID, lineID uuid.UUID, ts time.Time, durationMinutes int32, incidentType, status, source string) (*Incident, error)
+	CreateIncidentWithOptions(ctx context.Context, stationID, lineID uuid.UUID, ts time.Time, durationMinutes int32, incidentType, status, source string, opts IncidentCreateOptions) (*IncidentCreateResult, error)
+	UpdateIncidentStatus(ctx context.Context, id uuid.UUID, status, action string) (*IncidentWithDetails, error)
+	UpdateIncident(ctx context.Context, id uuid.UUID, durationMinutes *int32, incidentType *string) (*IncidentWithDetails, error)
+	ReassignIncident(ctx context.Context, id, lineID uuid.UUID) (*IncidentWithDetails, error)
+	DeleteIncident(ctx context.Context, id uuid.UUID) error
+	GetIncidentHistory(ctx context.Context, id uuid.UUID) ([]IncidentAuditEntry, error)
 	GetIncidentWithDetails(ctx context.Context, incidentID uuid.UUID) (*IncidentWithDetails, error)
-	GetTopBreakdownsByLine(ctx context.Context, limit int32) ([]BreakdownCount, error)
-	GetTopBreakdownsByStation(ctx context.Context, limit int32) ([]BreakdownCount, error)
-	CalculateMTBF(ctx context.Context) ([]MTBFResult, error)
-	GetRecentDisruptions(ctx context.Context, lineName, stationName string, limit int32) ([]IncidentWithDetails, error)
+	ListIncidentsByTag(ctx context.Context, tag string, limit int32, timeField TimeField) ([]IncidentWithDetails, error)
+	GetTopBreakdownsByLine(ctx context.Context, limit int32, includeDeleted bool) ([]BreakdownCount, *time.Time, error)
+	GetTopBreakdownsByStation(ctx context.Context, limit int32, lineID *uuid.UUID, includeDeleted bool) ([]BreakdownCount, *time.Time, error)
+	GetIncidentsForMap(ctx context.Context, lineName string, start, end time.Time, includeMissingGeo, includeDeleted bool) ([]MapIncident, error)
+	CalculateMTBF(ctx context.Context, includeDeleted bool) ([]MTBFResult, *time.Time, error)
+	GetStationMTBF(ctx context.Context, stationID uuid.UUID) (*StationMTBF, error)
+	GetIncidentIntervals(ctx context.Context, lineName string, start, end time.Time, includeDeleted bool) ([]IncidentInterval, error)
+	GetRecentDisruptions(ctx context.Context, filter IncidentFilter, limit int32) ([]IncidentWithDetails, error)
+	GetRollingIncidentAverage(ctx context.Context, lineName string, start, end time.Time, includeDeleted bool) ([]DailyRollingAverage, error)
+	GetCumulativeIncidents(ctx context.Context, lineID *uuid.UUID, start, end time.Time, includeDeleted bool) ([]DailyCumulativeCount, error)
+	GetBusiestPeriod(ctx context.Context, lineName string, start, end time.Time, window time.Duration, includeDeleted bool) (*BusiestPeriod, error)
+	GetStationIncidentAggregates(ctx context.Context, lineName string, start, end time.Time, includeDeleted bool) ([]StationIncidentAggregate, error)
+	GetIncidentsDuringMaintenance(ctx context.Context, lineName string, start, end time.Time, includeDeleted bool) ([]StationIncidentAggregate, error)
+	GetIncidentTypeCountsByLine(ctx context.Context, start, end *time.Time, includeDeleted bool) ([]LineTypeCount, error)
+	GetIncidentTypeCountsByHour(ctx context.Context, lineName string, start, end time.Time, includeDeleted bool) ([]HourTypeCount, error)
+	GetLineIncidentTypeCounts(ctx context.Context, lineID uuid.UUID, start, end time.Time, includeDeleted bool) ([]TypeCount, error)
+	GetLastIncidentTimes(ctx context.Context, lineName string, includeDeleted bool) ([]LineLastIncident, error)
+	GetTopIncidentTypeByLine(ctx context.Context, start, end time.Time, includeDeleted bool) ([]LineTypeCount, error)
+	GetIncidentStatusCounts(ctx context.Context, lineName string, start, end *time.Time, includeDeleted bool) ([]StatusCount, error)
+	GetLongestIncidents(ctx context.Context, start, end time.Time, includeDeleted bool) ([]IncidentWithDetails, error)
+	GetConcurrentIncidents(ctx context.Context, lineName string, start, end time.Time, limit int32, includeDeleted bool) ([]ConcurrentIncidentPair, error)
+	GetNetworkOverview(ctx context.Context, start, end time.Time, includeDeleted bool) (*NetworkOverview, error)
+	GetDurationPercentiles(ctx context.Context, start, end time.Time, includeDeleted bool) ([]LineDurationPercentiles, error)
+	GetLongestQuietPeriods(ctx context.Context, start, end time.Time, includeDeleted bool) ([]LineQuietPeriod, error)
+	GetSLABreaches(ctx context.Context, thresholdMinutes int32, start, end time.Time, includeDeleted bool) ([]LineSLABreaches, error)
+	GetStationLineComparison(ctx context.Context, stationID uuid.UUID, start, end time.Time) (*StationLineComparison, error)
+	GetStationStatusCounts(ctx context.Context) ([]LineStationStatusCount, error)
+	GetSegmentIncidentCounts(ctx context.Context, lineName string, stationNames []string, start, end *time.Time, includeDeleted bool) ([]BreakdownCount, error)
+	StreamIncidents(ctx context.Context, start, end *time.Time, timeField TimeField, fn func(*IncidentWithDetails) error) error
+	FindOrphanedIncidents(ctx context.Context, limit int32) ([]OrphanedIncident, error)
+	NormalizeStationStatuses(ctx context.Context) (int64, error)
+	PurgeIncidentsBefore(ctx context.Context, cutoff time.Time, batchSize int) (int64, error)
+	Ping(ctx context.Context) error
+	GetSchemaVersion(ctx context.Context) (*SchemaVersion, error)
+}
+
+var defaultIncidentStatuses = []string{"open", "investigating", "resolved"}
+
+const defaultResolvedStatus = "resolved"
+
+var incidentTypes = []string{"mechanical", "power", "signal", "weather", "other"}
+
+// maintenanceIncidentTypes are the incident types that put their station into
+// maintenance when created with auto_update_station_status.
+var maintenanceIncidentTypes = []string{"mechanical", "power"}
+
+const DefaultAppName = "backend-analytics"
+
+type ServiceConfig struct {
+	// AppName is reported by the health and readiness checks.
+	AppName string
+	// IncidentStatuses lists the allowed incident status values. The first
+	// entry is assigned to newly created incidents.
+	IncidentStatuses []string
+	// ResolvedStatus is the terminal status set by ResolveIncident and
+	// skipped by only_active filters. It must be one of IncidentStatuses.
+	ResolvedStatus string
+	// GradeThresholds are checked in order by GetStationGrades; stations
+	// matching none of them are graded F.
+	GradeThresholds []GradeThreshold
+	// AdminEnabled allows RPCs that modify data in bulk, such as
+	// NormalizeStationStatuses.
+	AdminEnabled bool
+	// IncidentFutureTolerance is how far ahead of the server clock an incident
+	// timestamp may be, to allow for collectors with skewed clocks.
+	IncidentFutureTolerance time.Duration
+	// IncidentRetentionDays is how many days of incidents PurgeOldIncidents
+	// keeps. Zero disables purging.
+	IncidentRetentionDays int
+	// NetworkOverviewCacheTTL is how long GetNetworkOverview results are
+	// reused for the same range. Zero disables caching.
+	NetworkOverviewCacheTTL time.Duration
+	// AnalyticsCacheTTL is how long MTBF and top-breakdown results are
+	// cached between incident writes. Zero disables caching.
+	AnalyticsCacheTTL time.Duration
+	// MaxListLimit caps the limit of every list and analytics RPC, on top of
+	// each RPC's own maximum. Zero leaves only the per-RPC maximums.
+	MaxListLimit int32
+	// IncidentWebhook is notified of every stored incident. Nil disables
+	// notifications.
+	IncidentWebhook *WebhookDispatcher
+	// AutoMaintenanceMinDuration is the shortest incident, in minutes, that
+	// sets its station to maintenance when auto_update_station_status is set.
+	AutoMaintenanceMinDuration int32
+	// SchemaVersion is the migration version ReadyCheck requires the database
+	// to be at. Zero skips the migration check.
+	SchemaVersion uint
 }
 
 type Service struct {
 	pb.UnimplementedTransportAnalyticsServer
-	repo RepositoryInterface
+	repo            RepositoryInterface
+	cfg             ServiceConfig
+	networkOverview *ttlCache[NetworkOverview]
+	// failing is set while the instance is shutting down, so the health and
+	// readiness probes steer traffic away before it stops serving.
+	failing atomic.Bool
 }
 
-func NewService(repo *Repository) *Service {
+func NewService(repo *Repository, cfg ServiceConfig) *Service {
 	return &Service{
-		repo: repo,
+		repo:            newCachedRepository(repo, cfg.AnalyticsCacheTTL),
+		cfg:             cfg,
+		networkOverview: newTTLCache[NetworkOverview](cfg.NetworkOverviewCacheTTL),
+	}
+}
+
+func (s *Service) appName() string {
+	if s.cfg.AppName == "" {
+		return DefaultAppName
+	}
+	return s.cfg.AppName
+}
+
+func (s *Service) gradeThresholds() []GradeThreshold {
+	if len(s.cfg.GradeThresholds) == 0 {
+		return defaultGradeThresholds
+	}
+	return s.cfg.GradeThresholds
+}
+
+func (s *Service) incidentStatuses() []string {
+	if len(s.cfg.IncidentStatuses) == 0 {
+		return defaultIncidentStatuses
+	}
+	return s.cfg.IncidentStatuses
+}
+
+func (s *Service) resolvedStatus() string {
+	if s.cfg.ResolvedStatus == "" {
+		return defaultResolvedStatus
+	}
+	return s.cfg.ResolvedStatus
+}
+
+func (s *Service) validateIncidentStatus(statusVal string) error {
+	statuses := s.incidentStatuses()
+	for _, allowed := range statuses {
+		if statusVal == allowed {
+			return nil
+		}
 	}
+	return newValidationError("status", "status must be one of: %s", strings.Join(statuses, ", "))
+}
+
+// HealthCheck is a liveness probe: it answers as long as the process can
+// serve requests and never touches the database, so a database outage does
+// not get the service restarted.
+// SetFailing makes HealthCheck and ReadyCheck fail with Unavailable while
+// fail is set.
+func (s *Service) SetFailing(fail bool) {
+	s.failing.Store(fail)
 }
 
 func (s *Service) HealthCheck(ctx context.Context, _ *emptypb.Empty) (*httpbody.HttpBody, error) {
+	if s.failing.Load() {
+		return nil, status.Error(codes.Unavailable, "service is shutting down")
+	}
+
 	health := map[string]interface{}{
 		"status":     "healthy",
-		"assessment": "backend-analytics",
+		"assessment": s.appName(),
 		"time":       time.Now().UTC().Format(time.RFC3339),
 	}
 	data, _ := json.Marshal(health)
@@ -65,10 +225,37 @@ func (s *Service) HealthCheck(ctx context.Context, _ *emptypb.Empty) (*httpbody.
 	}, nil
 }
 
+// ReadyCheck is a readiness probe. It fails with Unavailable (HTTP 503)
+// while the database is unreachable or its migrations are dirty or behind
+// SchemaVersion, so traffic is held back until the instance can serve it.
+// It also fails while the service is shutting down.
 func (s *Service) ReadyCheck(ctx context.Context, _ *emptypb.Empty) (*httpbody.HttpBody, error) {
+	if s.failing.Load() {
+		return nil, status.Error(codes.Unavailable, "service is shutting down")
+	}
+
+	if err := s.repo.Ping(ctx); err != nil {
+		log.Error(ctx, "Readiness check failed", "error", err)
+		return nil, status.Error(codes.Unavailable, "database unavailable")
+	}
+
+	if s.cfg.SchemaVersion > 0 {
+		version, err := s.repo.GetSchemaVersion(ctx)
+		if err != nil {
+			log.Error(ctx, "Readiness check failed", "error", err)
+			return nil, status.Error(codes.Unavailable, "failed to read schema version")
+		}
+		if version != nil && version.Dirty {
+			return nil, status.Errorf(codes.Unavailable, "database migration %d is dirty", version.Version)
+		}
+		if version != nil && version.Version < s.cfg.SchemaVersion {
+			return nil, status.Errorf(codes.Unavailable, "database schema is at version %d, want %d", version.Version, s.cfg.SchemaVersion)
+		}
+	}
+
 	ready := map[string]interface{}{
 		"status":     "ready",
-		"assessment": "backend-analytics",
+		"assessment": s.appName(),
 		"time":       time.Now().UTC().Format(time.RFC3339),
 	}
 	data, _ := json.Marshal(ready)
@@ -79,26 +266,151 @@ func (s *Service) ReadyCheck(ctx context.Context, _ *emptypb.Empty) (*httpbody.H
 }
 
 func (s *Service) CreateIncident(ctx context.Context, req *pb.CreateIncidentRequest) (*pb.IncidentResponse, error) {
-	if err := s.validateIncidentRequest(req); err != nil {
-		return nil, status.Error(codes.InvalidArgument, err.Error())
+	fields, err := s.validateIncidentRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.LineId != "" {
+		return s.createIncidentByID(ctx, req, fields)
+	}
+
+	if req.DryRun {
+		return s.dryRunCreateIncident(ctx, req, fields)
 	}
 
 	log.Info(ctx, "Creating incident", "line", req.Line, "station", req.Station)
 
-	line, err := s.repo.GetOrCreateLine(ctx, strings.TrimSpace(req.Line))
+	line, lineCreated, err := s.repo.GetOrCreateLine(ctx, strings.TrimSpace(req.Line))
 	if err != nil {
 		log.Error(ctx, "Failed to get/create line", "error", err)
 		return nil, status.Error(codes.Internal, "failed to process line")
 	}
 
-	station, err := s.repo.GetOrCreateStation(ctx, strings.TrimSpace(req.Station), line.ID)
+	station, stationCreated, err := s.repo.GetOrCreateStation(ctx, strings.TrimSpace(req.Station), line.ID)
 	if err != nil {
 		log.Error(ctx, "Failed to get/create station", "error", err)
 		return nil, status.Error(codes.Internal, "failed to process station")
 	}
 
+	loc := incidentLocation{
+		lineID:      line.ID,
+		stationID:   station.ID,
+		lineName:    req.Line,
+		stationName: req.Station,
+	}
+	resp, err := s.storeIncident(ctx, req, fields, loc)
+	if err != nil {
+		return nil, err
+	}
+	resp.LineCreated = lineCreated
+	resp.StationCreated = stationCreated
+	return resp, nil
+}
+
+// incidentFields are the CreateIncident fields that are normalised before
+// they are stored. The request itself is left as the caller sent it.
+type incidentFields struct {
+	incidentType string
+	source       string
+	tags         []string
+}
+
+// incidentLocation is the line and station an incident is recorded against.
+type incidentLocation struct {
+	lineID      uuid.UUID
+	stationID   uuid.UUID
+	lineName    string
+	stationName string
+}
+
+// createIncidentByID records an incident against an existing line and station
+// given by ID. Unlike the name-based path it never creates either entity.
+func (s *Service) createIncidentByID(ctx context.Context, req *pb.CreateIncidentRequest, fields incidentFields) (*pb.IncidentResponse, error) {
+	lineID, err := uuid.Parse(req.LineId)
+	if err != nil {
+		return nil, validationStatus(newValidationError("line_id", "invalid line_id"))
+	}
+	stationID, err := uuid.Parse(req.StationId)
+	if err != nil {
+		return nil, validationStatus(newValidationError("station_id", "invalid station_id"))
+	}
+
+	log.Info(ctx, "Creating incident by ID", "line_id", lineID.String(), "station_id", stationID.String(), "dry_run", req.DryRun)
+
+	line, err := s.repo.GetLine(ctx, lineID)
+	if err == ErrNotFound {
+		return nil, status.Error(codes.NotFound, "line not found")
+	}
+	if err != nil {
+		log.Error(ctx, "Failed to get line", "error", err)
+		return nil, status.Error(codes.Internal, "failed to process line")
+	}
+
+	station, err := s.repo.GetStation(ctx, stationID)
+	if err == ErrNotFound {
+		return nil, status.Error(codes.NotFound, "station not found")
+	}
+	if err != nil {
+		log.Error(ctx, "Failed to get station", "error", err)
+		return nil, status.Error(codes.Internal, "failed to process station")
+	}
+
+	if !stationServesLine(station, lineID) {
+		return nil, validationStatus(newValidationError("station_id", "station is not served by the given line"))
+	}
+
+	loc := incidentLocation{
+		lineID:      line.ID,
+		stationID:   station.ID,
+		lineName:    line.Name,
+		stationName: station.Name,
+	}
+
+	if req.DryRun {
+		return &pb.IncidentResponse{
+			Line:            loc.lineName,
+			Station:         loc.stationName,
+			Timestamp:       req.Timestamp,
+			DurationMinutes: req.DurationMinutes,
+			IncidentType:    fields.incidentType,
+			LineId:          loc.lineID.String(),
+			StationId:       loc.stationID.String(),
+			Status:          s.incidentStatuses()[0],
+			Source:          fields.source,
+			Tags:            fields.tags,
+			DryRun:          true,
+		}, nil
+	}
+
+	return s.storeIncident(ctx, req, fields, loc)
+}
+
+func (s *Service) storeIncident(ctx context.Context, req *pb.CreateIncidentRequest, fields incidentFields, loc incidentLocation) (*pb.IncidentResponse, error) {
 	ts := req.Timestamp.AsTime()
-	incident, err := s.repo.CreateIncident(ctx, station.ID, line.ID, ts, req.DurationMinutes, req.IncidentType)
+	stationStatus := s.autoStationStatus(req, fields.incidentType)
+	var incident *Incident
+	var tags []string
+	var stationStatusUpdated bool
+	var err error
+	if stationStatus != "" || len(fields.tags) > 0 {
+		if stationStatus != "" {
+			log.Info(ctx, "Updating station status with incident", "station_id", loc.stationID.String(), "status", stationStatus)
+		}
+		// Station status and tags are written in the incident's transaction,
+		// so a failure leaves nothing behind for a retry to collide with.
+		opts := IncidentCreateOptions{StationStatus: stationStatus, Tags: fields.tags}
+		var result *IncidentCreateResult
+		result, err = s.repo.CreateIncidentWithOptions(ctx, loc.stationID, loc.lineID, ts, req.DurationMinutes, fields.incidentType, s.incidentStatuses()[0], fields.source, opts)
+		if err == nil {
+			incident, tags, stationStatusUpdated = result.Incident, result.Tags, result.StationStatusUpdated
+		}
+	} else {
+		incident, err = s.repo.CreateIncident(ctx, loc.stationID, loc.lineID, ts, req.DurationMinutes, fields.incidentType, s.incidentStatuses()[0], fields.source)
+	}
+	if err == ErrAlreadyExists {
+		return nil, status.Error(codes.AlreadyExists, "incident already exists for this station, line and timestamp")
+	}
 	if err != nil {
 		log.Error(ctx, "Failed to create incident", "error", err)
 		return nil, status.Error(codes.Internal, "failed to create incident")
@@ -106,16 +418,317 @@ func (s *Service) CreateIncident(ctx context.Context, req *pb.CreateIncidentRequ
 
 	log.Info(ctx, "Incident created successfully", "incident_id", incident.ID.String())
 
-	return &pb.IncidentResponse{
-		Id:              incident.ID.String(),
-		Line:            req.Line,
-		Station:         req.Station,
-		Timestamp:       timestamppb.New(incident.Timestamp),
-		DurationMinutes: incident.DurationMinutes,
-		IncidentType:    incident.IncidentType,
-		LineId:          line.ID.String(),
-		StationId:       station.ID.String(),
-		Status:          incident.Status,
+	resp := &pb.IncidentResponse{
+		Id:                   incident.ID.String(),
+		Line:                 loc.lineName,
+		Station:              loc.stationName,
+		Timestamp:            timestamppb.New(incident.Timestamp),
+		DurationMinutes:      incident.DurationMinutes,
+		IncidentType:         incident.IncidentType,
+		LineId:               loc.lineID.String(),
+		StationId:            loc.stationID.String(),
+		Status:               incident.Status,
+		Source:               stringValue(incident.Source),
+		CreatedAt:            timestamppb.New(incident.CreatedAt),
+		Tags:                 tags,
+		StationStatusUpdated: stationStatusUpdated,
+	}
+	s.cfg.IncidentWebhook.Notify(ctx, resp)
+	return resp, nil
+}
+
+// autoStationStatus returns the status an incident's station should be set to
+// when it is created, or "" to leave the station alone. Only requests with
+// auto_update_station_status qualify, and only for incident types in
+// maintenanceIncidentTypes lasting at least AutoMaintenanceMinDuration.
+// incidentType must already be normalised.
+func (s *Service) autoStationStatus(req *pb.CreateIncidentRequest, incidentType string) string {
+	if !req.AutoUpdateStationStatus || req.DurationMinutes < s.cfg.AutoMaintenanceMinDuration {
+		return ""
+	}
+	for _, t := range maintenanceIncidentTypes {
+		if incidentType == t {
+			return "maintenance"
+		}
+	}
+	return ""
+}
+
+func stationServesLine(station *StationWithLine, lineID uuid.UUID) bool {
+	if station.LineID == lineID {
+		return true
+	}
+	for _, ref := range station.Lines {
+		if ref.LineID == lineID {
+			return true
+		}
+	}
+	return false
+}
+
+// dryRunCreateIncident resolves the line and station without creating them and
+// returns the incident CreateIncident would have stored. IDs are left empty for
+// a line or station that does not exist yet.
+func (s *Service) dryRunCreateIncident(ctx context.Context, req *pb.CreateIncidentRequest, fields incidentFields) (*pb.IncidentResponse, error) {
+	lineName := strings.TrimSpace(req.Line)
+	stationName := strings.TrimSpace(req.Station)
+
+	log.Info(ctx, "Validating incident (dry run)", "line", lineName, "station", stationName)
+
+	resp := &pb.IncidentResponse{
+		Line:            lineName,
+		Station:         stationName,
+		Timestamp:       req.Timestamp,
+		DurationMinutes: req.DurationMinutes,
+		IncidentType:    fields.incidentType,
+		Status:          s.incidentStatuses()[0],
+		Source:          fields.source,
+		Tags:            fields.tags,
+		DryRun:          true,
+	}
+
+	line, err := s.repo.FindLineByName(ctx, lineName)
+	if err == ErrNotFound {
+		return resp, nil
+	}
+	if err != nil {
+		log.Error(ctx, "Failed to look up line", "error", err)
+		return nil, status.Error(codes.Internal, "failed to process line")
+	}
+	resp.Line = line.Name
+	resp.LineId = line.ID.String()
+
+	station, err := s.repo.FindStationByName(ctx, stationName, line.ID)
+	if err == ErrNotFound {
+		return resp, nil
+	}
+	if err != nil {
+		log.Error(ctx, "Failed to look up station", "error", err)
+		return nil, status.Error(codes.Internal, "failed to process station")
+	}
+	resp.StationId = station.ID.String()
+
+	return resp, nil
+}
+
+func (s *Service) UpdateIncidentStatus(ctx context.Context, req *pb.UpdateIncidentStatusRequest) (*pb.IncidentResponse, error) {
+	id, err := uuid.Parse(req.Id)
+	if err != nil {
+		return nil, validationStatus(newValidationError("id", "invalid incident ID"))
+	}
+
+	statusVal := strings.TrimSpace(req.Status)
+	if err := s.validateIncidentStatus(statusVal); err != nil {
+		return nil, validationStatus(err)
+	}
+
+	log.Info(ctx, "Updating incident status", "id", id.String(), "status", statusVal)
+
+	incident, err := s.repo.UpdateIncidentStatus(ctx, id, statusVal, "update_status")
+	if err == ErrNotFound {
+		return nil, status.Error(codes.NotFound, "incident not found")
+	}
+	if err != nil {
+		log.Error(ctx, "Failed to update incident status", "error", err)
+		return nil, status.Error(codes.Internal, "failed to update incident status")
+	}
+
+	log.Info(ctx, "Incident status updated successfully", "incident_id", incident.ID.String())
+
+	return incidentDetailsToProto(incident), nil
+}
+
+func (s *Service) UpdateIncident(ctx context.Context, req *pb.UpdateIncidentRequest) (*pb.IncidentResponse, error) {
+	id, err := uuid.Parse(req.Id)
+	if err != nil {
+		return nil, validationStatus(newValidationError("id", "invalid incident ID"))
+	}
+
+	if req.DurationMinutes == nil && req.IncidentType == nil {
+		return nil, validationStatus(newValidationError("duration_minutes", "at least one of duration_minutes or incident_type must be provided"))
+	}
+	if req.DurationMinutes != nil && (*req.DurationMinutes < 0 || *req.DurationMinutes > 1440) {
+		return nil, validationStatus(newValidationError("duration_minutes", "duration_minutes must be between 0 and 1440"))
+	}
+	if req.IncidentType != nil {
+		incidentType := normalizeIncidentType(*req.IncidentType)
+		req.IncidentType = &incidentType
+		if err := validateIncidentType(incidentType); err != nil {
+			return nil, validationStatus(err)
+		}
+	}
+
+	log.Info(ctx, "Updating incident", "id", id.String())
+
+	incident, err := s.repo.UpdateIncident(ctx, id, req.DurationMinutes, req.IncidentType)
+	if err == ErrNotFound {
+		return nil, status.Error(codes.NotFound, "incident not found")
+	}
+	if err != nil {
+		log.Error(ctx, "Failed to update incident", "error", err)
+		return nil, status.Error(codes.Internal, "failed to update incident")
+	}
+
+	log.Info(ctx, "Incident updated successfully", "incident_id", incident.ID.String())
+
+	return incidentDetailsToProto(incident), nil
+}
+
+func (s *Service) ReassignIncident(ctx context.Context, req *pb.ReassignIncidentRequest) (*pb.IncidentResponse, error) {
+	id, err := uuid.Parse(req.Id)
+	if err != nil {
+		return nil, validationStatus(newValidationError("id", "invalid incident ID"))
+	}
+	lineID, err := uuid.Parse(req.LineId)
+	if err != nil {
+		return nil, validationStatus(newValidationError("line_id", "invalid line ID"))
+	}
+
+	log.Info(ctx, "Reassigning incident", "id", id.String(), "line_id", lineID.String())
+
+	incident, err := s.repo.ReassignIncident(ctx, id, lineID)
+	if err == ErrNotFound {
+		return nil, status.Error(codes.NotFound, "incident not found")
+	}
+	if err == ErrLineNotFound {
+		return nil, status.Error(codes.NotFound, "line not found")
+	}
+	if err == ErrStationLineMismatch {
+		return nil, status.Error(codes.FailedPrecondition, "the incident's station is not on the target line")
+	}
+	if err == ErrAlreadyExists {
+		return nil, status.Error(codes.AlreadyExists, "the target line already has an incident at this station and time")
+	}
+	if err != nil {
+		log.Error(ctx, "Failed to reassign incident", "error", err)
+		return nil, status.Error(codes.Internal, "failed to reassign incident")
+	}
+
+	return incidentDetailsToProto(incident), nil
+}
+
+func (s *Service) ResolveIncident(ctx context.Context, req *pb.ResolveIncidentRequest) (*pb.IncidentResponse, error) {
+	id, err := uuid.Parse(req.Id)
+	if err != nil {
+		return nil, validationStatus(newValidationError("id", "invalid incident ID"))
+	}
+
+	resolved := s.resolvedStatus()
+	if err := s.validateIncidentStatus(resolved); err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "%s is not a configured incident status", resolved)
+	}
+
+	log.Info(ctx, "Resolving incident", "id", id.String())
+
+	incident, err := s.repo.UpdateIncidentStatus(ctx, id, resolved, "resolve")
+	if err == ErrNotFound {
+		return nil, status.Error(codes.NotFound, "incident not found")
+	}
+	if err != nil {
+		log.Error(ctx, "Failed to resolve incident", "error", err)
+		return nil, status.Error(codes.Internal, "failed to resolve incident")
+	}
+
+	log.Info(ctx, "Incident resolved successfully", "incident_id", incident.ID.String())
+
+	return incidentDetailsToProto(incident), nil
+}
+
+func (s *Service) ListIncidentsByTag(ctx context.Context, req *pb.ListIncidentsByTagRequest) (*pb.ListIncidentsByTagResponse, error) {
+	tag, err := requiredText("tag", strings.ToLower(req.Tag), maxTagLength)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	limit, err := s.listLimit(req.Limit, 50, 500, req.StrictLimit)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	timeField, err := parseTimeField(req.TimeField)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	log.Info(ctx, "Listing incidents by tag", "tag", tag, "limit", limit, "time_field", timeField)
+
+	incidents, err := s.repo.ListIncidentsByTag(ctx, tag, limit, timeField)
+	if err != nil {
+		log.Error(ctx, "Failed to list incidents by tag", "error", err)
+		return nil, status.Error(codes.Internal, "failed to list incidents by tag")
+	}
+
+	items := make([]*pb.IncidentResponse, len(incidents))
+	for i := range incidents {
+		items[i] = incidentDetailsToProto(&incidents[i])
+	}
+
+	return &pb.ListIncidentsByTagResponse{
+		Tag:       tag,
+		Incidents: items,
+	}, nil
+}
+
+func (s *Service) DeleteIncident(ctx context.Context, req *pb.DeleteIncidentRequest) (*emptypb.Empty, error) {
+	id, err := uuid.Parse(req.Id)
+	if err != nil {
+		return nil, validationStatus(newValidationError("id", "invalid incident ID"))
+	}
+
+	log.Info(ctx, "Deleting incident", "id", id.String())
+
+	err = s.repo.DeleteIncident(ctx, id)
+	if err == ErrNotFound {
+		return nil, status.Error(codes.NotFound, "incident not found")
+	}
+	if err != nil {
+		log.Error(ctx, "Failed to delete incident", "error", err)
+		return nil, status.Error(codes.Internal, "failed to delete incident")
+	}
+
+	log.Info(ctx, "Incident deleted successfully", "incident_id", id.String())
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *Service) GetIncidentHistory(ctx context.Context, req *pb.GetIncidentHistoryRequest) (*pb.IncidentHistoryResponse, error) {
+	id, err := uuid.Parse(req.Id)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid incident ID")
+	}
+
+	entries, err := s.repo.GetIncidentHistory(ctx, id)
+	if err != nil {
+		log.Error(ctx, "Failed to get incident history", "error", err)
+		return nil, status.Error(codes.Internal, "failed to get incident history")
+	}
+
+	if len(entries) == 0 {
+		_, err := s.repo.GetIncidentWithDetails(ctx, id)
+		if err == ErrNotFound {
+			return nil, status.Error(codes.NotFound, "incident not found")
+		}
+		if err != nil {
+			log.Error(ctx, "Failed to get incident", "error", err)
+			return nil, status.Error(codes.Internal, "failed to get incident history")
+		}
+	}
+
+	items := make([]*pb.IncidentAuditEntry, len(entries))
+	for i, e := range entries {
+		items[i] = &pb.IncidentAuditEntry{
+			Id:         e.ID.String(),
+			IncidentId: e.IncidentID.String(),
+			Action:     e.Action,
+			OldValues:  stringValue(e.OldValues),
+			NewValues:  stringValue(e.NewValues),
+			CreatedAt:  timestamppb.New(e.CreatedAt),
+		}
+	}
+
+	return &pb.IncidentHistoryResponse{
+		IncidentId: id.String(),
+		Entries:    items,
 	}, nil
 }
 
@@ -125,23 +738,38 @@ func (s *Service) GetTopBreakdowns(ctx context.Context, req *pb.TopBreakdownsReq
 		return nil, status.Error(codes.InvalidArgument, "scope must be 'line' or 'station'")
 	}
 
-	limit := req.Limit
-	if limit <= 0 {
-		limit = 5
+	limit, err := s.listLimit(req.Limit, 5, 100, req.StrictLimit)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
-	if limit > 100 {
-		limit = 100
+
+	lineName := strings.TrimSpace(req.Line)
+	if lineName != "" && scope != "station" {
+		return nil, status.Error(codes.InvalidArgument, "line filter requires scope 'station'")
 	}
 
-	log.Info(ctx, "Getting top breakdowns", "scope", scope, "limit", limit)
+	log.Info(ctx, "Getting top breakdowns", "scope", scope, "limit", limit, "line", lineName, "include_deleted", req.IncludeDeleted)
+
+	var lineID *uuid.UUID
+	if lineName != "" {
+		line, err := s.repo.FindLineByName(ctx, lineName)
+		if err == ErrNotFound {
+			return nil, status.Error(codes.NotFound, "line not found")
+		}
+		if err != nil {
+			log.Error(ctx, "Failed to find line", "error", err)
+			return nil, status.Error(codes.Internal, "failed to get breakdowns")
+		}
+		lineID = &line.ID
+	}
 
 	var breakdowns []BreakdownCount
-	var err error
+	var latest *time.Time
 
 	if scope == "line" {
-		breakdowns, err = s.repo.GetTopBreakdownsByLine(ctx, limit)
+		breakdowns, latest, err = s.repo.GetTopBreakdownsByLine(ctx, limit, req.IncludeDeleted)
 	} else {
-		breakdowns, err = s.repo.GetTopBreakdownsByStation(ctx, limit)
+		breakdowns, latest, err = s.repo.GetTopBreakdownsByStation(ctx, limit, lineID, req.IncludeDeleted)
 	}
 
 	if err != nil {
@@ -158,15 +786,16 @@ func (s *Service) GetTopBreakdowns(ctx context.Context, req *pb.TopBreakdownsReq
 	}
 
 	return &pb.TopBreakdownsResponse{
-		Scope: scope,
-		Items: items,
+		Scope:    scope,
+		Items:    items,
+		DataAsOf: dataAsOf(latest),
 	}, nil
 }
 
-func (s *Service) GetMTBF(ctx context.Context, _ *emptypb.Empty) (*pb.MTBFResponse, error) {
-	log.Info(ctx, "Calculating MTBF for all lines")
+func (s *Service) GetMTBF(ctx context.Context, req *pb.MTBFRequest) (*pb.MTBFResponse, error) {
+	log.Info(ctx, "Calculating MTBF for all lines", "include_deleted", req.IncludeDeleted)
 
-	results, err := s.repo.CalculateMTBF(ctx)
+	results, latest, err := s.repo.CalculateMTBF(ctx, req.IncludeDeleted)
 	if err != nil {
 		log.Error(ctx, "Failed to calculate MTBF", "error", err)
 		return nil, status.Error(codes.Internal, "failed to calculate MTBF")
@@ -181,28 +810,149 @@ func (s *Service) GetMTBF(ctx context.Context, _ *emptypb.Empty) (*pb.MTBFRespon
 	}
 
 	return &pb.MTBFResponse{
-		Lines: lines,
+		Lines:    lines,
+		DataAsOf: dataAsOf(latest),
 	}, nil
 }
 
-func (s *Service) GetRecentDisruptions(ctx context.Context, req *pb.RecentDisruptionsRequest) (*pb.RecentDisruptionsResponse, error) {
-	limit := req.Limit
-	if limit <= 0 {
-		limit = 20
+// dataAsOf converts the newest incident timestamp a result covers, as
+// returned with it by the repository, so clients can tell how fresh the
+// result is. It is nil when there are no incidents.
+func dataAsOf(latest *time.Time) *timestamppb.Timestamp {
+	if latest == nil {
+		return nil
+	}
+	return timestamppb.New(*latest)
+}
+
+func (s *Service) GetStationMTBF(ctx context.Context, req *pb.StationMTBFRequest) (*pb.StationMTBFResponse, error) {
+	stationID, err := uuid.Parse(req.StationId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid station ID")
+	}
+
+	log.Info(ctx, "Calculating MTBF for station", "station_id", stationID.String())
+
+	result, err := s.repo.GetStationMTBF(ctx, stationID)
+	if err == ErrNotFound {
+		return nil, status.Error(codes.NotFound, "station not found")
+	}
+	if err != nil {
+		log.Error(ctx, "Failed to calculate station MTBF", "error", err)
+		return nil, status.Error(codes.Internal, "failed to calculate station MTBF")
+	}
+
+	return &pb.StationMTBFResponse{
+		StationId:        stationID.String(),
+		Station:          result.StationName,
+		Line:             result.LineName,
+		IncidentCount:    result.IncidentCount,
+		MtbfMinutes:      result.MTBFMinutes,
+		InsufficientData: result.IncidentCount < 2,
+	}, nil
+}
+
+func (s *Service) GetWeightedMTBF(ctx context.Context, req *pb.WeightedMTBFRequest) (*pb.WeightedMTBFResponse, error) {
+	start, end, err := parseTimeRange(req.StartTime, req.EndTime)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
-	if limit > 100 {
-		limit = 100
+
+	rangeStart, rangeEnd, err := analyticsRange(start, end)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
 	lineName := strings.TrimSpace(req.Line)
-	stationName := strings.TrimSpace(req.Station)
 
-	log.Info(ctx, "Getting recent disruptions",
+	log.Info(ctx, "Calculating weighted MTBF",
 		"line", lineName,
-		"station", stationName,
+		"start_time", rangeStart,
+		"end_time", rangeEnd)
+
+	intervals, err := s.repo.GetIncidentIntervals(ctx, lineName, rangeStart, rangeEnd, req.IncludeDeleted)
+	if err != nil {
+		log.Error(ctx, "Failed to get incident intervals", "error", err)
+		return nil, status.Error(codes.Internal, "failed to calculate weighted MTBF")
+	}
+
+	results := computeWeightedMTBF(intervals)
+	lines := make([]*pb.WeightedMTBFLineItem, len(results))
+	for i, r := range results {
+		lines[i] = &pb.WeightedMTBFLineItem{
+			Name:                r.LineName,
+			MtbfMinutes:         r.MTBFMinutes,
+			WeightedMtbfMinutes: r.WeightedMTBFMinutes,
+			IntervalCount:       r.IntervalCount,
+		}
+	}
+
+	return &pb.WeightedMTBFResponse{
+		Lines: lines,
+	}, nil
+}
+
+func (s *Service) GetRecentDisruptions(ctx context.Context, req *pb.RecentDisruptionsRequest) (*pb.RecentDisruptionsResponse, error) {
+	limit, err := s.listLimit(req.Limit, 20, 100, req.StrictLimit)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := validateDurationRange(req.MinDuration, req.MaxDuration); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	start, end, err := parseTimeRange(req.StartTime, req.EndTime)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	timeField, err := parseTimeField(req.TimeField)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	lineNames, err := parseLineNames(req.Lines)
+	if err != nil {
+		return nil, validationStatus(err)
+	}
+
+	order, err := parseDisruptionSort(req.SortBy, req.SortOrder)
+	if err != nil {
+		return nil, validationStatus(err)
+	}
+
+	filter := IncidentFilter{
+		LineName:       strings.TrimSpace(req.Line),
+		LineNames:      lineNames,
+		StationName:    strings.TrimSpace(req.Station),
+		Source:         strings.TrimSpace(req.Source),
+		MinDuration:    req.MinDuration,
+		MaxDuration:    req.MaxDuration,
+		OnlyActive:     req.OnlyActive,
+		ResolvedStatus: s.resolvedStatus(),
+		Start:          start,
+		End:            end,
+		TimeField:      timeField,
+		IncludeDeleted: req.IncludeDeleted,
+		Sort:           order,
+	}
+
+	log.Info(ctx, "Getting recent disruptions",
+		"line", filter.LineName,
+		"lines", filter.LineNames,
+		"station", filter.StationName,
+		"source", filter.Source,
+		"only_active", filter.OnlyActive,
+		"start_time", start,
+		"end_time", end,
+		"time_field", timeField,
+		"include_deleted", filter.IncludeDeleted,
+		"sort_by", order.By,
+		"ascending", order.Ascending,
 		"limit", limit)
 
-	incidents, err := s.repo.GetRecentDisruptions(ctx, lineName, stationName, limit)
+	incidents, err := s.repo.GetRecentDisruptions(ctx, filter, limit)
 	if err != nil {
 		log.Error(ctx, "Failed to get recent disruptions", "error", err)
 		return nil, status.Error(codes.Internal, "failed to get disruptions")
@@ -217,6 +967,8 @@ func (s *Service) GetRecentDisruptions(ctx context.Context, req *pb.RecentDisrup
 			DurationMinutes: inc.DurationMinutes,
 			IncidentType:    inc.IncidentType,
 			Status:          inc.Status,
+			Source:          stringValue(inc.Source),
+			CreatedAt:       timestamppb.New(inc.CreatedAt),
 		}
 	}
 
@@ -225,62 +977,1414 @@ func (s *Service) GetRecentDisruptions(ctx context.Context, req *pb.RecentDisrup
 	}, nil
 }
 
-func (s *Service) validateIncidentRequest(req *pb.CreateIncidentRequest) error {
-	line := strings.TrimSpace(req.Line)
-	if line == "" {
-		return fmt.Errorf("line must not be empty")
-	}
-	if len(line) > 100 {
-		return fmt.Errorf("line must not exceed 100 characters")
+// parseLineNames trims a repeated line filter, rejecting blank names so a
+// stray empty value cannot silently widen or empty the result.
+func parseLineNames(lines []string) ([]string, error) {
+	if len(lines) == 0 {
+		return nil, nil
+	}
+	names := make([]string, len(lines))
+	for i, line := range lines {
+		names[i] = strings.TrimSpace(line)
+		if names[i] == "" {
+			return nil, newValidationError(fmt.Sprintf("lines[%d]", i), "lines must not contain empty names")
+		}
+	}
+	return names, nil
+}
+
+func (s *Service) StreamIncidentsExport(req *pb.StreamIncidentsExportRequest, stream grpc.ServerStreamingServer[pb.IncidentResponse]) error {
+	ctx := stream.Context()
+
+	start, end, err := parseTimeRange(req.StartTime, req.EndTime)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	timeField, err := parseTimeField(req.TimeField)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	log.Info(ctx, "Streaming incidents export", "start_time", start, "end_time", end, "time_field", timeField)
+
+	var sent int
+	err = s.repo.StreamIncidents(ctx, start, end, timeField, func(inc *IncidentWithDetails) error {
+		sent++
+		return stream.Send(incidentDetailsToProto(inc))
+	})
+	if ctxErr := ctx.Err(); ctxErr != nil {
+		log.Info(ctx, "Incidents export cancelled", "sent", sent)
+		return status.FromContextError(ctxErr).Err()
+	}
+	if err != nil {
+		log.Error(ctx, "Failed to stream incidents export", "error", err)
+		return status.Error(codes.Internal, "failed to export incidents")
+	}
+
+	log.Info(ctx, "Incidents export completed", "sent", sent)
+
+	return nil
+}
+
+func (s *Service) FindOrphanedIncidents(ctx context.Context, req *pb.OrphanedIncidentsRequest) (*pb.OrphanedIncidentsResponse, error) {
+	limit, err := s.listLimit(req.Limit, 100, 1000, req.StrictLimit)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	log.Info(ctx, "Finding orphaned incidents", "limit", limit)
+
+	orphans, err := s.repo.FindOrphanedIncidents(ctx, limit)
+	if err != nil {
+		log.Error(ctx, "Failed to find orphaned incidents", "error", err)
+		return nil, status.Error(codes.Internal, "failed to find orphaned incidents")
+	}
+
+	if len(orphans) > 0 {
+		log.Warn(ctx, "Orphaned incidents found", "count", len(orphans))
+	}
+
+	items := make([]*pb.OrphanedIncidentItem, len(orphans))
+	for i, o := range orphans {
+		items[i] = &pb.OrphanedIncidentItem{
+			Id:             o.ID.String(),
+			LineId:         o.LineID.String(),
+			StationId:      o.StationID.String(),
+			Timestamp:      timestamppb.New(o.Timestamp),
+			IncidentType:   o.IncidentType,
+			Status:         o.Status,
+			MissingLine:    o.MissingLine,
+			MissingStation: o.MissingStation,
+		}
+	}
+
+	return &pb.OrphanedIncidentsResponse{
+		Items: items,
+	}, nil
+}
+
+func (s *Service) NormalizeStationStatuses(ctx context.Context, _ *emptypb.Empty) (*pb.NormalizeStationStatusesResponse, error) {
+	if !s.cfg.AdminEnabled {
+		return nil, status.Error(codes.PermissionDenied, "admin RPCs are disabled")
+	}
+
+	log.Info(ctx, "Normalizing station statuses")
+
+	updated, err := s.repo.NormalizeStationStatuses(ctx)
+	if err != nil {
+		log.Error(ctx, "Failed to normalize station statuses", "error", err)
+		return nil, status.Error(codes.Internal, "failed to normalize station statuses")
+	}
+
+	log.Info(ctx, "Station statuses normalized", "updated", updated)
+
+	return &pb.NormalizeStationStatusesResponse{
+		UpdatedCount: int32(updated),
+	}, nil
+}
+
+func (s *Service) PurgeOldIncidents(ctx context.Context, req *pb.PurgeOldIncidentsRequest) (*pb.PurgeOldIncidentsResponse, error) {
+	if !s.cfg.AdminEnabled {
+		return nil, status.Error(codes.PermissionDenied, "admin RPCs are disabled")
+	}
+	if s.cfg.IncidentRetentionDays <= 0 {
+		return nil, status.Error(codes.FailedPrecondition, "incident retention is not configured")
+	}
+	if !req.Confirm {
+		return nil, validationStatus(newValidationError("confirm", "confirm must be true to purge incidents"))
+	}
+
+	cutoff, deleted, err := s.purgeIncidents(ctx)
+	if err != nil {
+		log.Error(ctx, "Failed to purge old incidents", "error", err, "deleted", deleted)
+		return nil, status.Error(codes.Internal, "failed to purge old incidents")
+	}
+
+	return &pb.PurgeOldIncidentsResponse{
+		DeletedCount: deleted,
+		Cutoff:       timestamppb.New(cutoff),
+	}, nil
+}
+
+// PurgeExpiredIncidents deletes incidents older than the configured
+// retention. It is run by the scheduled purge job.
+func (s *Service) PurgeExpiredIncidents(ctx context.Context) error {
+	_, _, err := s.purgeIncidents(ctx)
+	return err
+}
+
+func (s *Service) purgeIncidents(ctx context.Context) (time.Time, int64, error) {
+	cutoff := time.Now().UTC().AddDate(0, 0, -s.cfg.IncidentRetentionDays)
+
+	log.Info(ctx, "Purging old incidents", "cutoff", cutoff)
+
+	deleted, err := s.repo.PurgeIncidentsBefore(ctx, cutoff, purgeBatchSize)
+	if err != nil {
+		return cutoff, deleted, err
+	}
+
+	log.Info(ctx, "Old incidents purged", "cutoff", cutoff, "deleted", deleted)
+	return cutoff, deleted, nil
+}
+
+func (s *Service) GetSegmentIncidents(ctx context.Context, req *pb.SegmentIncidentsRequest) (*pb.SegmentIncidentsResponse, error) {
+	lineName := strings.TrimSpace(req.Line)
+	if lineName == "" {
+		return nil, status.Error(codes.InvalidArgument, "line must not be empty")
+	}
+
+	if len(req.Stations) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "stations must not be empty")
+	}
+	if len(req.Stations) > 100 {
+		return nil, status.Error(codes.InvalidArgument, "stations must not exceed 100 entries")
+	}
+
+	stationNames := make([]string, 0, len(req.Stations))
+	seen := make(map[string]bool, len(req.Stations))
+	for _, name := range req.Stations {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			return nil, status.Error(codes.InvalidArgument, "station names must not be empty")
+		}
+		if seen[name] {
+			continue
+		}
+		seen[name] = true
+		stationNames = append(stationNames, name)
+	}
+
+	start, end, err := parseTimeRange(req.StartTime, req.EndTime)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	log.Info(ctx, "Getting segment incidents", "line", lineName, "stations", len(stationNames))
+
+	counts, err := s.repo.GetSegmentIncidentCounts(ctx, lineName, stationNames, start, end, req.IncludeDeleted)
+	if err != nil {
+		log.Error(ctx, "Failed to get segment incidents", "error", err)
+		return nil, status.Error(codes.Internal, "failed to get segment incidents")
+	}
+
+	byName := make(map[string]int32, len(counts))
+	for _, c := range counts {
+		byName[c.Name] = c.Count
+	}
+
+	items := make([]*pb.SegmentStationItem, len(stationNames))
+	var total int32
+	for i, name := range stationNames {
+		count, ok := byName[name]
+		if !ok {
+			return nil, status.Errorf(codes.NotFound, "station %q not found on line %q", name, lineName)
+		}
+		items[i] = &pb.SegmentStationItem{
+			Station:       name,
+			IncidentCount: count,
+		}
+		total += count
+	}
+
+	return &pb.SegmentIncidentsResponse{
+		Line:           lineName,
+		Stations:       items,
+		TotalIncidents: total,
+	}, nil
+}
+
+func (s *Service) GetIncidentMatrix(ctx context.Context, req *pb.IncidentMatrixRequest) (*pb.IncidentMatrixResponse, error) {
+	start, end, err := parseTimeRange(req.StartTime, req.EndTime)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	log.Info(ctx, "Getting incident matrix", "start_time", start, "end_time", end)
+
+	counts, err := s.repo.GetIncidentTypeCountsByLine(ctx, start, end, req.IncludeDeleted)
+	if err != nil {
+		log.Error(ctx, "Failed to get incident matrix", "error", err)
+		return nil, status.Error(codes.Internal, "failed to get incident matrix")
+	}
+
+	var rows []*pb.IncidentMatrixRow
+	byLine := make(map[string]*pb.IncidentMatrixRow)
+	for _, c := range counts {
+		row, ok := byLine[c.LineName]
+		if !ok {
+			row = &pb.IncidentMatrixRow{
+				Line:         c.LineName,
+				CountsByType: make(map[string]int32, len(incidentTypes)),
+			}
+			for _, t := range incidentTypes {
+				row.CountsByType[t] = 0
+			}
+			byLine[c.LineName] = row
+			rows = append(rows, row)
+		}
+		if c.IncidentType != nil {
+			row.CountsByType[*c.IncidentType] += c.Count
+			row.Total += c.Count
+		}
+	}
+
+	return &pb.IncidentMatrixResponse{
+		IncidentTypes: incidentTypes,
+		Rows:          rows,
+	}, nil
+}
+
+func (s *Service) GetIncidentStatusCounts(ctx context.Context, req *pb.IncidentStatusCountsRequest) (*pb.IncidentStatusCountsResponse, error) {
+	start, end, err := parseTimeRange(req.StartTime, req.EndTime)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	lineName := strings.TrimSpace(req.Line)
+
+	log.Info(ctx, "Getting incident status counts", "line", lineName, "start_time", start, "end_time", end)
+
+	counts, err := s.repo.GetIncidentStatusCounts(ctx, lineName, start, end, req.IncludeDeleted)
+	if err != nil {
+		log.Error(ctx, "Failed to get incident status counts", "error", err)
+		return nil, status.Error(codes.Internal, "failed to get incident status counts")
+	}
+
+	// Every configured status is reported, with zero when unused; statuses
+	// left over from an earlier configuration are appended after them.
+	statuses := append([]string(nil), s.incidentStatuses()...)
+	countsByStatus := make(map[string]int32, len(statuses))
+	for _, st := range statuses {
+		countsByStatus[st] = 0
+	}
+	var total int32
+	for _, c := range counts {
+		if _, ok := countsByStatus[c.Status]; !ok {
+			statuses = append(statuses, c.Status)
+		}
+		countsByStatus[c.Status] += c.Count
+		total += c.Count
+	}
+
+	return &pb.IncidentStatusCountsResponse{
+		Statuses:       statuses,
+		CountsByStatus: countsByStatus,
+		Total:          total,
+	}, nil
+}
+
+func (s *Service) GetIncidentsDuringMaintenance(ctx context.Context, req *pb.IncidentsDuringMaintenanceRequest) (*pb.IncidentsDuringMaintenanceResponse, error) {
+	start, end, err := parseTimeRange(req.StartTime, req.EndTime)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	rangeStart, rangeEnd, err := analyticsRange(start, end)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	lineName := strings.TrimSpace(req.Line)
+
+	log.Info(ctx, "Getting incidents during maintenance",
+		"line", lineName,
+		"start_time", rangeStart,
+		"end_time", rangeEnd)
+
+	aggregates, err := s.repo.GetIncidentsDuringMaintenance(ctx, lineName, rangeStart, rangeEnd, req.IncludeDeleted)
+	if err != nil {
+		log.Error(ctx, "Failed to get incidents during maintenance", "error", err)
+		return nil, status.Error(codes.Internal, "failed to get incidents during maintenance")
+	}
+
+	items := make([]*pb.MaintenanceIncidentItem, len(aggregates))
+	var total int32
+	for i, a := range aggregates {
+		items[i] = &pb.MaintenanceIncidentItem{
+			Station:              a.StationName,
+			Line:                 a.LineName,
+			IncidentCount:        a.IncidentCount,
+			TotalDowntimeMinutes: a.TotalDowntimeMinutes,
+		}
+		total += a.IncidentCount
+	}
+
+	return &pb.IncidentsDuringMaintenanceResponse{
+		Items:          items,
+		TotalIncidents: total,
+	}, nil
+}
+
+func (s *Service) GetLongestIncidents(ctx context.Context, req *pb.LongestIncidentsRequest) (*pb.LongestIncidentsResponse, error) {
+	start, end, err := parseTimeRange(req.StartTime, req.EndTime)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	rangeStart, rangeEnd, err := analyticsRange(start, end)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	log.Info(ctx, "Getting longest incidents", "start_time", rangeStart, "end_time", rangeEnd)
+
+	incidents, err := s.repo.GetLongestIncidents(ctx, rangeStart, rangeEnd, req.IncludeDeleted)
+	if err != nil {
+		log.Error(ctx, "Failed to get longest incidents", "error", err)
+		return nil, status.Error(codes.Internal, "failed to get longest incidents")
+	}
+
+	items := make([]*pb.IncidentResponse, len(incidents))
+	for i := range incidents {
+		items[i] = incidentDetailsToProto(&incidents[i])
+	}
+
+	return &pb.LongestIncidentsResponse{
+		Incidents: items,
+	}, nil
+}
+
+func (s *Service) GetNetworkOverview(ctx context.Context, req *pb.NetworkOverviewRequest) (*pb.NetworkOverviewResponse, error) {
+	start, end, err := parseTimeRange(req.StartTime, req.EndTime)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// Key on the range as requested so the default "last 30 days" range,
+	// which moves with the clock, still hits the cache between dashboard loads.
+	cacheKey := timeRangeKey(start, end) + "|" + fmt.Sprint(req.IncludeDeleted)
+	if overview, ok := s.networkOverview.Get(cacheKey); ok {
+		return networkOverviewToProto(&overview), nil
+	}
+
+	rangeStart, rangeEnd, err := analyticsRange(start, end)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	log.Info(ctx, "Getting network overview", "start_time", rangeStart, "end_time", rangeEnd)
+
+	overview, err := s.repo.GetNetworkOverview(ctx, rangeStart, rangeEnd, req.IncludeDeleted)
+	if err != nil {
+		log.Error(ctx, "Failed to get network overview", "error", err)
+		return nil, status.Error(codes.Internal, "failed to get network overview")
+	}
+
+	for _, failure := range overview.Failures {
+		log.Error(ctx, "Failed to get network overview section", "section", failure.Section, "error", failure.Err)
+	}
+	if len(overview.Failures) > 0 && !req.BestEffort {
+		return nil, status.Error(codes.Internal, "failed to get network overview")
+	}
+	// Partial overviews are not cached so the next load retries the failed
+	// sections.
+	if len(overview.Failures) == 0 {
+		s.networkOverview.Set(cacheKey, *overview)
+	}
+
+	return networkOverviewToProto(overview), nil
+}
+
+// timeRangeKey formats an optional range for use as a cache key; unset bounds
+// are left empty.
+func timeRangeKey(start, end *time.Time) string {
+	var key [2]string
+	for i, t := range []*time.Time{start, end} {
+		if t != nil {
+			key[i] = t.UTC().Format(time.RFC3339Nano)
+		}
+	}
+	return key[0] + "|" + key[1]
+}
+
+func networkOverviewToProto(overview *NetworkOverview) *pb.NetworkOverviewResponse {
+	return &pb.NetworkOverviewResponse{
+		TotalLines:           overview.TotalLines,
+		TotalStations:        overview.TotalStations,
+		TotalIncidents:       overview.TotalIncidents,
+		TotalDowntimeMinutes: overview.DowntimeMinutes,
+		MtbfMinutes:          overview.MTBFMinutes,
+		FailedSections:       sectionFailuresToProto(overview.Failures),
+	}
+}
+
+// sectionFailuresToProto reports failed sections to the client with a coarse
+// reason; the underlying database error is only logged.
+func sectionFailuresToProto(failures []SectionFailure) []*pb.SectionFailure {
+	if len(failures) == 0 {
+		return nil
+	}
+	items := make([]*pb.SectionFailure, len(failures))
+	for i, failure := range failures {
+		reason := "query failed"
+		if errors.Is(failure.Err, context.DeadlineExceeded) || isQueryCanceled(failure.Err) {
+			reason = "query timed out"
+		}
+		items[i] = &pb.SectionFailure{
+			Section: failure.Section,
+			Reason:  reason,
+		}
+	}
+	return items
+}
+
+func (s *Service) GetDurationPercentiles(ctx context.Context, req *pb.DurationPercentilesRequest) (*pb.DurationPercentilesResponse, error) {
+	start, end, err := parseTimeRange(req.StartTime, req.EndTime)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	rangeStart, rangeEnd, err := analyticsRange(start, end)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	log.Info(ctx, "Getting duration percentiles", "start_time", rangeStart, "end_time", rangeEnd)
+
+	results, err := s.repo.GetDurationPercentiles(ctx, rangeStart, rangeEnd, req.IncludeDeleted)
+	if err != nil {
+		log.Error(ctx, "Failed to get duration percentiles", "error", err)
+		return nil, status.Error(codes.Internal, "failed to get duration percentiles")
+	}
+
+	lines := make([]*pb.DurationPercentilesLineItem, len(results))
+	for i, r := range results {
+		lines[i] = &pb.DurationPercentilesLineItem{
+			Name:          r.LineName,
+			IncidentCount: r.IncidentCount,
+			P50Minutes:    r.P50Minutes,
+			P90Minutes:    r.P90Minutes,
+			P95Minutes:    r.P95Minutes,
+			P99Minutes:    r.P99Minutes,
+		}
+	}
+
+	return &pb.DurationPercentilesResponse{
+		Lines: lines,
+	}, nil
+}
+
+func (s *Service) GetLongestQuietPeriod(ctx context.Context, req *pb.LongestQuietPeriodRequest) (*pb.LongestQuietPeriodResponse, error) {
+	start, end, err := parseTimeRange(req.StartTime, req.EndTime)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	rangeStart, rangeEnd, err := analyticsRange(start, end)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	log.Info(ctx, "Getting longest quiet periods", "start_time", rangeStart, "end_time", rangeEnd)
+
+	results, err := s.repo.GetLongestQuietPeriods(ctx, rangeStart, rangeEnd, req.IncludeDeleted)
+	if err != nil {
+		log.Error(ctx, "Failed to get longest quiet periods", "error", err)
+		return nil, status.Error(codes.Internal, "failed to get longest quiet periods")
+	}
+
+	lines := make([]*pb.QuietPeriodLineItem, len(results))
+	for i, r := range results {
+		lines[i] = &pb.QuietPeriodLineItem{
+			Name:           r.LineName,
+			GapMinutes:     r.GapMinutes,
+			StartTime:      timestamppb.New(r.StartTime),
+			EndTime:        timestamppb.New(r.EndTime),
+			StartTimeLabel: displayLabel(r.StartTime, time.UTC),
+			EndTimeLabel:   displayLabel(r.EndTime, time.UTC),
+		}
+	}
+
+	return &pb.LongestQuietPeriodResponse{
+		Lines: lines,
+	}, nil
+}
+
+func (s *Service) GetSLABreaches(ctx context.Context, req *pb.SLABreachesRequest) (*pb.SLABreachesResponse, error) {
+	if req.ThresholdMinutes < 1 || req.ThresholdMinutes > 1440 {
+		return nil, validationStatus(newValidationError("threshold_minutes", "threshold_minutes must be between 1 and 1440"))
+	}
+
+	start, end, err := parseTimeRange(req.StartTime, req.EndTime)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	rangeStart, rangeEnd, err := analyticsRange(start, end)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	log.Info(ctx, "Getting SLA breaches", "threshold_minutes", req.ThresholdMinutes, "start_time", rangeStart, "end_time", rangeEnd)
+
+	results, err := s.repo.GetSLABreaches(ctx, req.ThresholdMinutes, rangeStart, rangeEnd, req.IncludeDeleted)
+	if err != nil {
+		log.Error(ctx, "Failed to get SLA breaches", "error", err)
+		return nil, status.Error(codes.Internal, "failed to get SLA breaches")
+	}
+
+	lines := make([]*pb.SLABreachLineItem, len(results))
+	for i, r := range results {
+		lines[i] = &pb.SLABreachLineItem{
+			Name:           r.LineName,
+			TotalIncidents: r.TotalIncidents,
+			Breaches:       r.Breaches,
+			BreachRate:     r.BreachRate,
+		}
+	}
+
+	return &pb.SLABreachesResponse{
+		ThresholdMinutes: req.ThresholdMinutes,
+		Lines:            lines,
+	}, nil
+}
+
+func (s *Service) GetConcurrentIncidents(ctx context.Context, req *pb.ConcurrentIncidentsRequest) (*pb.ConcurrentIncidentsResponse, error) {
+	start, end, err := parseTimeRange(req.StartTime, req.EndTime)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	rangeStart, rangeEnd, err := analyticsRange(start, end)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	limit, err := s.listLimit(req.Limit, 100, 1000, req.StrictLimit)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	lineName := strings.TrimSpace(req.Line)
+
+	log.Info(ctx, "Getting concurrent incidents",
+		"line", lineName,
+		"start_time", rangeStart,
+		"end_time", rangeEnd,
+		"limit", limit)
+
+	pairs, err := s.repo.GetConcurrentIncidents(ctx, lineName, rangeStart, rangeEnd, limit, req.IncludeDeleted)
+	if err != nil {
+		log.Error(ctx, "Failed to get concurrent incidents", "error", err)
+		return nil, status.Error(codes.Internal, "failed to get concurrent incidents")
+	}
+
+	items := make([]*pb.ConcurrentIncidentPair, len(pairs))
+	for i := range pairs {
+		p := &pairs[i]
+		items[i] = &pb.ConcurrentIncidentPair{
+			Line:           p.First.LineName,
+			First:          incidentDetailsToProto(&p.First),
+			Second:         incidentDetailsToProto(&p.Second),
+			OverlapStart:   timestamppb.New(p.OverlapStart),
+			OverlapEnd:     timestamppb.New(p.OverlapEnd),
+			OverlapMinutes: p.OverlapEnd.Sub(p.OverlapStart).Minutes(),
+		}
+	}
+
+	return &pb.ConcurrentIncidentsResponse{
+		Pairs: items,
+	}, nil
+}
+
+func (s *Service) GetIncidentTypeByHour(ctx context.Context, req *pb.IncidentTypeByHourRequest) (*pb.IncidentTypeByHourResponse, error) {
+	start, end, err := parseTimeRange(req.StartTime, req.EndTime)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	rangeStart, rangeEnd, err := analyticsRange(start, end)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	lineName := strings.TrimSpace(req.Line)
+
+	log.Info(ctx, "Getting incident types by hour",
+		"line", lineName,
+		"start_time", rangeStart,
+		"end_time", rangeEnd)
+
+	counts, err := s.repo.GetIncidentTypeCountsByHour(ctx, lineName, rangeStart, rangeEnd, req.IncludeDeleted)
+	if err != nil {
+		log.Error(ctx, "Failed to get incident types by hour", "error", err)
+		return nil, status.Error(codes.Internal, "failed to get incident types by hour")
+	}
+
+	rows := make([]*pb.IncidentHourRow, 24)
+	for hour := range rows {
+		row := &pb.IncidentHourRow{
+			Hour:         int32(hour),
+			CountsByType: make(map[string]int32, len(incidentTypes)),
+		}
+		for _, t := range incidentTypes {
+			row.CountsByType[t] = 0
+		}
+		rows[hour] = row
+	}
+	for _, c := range counts {
+		if c.Hour < 0 || c.Hour > 23 {
+			continue
+		}
+		row := rows[c.Hour]
+		row.CountsByType[c.IncidentType] += c.Count
+		row.Total += c.Count
+	}
+
+	return &pb.IncidentTypeByHourResponse{
+		IncidentTypes: incidentTypes,
+		Rows:          rows,
+	}, nil
+}
+
+func (s *Service) GetLineTypeDistribution(ctx context.Context, req *pb.LineTypeDistributionRequest) (*pb.LineTypeDistributionResponse, error) {
+	lineName := strings.TrimSpace(req.Line)
+	if lineName == "" {
+		return nil, status.Error(codes.InvalidArgument, "line must not be empty")
+	}
+
+	start, end, err := parseTimeRange(req.StartTime, req.EndTime)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	rangeStart, rangeEnd, err := analyticsRange(start, end)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	log.Info(ctx, "Getting line type distribution",
+		"line", lineName,
+		"start_time", rangeStart,
+		"end_time", rangeEnd)
+
+	line, err := s.repo.FindLineByName(ctx, lineName)
+	if err == ErrNotFound {
+		return nil, status.Error(codes.NotFound, "line not found")
+	}
+	if err != nil {
+		log.Error(ctx, "Failed to find line", "error", err)
+		return nil, status.Error(codes.Internal, "failed to get line type distribution")
+	}
+
+	counts, err := s.repo.GetLineIncidentTypeCounts(ctx, line.ID, rangeStart, rangeEnd, req.IncludeDeleted)
+	if err != nil {
+		log.Error(ctx, "Failed to get line type distribution", "error", err)
+		return nil, status.Error(codes.Internal, "failed to get line type distribution")
+	}
+
+	// Configured types come first, in their usual order, so every chart has
+	// the same legend; any other type found in the data follows.
+	byType := make(map[string]int32, len(counts))
+	types := append([]string(nil), incidentTypes...)
+	for _, c := range counts {
+		if _, ok := byType[c.IncidentType]; !ok && validateIncidentType(c.IncidentType) != nil {
+			types = append(types, c.IncidentType)
+		}
+		byType[c.IncidentType] += c.Count
+	}
+
+	values := make([]int32, len(types))
+	var total int32
+	for i, t := range types {
+		values[i] = byType[t]
+		total += values[i]
+	}
+	percentages := sharePercentages(values)
+
+	shares := make([]*pb.LineTypeShare, len(types))
+	for i, t := range types {
+		shares[i] = &pb.LineTypeShare{
+			IncidentType: t,
+			Count:        values[i],
+			Percentage:   percentages[i],
+		}
+	}
+
+	return &pb.LineTypeDistributionResponse{
+		Line:           line.Name,
+		TotalIncidents: total,
+		Types:          shares,
+	}, nil
+}
+
+func (s *Service) GetLineTopIncidentType(ctx context.Context, req *pb.LineTopIncidentTypeRequest) (*pb.LineTopIncidentTypeResponse, error) {
+	start, end, err := parseTimeRange(req.StartTime, req.EndTime)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	rangeStart, rangeEnd, err := analyticsRange(start, end)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	log.Info(ctx, "Getting top incident type per line",
+		"start_time", rangeStart,
+		"end_time", rangeEnd)
+
+	tops, err := s.repo.GetTopIncidentTypeByLine(ctx, rangeStart, rangeEnd, req.IncludeDeleted)
+	if err != nil {
+		log.Error(ctx, "Failed to get top incident type per line", "error", err)
+		return nil, status.Error(codes.Internal, "failed to get top incident type per line")
+	}
+
+	items := make([]*pb.LineTopIncidentTypeItem, len(tops))
+	for i, t := range tops {
+		items[i] = &pb.LineTopIncidentTypeItem{
+			Line:         t.LineName,
+			IncidentType: stringValue(t.IncidentType),
+			Count:        t.Count,
+		}
+	}
+
+	return &pb.LineTopIncidentTypeResponse{
+		Items: items,
+	}, nil
+}
+
+func (s *Service) GetTimeSinceLastIncident(ctx context.Context, req *pb.TimeSinceLastIncidentRequest) (*pb.TimeSinceLastIncidentResponse, error) {
+	lineName := strings.TrimSpace(req.Line)
+
+	log.Info(ctx, "Getting time since last incident", "line", lineName)
+
+	lasts, err := s.repo.GetLastIncidentTimes(ctx, lineName, req.IncludeDeleted)
+	if err != nil {
+		log.Error(ctx, "Failed to get time since last incident", "error", err)
+		return nil, status.Error(codes.Internal, "failed to get time since last incident")
+	}
+
+	now := time.Now().UTC()
+	items := make([]*pb.LineTimeSinceLastIncident, len(lasts))
+	for i, l := range lasts {
+		item := &pb.LineTimeSinceLastIncident{Line: l.LineName}
+		if l.LastIncidentAt != nil {
+			item.LastIncidentAt = timestamppb.New(*l.LastIncidentAt)
+			// Incidents timestamped slightly ahead of the server clock count
+			// as having just happened.
+			minutes := round2(math.Max(now.Sub(*l.LastIncidentAt).Minutes(), 0))
+			item.MinutesSinceLastIncident = &minutes
+		}
+		items[i] = item
+	}
+
+	return &pb.TimeSinceLastIncidentResponse{
+		AsOf:  timestamppb.New(now),
+		Lines: items,
+	}, nil
+}
+
+// sharePercentages converts counts into percentages with two decimal places
+// that add up to exactly 100, handing the hundredths lost to rounding down to
+// the entries with the largest remainders. All zeros are returned when the
+// counts sum to zero.
+func sharePercentages(counts []int32) []float64 {
+	var total int64
+	for _, c := range counts {
+		total += int64(c)
+	}
+
+	percentages := make([]float64, len(counts))
+	if total == 0 {
+		return percentages
+	}
+
+	const scale = 10000
+	units := make([]int64, len(counts))
+	remainders := make([]int64, len(counts))
+	var assigned int64
+	for i, c := range counts {
+		units[i] = int64(c) * scale / total
+		remainders[i] = int64(c) * scale % total
+		assigned += units[i]
+	}
+
+	order := make([]int, len(counts))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(a, b int) bool {
+		return remainders[order[a]] > remainders[order[b]]
+	})
+	for i := int64(0); i < scale-assigned; i++ {
+		units[order[i]]++
+	}
+
+	for i, u := range units {
+		percentages[i] = float64(u) / 100
+	}
+	return percentages
+}
+
+func (s *Service) GetRollingIncidentAverage(ctx context.Context, req *pb.RollingAverageRequest) (*pb.RollingAverageResponse, error) {
+	start, end, err := parseTimeRange(req.StartTime, req.EndTime)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	rangeStart, rangeEnd, err := analyticsRange(start, end)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	lineName := strings.TrimSpace(req.Line)
+
+	log.Info(ctx, "Getting rolling incident average",
+		"line", lineName,
+		"start_time", rangeStart,
+		"end_time", rangeEnd)
+
+	results, err := s.repo.GetRollingIncidentAverage(ctx, lineName, rangeStart, rangeEnd, req.IncludeDeleted)
+	if err != nil {
+		log.Error(ctx, "Failed to get rolling incident average", "error", err)
+		return nil, status.Error(codes.Internal, "failed to get rolling incident average")
+	}
+
+	items := make([]*pb.RollingAverageItem, len(results))
+	for i, r := range results {
+		items[i] = &pb.RollingAverageItem{
+			Line:           r.LineName,
+			Day:            timestamppb.New(r.Day),
+			Count:          r.Count,
+			RollingAverage: r.RollingAverage,
+			DayLabel:       displayLabel(r.Day, time.UTC),
+		}
+	}
+
+	return &pb.RollingAverageResponse{
+		Items: items,
+	}, nil
+}
+
+func (s *Service) GetCumulativeIncidents(ctx context.Context, req *pb.CumulativeIncidentsRequest) (*pb.CumulativeIncidentsResponse, error) {
+	start, end, err := parseTimeRange(req.StartTime, req.EndTime)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	rangeStart, rangeEnd, err := analyticsRange(start, end)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	lineName := strings.TrimSpace(req.Line)
+
+	log.Info(ctx, "Getting cumulative incidents",
+		"line", lineName,
+		"start_time", rangeStart,
+		"end_time", rangeEnd)
+
+	var lineID *uuid.UUID
+	if lineName != "" {
+		line, err := s.repo.FindLineByName(ctx, lineName)
+		if err == ErrNotFound {
+			return nil, status.Error(codes.NotFound, "line not found")
+		}
+		if err != nil {
+			log.Error(ctx, "Failed to find line", "error", err)
+			return nil, status.Error(codes.Internal, "failed to get cumulative incidents")
+		}
+		lineID = &line.ID
+	}
+
+	results, err := s.repo.GetCumulativeIncidents(ctx, lineID, rangeStart, rangeEnd, req.IncludeDeleted)
+	if err != nil {
+		log.Error(ctx, "Failed to get cumulative incidents", "error", err)
+		return nil, status.Error(codes.Internal, "failed to get cumulative incidents")
+	}
+
+	items := make([]*pb.CumulativeIncidentsItem, len(results))
+	var total int32
+	for i, r := range results {
+		items[i] = &pb.CumulativeIncidentsItem{
+			Day:             timestamppb.New(r.Day),
+			Count:           r.Count,
+			CumulativeCount: r.CumulativeCount,
+			DayLabel:        displayLabel(r.Day, time.UTC),
+		}
+		total = r.CumulativeCount
+	}
+
+	return &pb.CumulativeIncidentsResponse{
+		Line:  lineName,
+		Items: items,
+		Total: total,
+	}, nil
+}
+
+func (s *Service) GetBusiestPeriod(ctx context.Context, req *pb.BusiestPeriodRequest) (*pb.BusiestPeriodResponse, error) {
+	lineName := strings.TrimSpace(req.Line)
+	if lineName == "" {
+		return nil, status.Error(codes.InvalidArgument, "line must not be empty")
+	}
+
+	windowMinutes := req.WindowMinutes
+	if windowMinutes == 0 {
+		windowMinutes = 60
+	}
+	if windowMinutes < 1 || windowMinutes > 1440 {
+		return nil, status.Error(codes.InvalidArgument, "window_minutes must be between 1 and 1440")
+	}
+
+	start, end, err := parseTimeRange(req.StartTime, req.EndTime)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	rangeStart, rangeEnd, err := analyticsRange(start, end)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	window := time.Duration(windowMinutes) * time.Minute
+
+	log.Info(ctx, "Getting busiest period",
+		"line", lineName,
+		"window_minutes", windowMinutes,
+		"start_time", rangeStart,
+		"end_time", rangeEnd)
+
+	period, err := s.repo.GetBusiestPeriod(ctx, lineName, rangeStart, rangeEnd, window, req.IncludeDeleted)
+	if err == ErrNotFound {
+		return nil, status.Error(codes.NotFound, fmt.Sprintf("no incidents found for line %q in the given range", lineName))
+	}
+	if err != nil {
+		log.Error(ctx, "Failed to get busiest period", "error", err)
+		return nil, status.Error(codes.Internal, "failed to get busiest period")
+	}
+
+	windowEnd := period.WindowStart.Add(window)
+	return &pb.BusiestPeriodResponse{
+		Line:                 lineName,
+		WindowStart:          timestamppb.New(period.WindowStart),
+		WindowEnd:            timestamppb.New(windowEnd),
+		IncidentCount:        period.IncidentCount,
+		TotalDowntimeMinutes: period.TotalDowntimeMinutes,
+		WindowStartLabel:     displayLabel(period.WindowStart, time.UTC),
+		WindowEndLabel:       displayLabel(windowEnd, time.UTC),
+	}, nil
+}
+
+func (s *Service) GetStationGrades(ctx context.Context, req *pb.StationGradesRequest) (*pb.StationGradesResponse, error) {
+	start, end, err := parseTimeRange(req.StartTime, req.EndTime)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	rangeStart, rangeEnd, err := analyticsRange(start, end)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	lineName := strings.TrimSpace(req.Line)
+
+	log.Info(ctx, "Getting station grades",
+		"line", lineName,
+		"start_time", rangeStart,
+		"end_time", rangeEnd)
+
+	aggregates, err := s.repo.GetStationIncidentAggregates(ctx, lineName, rangeStart, rangeEnd, req.IncludeDeleted)
+	if err != nil {
+		log.Error(ctx, "Failed to get station incident aggregates", "error", err)
+		return nil, status.Error(codes.Internal, "failed to get station grades")
+	}
+
+	thresholds := s.gradeThresholds()
+	items := make([]*pb.StationGradeItem, len(aggregates))
+	for i, a := range aggregates {
+		items[i] = &pb.StationGradeItem{
+			Station:              a.StationName,
+			Line:                 a.LineName,
+			Grade:                gradeStation(thresholds, a.IncidentCount, a.TotalDowntimeMinutes),
+			IncidentCount:        a.IncidentCount,
+			TotalDowntimeMinutes: a.TotalDowntimeMinutes,
+		}
+	}
+
+	return &pb.StationGradesResponse{
+		Items: items,
+	}, nil
+}
+
+func (s *Service) GetIncidentsForMap(ctx context.Context, req *pb.IncidentMapRequest) (*pb.IncidentMapResponse, error) {
+	start, end, err := parseTimeRange(req.StartTime, req.EndTime)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	rangeStart, rangeEnd, err := analyticsRange(start, end)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	lineName := strings.TrimSpace(req.Line)
+
+	log.Info(ctx, "Getting incidents for map",
+		"line", lineName,
+		"start_time", rangeStart,
+		"end_time", rangeEnd,
+		"include_missing_geo", req.IncludeMissingGeo)
+
+	incidents, err := s.repo.GetIncidentsForMap(ctx, lineName, rangeStart, rangeEnd, req.IncludeMissingGeo, req.IncludeDeleted)
+	if err != nil {
+		log.Error(ctx, "Failed to get incidents for map", "error", err)
+		return nil, status.Error(codes.Internal, "failed to get incidents for map")
+	}
+
+	items := make([]*pb.IncidentMapItem, len(incidents))
+	for i, inc := range incidents {
+		item := &pb.IncidentMapItem{
+			Id:              inc.ID.String(),
+			Line:            inc.LineName,
+			Station:         inc.StationName,
+			Timestamp:       timestamppb.New(inc.Timestamp),
+			DurationMinutes: inc.DurationMinutes,
+			IncidentType:    inc.IncidentType,
+			Status:          inc.Status,
+		}
+		if inc.Latitude != nil && inc.Longitude != nil {
+			item.Latitude = *inc.Latitude
+			item.Longitude = *inc.Longitude
+			item.HasLocation = true
+		}
+		items[i] = item
+	}
+
+	return &pb.IncidentMapResponse{
+		Items: items,
+	}, nil
+}
+
+func (s *Service) GetStationStatusSummary(ctx context.Context, _ *emptypb.Empty) (*pb.StationStatusSummaryResponse, error) {
+	log.Info(ctx, "Getting station status summary")
+
+	counts, err := s.repo.GetStationStatusCounts(ctx)
+	if err != nil {
+		log.Error(ctx, "Failed to get station status counts", "error", err)
+		return nil, status.Error(codes.Internal, "failed to get station status summary")
+	}
+
+	// Rows arrive grouped by line in display order; each line gets one item
+	// with every status present, zero when no station has it.
+	var items []*pb.StationStatusSummaryItem
+	byLine := make(map[uuid.UUID]*pb.StationStatusSummaryItem)
+	for _, c := range counts {
+		item, ok := byLine[c.LineID]
+		if !ok {
+			item = &pb.StationStatusSummaryItem{LineId: c.LineID.String(), Line: c.LineName}
+			byLine[c.LineID] = item
+			items = append(items, item)
+		}
+		if c.Status == nil {
+			continue
+		}
+		switch *c.Status {
+		case "active":
+			item.Active += c.Count
+		case "inactive":
+			item.Inactive += c.Count
+		case "maintenance":
+			item.Maintenance += c.Count
+		case "closed":
+			item.Closed += c.Count
+		}
+		item.Total += c.Count
+	}
+
+	return &pb.StationStatusSummaryResponse{Items: items}, nil
+}
+
+func (s *Service) GetStationVsLineAverage(ctx context.Context, req *pb.StationVsLineAverageRequest) (*pb.StationVsLineAverageResponse, error) {
+	stationID, err := uuid.Parse(req.StationId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid station ID")
+	}
+
+	start, end, err := parseTimeRange(req.StartTime, req.EndTime)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	rangeStart, rangeEnd, err := analyticsRange(start, end)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	log.Info(ctx, "Comparing station with line average",
+		"station_id", stationID.String(),
+		"start_time", rangeStart,
+		"end_time", rangeEnd)
+
+	cmp, err := s.repo.GetStationLineComparison(ctx, stationID, rangeStart, rangeEnd)
+	if err == ErrNotFound {
+		return nil, status.Error(codes.NotFound, "station not found")
+	}
+	if err != nil {
+		log.Error(ctx, "Failed to compare station with line average", "error", err)
+		return nil, status.Error(codes.Internal, "failed to compare station with line average")
+	}
+
+	return &pb.StationVsLineAverageResponse{
+		Station:                cmp.StationName,
+		Line:                   cmp.LineName,
+		IncidentCount:          cmp.IncidentCount,
+		TotalDowntimeMinutes:   cmp.TotalDowntimeMinutes,
+		LineStationCount:       cmp.LineStationCount,
+		LineAvgIncidentCount:   round2(cmp.LineAvgIncidentCount),
+		LineAvgDowntimeMinutes: round2(cmp.LineAvgDowntimeMinutes),
+		IncidentCountDelta:     round2(float64(cmp.IncidentCount) - cmp.LineAvgIncidentCount),
+		DowntimeDeltaMinutes:   round2(float64(cmp.TotalDowntimeMinutes) - cmp.LineAvgDowntimeMinutes),
+	}, nil
+}
+
+func parseTimeRange(startTS, endTS *timestamppb.Timestamp) (*time.Time, *time.Time, error) {
+	var start, end *time.Time
+	if startTS != nil {
+		t := startTS.AsTime()
+		start = &t
+	}
+	if endTS != nil {
+		t := endTS.AsTime()
+		end = &t
+	}
+	if start != nil && end != nil && end.Before(*start) {
+		return nil, nil, fmt.Errorf("end_time must not be before start_time")
+	}
+	return start, end, nil
+}
+
+// analyticsRange resolves the optional bounds of an analytics request: end
+// defaults to now and start to 30 days before end. The range may span at most
+// 366 days and must not end before it starts, which a future start_time
+// without an end_time would otherwise do.
+func analyticsRange(start, end *time.Time) (time.Time, time.Time, error) {
+	rangeEnd := time.Now().UTC()
+	if end != nil {
+		rangeEnd = *end
+	}
+	rangeStart := rangeEnd.AddDate(0, 0, -30)
+	if start != nil {
+		rangeStart = *start
+	}
+	if rangeStart.After(rangeEnd) {
+		return time.Time{}, time.Time{}, fmt.Errorf("start_time must not be after end_time, which defaults to now")
+	}
+	if rangeEnd.Sub(rangeStart) > 366*24*time.Hour {
+		return time.Time{}, time.Time{}, fmt.Errorf("date range must not exceed 366 days")
+	}
+	return rangeStart, rangeEnd, nil
+}
+
+// parseTimeField reads a time_field request value, defaulting to the event
+// timestamp when it is empty.
+func parseTimeField(value string) (TimeField, error) {
+	switch TimeField(strings.ToLower(strings.TrimSpace(value))) {
+	case "", TimeFieldEvent:
+		return TimeFieldEvent, nil
+	case TimeFieldIngested:
+		return TimeFieldIngested, nil
+	}
+	return "", fmt.Errorf("time_field must be 'event' or 'ingested'")
+}
+
+// parseLineSort reads the sort_by and sort_order of a ListLines request.
+// An empty sort_by keeps the curated line order and sort_order defaults to
+// ascending.
+func parseLineSort(sortBy, sortOrder string) (LineSort, error) {
+	var order LineSort
+	switch by := LineSortField(strings.ToLower(strings.TrimSpace(sortBy))); by {
+	case "", LineSortName, LineSortIncidentCount, LineSortDowntime, LineSortMTBF:
+		order.By = by
+	default:
+		return order, newValidationError("sort_by", "sort_by must be one of: name, incident_count, downtime, mtbf")
+	}
+
+	switch strings.ToLower(strings.TrimSpace(sortOrder)) {
+	case "", "asc":
+	case "desc":
+		order.Descending = true
+	default:
+		return order, newValidationError("sort_order", "sort_order must be 'asc' or 'desc'")
+	}
+	return order, nil
+}
+
+// parseDisruptionSort validates GetRecentDisruptions' sort_by and sort_order
+// against their allow-lists. Both default to newest first.
+func parseDisruptionSort(sortBy, sortOrder string) (DisruptionSort, error) {
+	var order DisruptionSort
+	switch by := DisruptionSortField(strings.ToLower(strings.TrimSpace(sortBy))); by {
+	case "", DisruptionSortTime, DisruptionSortDuration:
+		order.By = by
+	default:
+		return order, newValidationError("sort_by", "sort_by must be one of: ts, duration")
+	}
+
+	switch strings.ToLower(strings.TrimSpace(sortOrder)) {
+	case "", "desc":
+	case "asc":
+		order.Ascending = true
+	default:
+		return order, newValidationError("sort_order", "sort_order must be 'asc' or 'desc'")
+	}
+	return order, nil
+}
+
+// listLimit resolves a request's limit. Zero or less selects def; a limit
+// above maxLimit, or above MaxListLimit when that is lower, is clamped to
+// it, or rejected when strict is set so the client learns it asked for too
+// much.
+func (s *Service) listLimit(limit, def, maxLimit int32, strict bool) (int32, error) {
+	if s.cfg.MaxListLimit > 0 && s.cfg.MaxListLimit < maxLimit {
+		maxLimit = s.cfg.MaxListLimit
+	}
+	if limit <= 0 {
+		return min(def, maxLimit), nil
+	}
+	if limit > maxLimit {
+		if strict {
+			return 0, fmt.Errorf("limit must not exceed %d", maxLimit)
+		}
+		return maxLimit, nil
+	}
+	return limit, nil
+}
+
+func validateDurationRange(minDuration, maxDuration *int32) error {
+	if minDuration != nil && (*minDuration < 0 || *minDuration > 1440) {
+		return newValidationError("min_duration", "min_duration must be between 0 and 1440")
+	}
+	if maxDuration != nil && (*maxDuration < 0 || *maxDuration > 1440) {
+		return newValidationError("max_duration", "max_duration must be between 0 and 1440")
+	}
+	if minDuration != nil && maxDuration != nil && *minDuration > *maxDuration {
+		return newValidationError("min_duration", "min_duration must not exceed max_duration")
+	}
+	return nil
+}
+
+// normalizeIncidentType trims and lowercases an incident type so that
+// " Mechanical" is accepted as "mechanical".
+func normalizeIncidentType(incidentType string) string {
+	return strings.ToLower(strings.TrimSpace(incidentType))
+}
+
+func validateIncidentType(incidentType string) error {
+	for _, t := range incidentTypes {
+		if incidentType == t {
+			return nil
+		}
+	}
+	return newValidationError("incident_type", "incident_type must be one of: %s", strings.Join(incidentTypes, ", "))
+}
+
+// validateIncidentRequest returns the normalised fields of req, and a
+// codes.InvalidArgument status describing the first problem with req and
+// listing all of them as field violations, or nil when req is valid.
+func (s *Service) validateIncidentRequest(req *pb.CreateIncidentRequest) (incidentFields, error) {
+	fields, problems := s.incidentRequestProblems(req)
+	return fields, validationStatus(problems...)
+}
+
+// incidentRequestProblems normalises the fields of req that are stored in a
+// different form and returns them with every validation problem, in the order
+// validateIncidentRequest lists them. It does not touch the repository or
+// modify req.
+func (s *Service) incidentRequestProblems(req *pb.CreateIncidentRequest) (incidentFields, []error) {
+	var fields incidentFields
+	var problems []error
+
+	if req.LineId != "" || req.StationId != "" {
+		if req.LineId == "" || req.StationId == "" {
+			field := "line_id"
+			if req.StationId == "" {
+				field = "station_id"
+			}
+			problems = append(problems, newValidationError(field, "line_id and station_id must be provided together"))
+		}
+		if _, err := uuid.Parse(req.LineId); req.LineId != "" && err != nil {
+			problems = append(problems, newValidationError("line_id", "invalid line_id"))
+		}
+		if _, err := uuid.Parse(req.StationId); req.StationId != "" && err != nil {
+			problems = append(problems, newValidationError("station_id", "invalid station_id"))
+		}
+	} else {
+		if _, err := requiredText("line", req.Line, maxNameLength); err != nil {
+			problems = append(problems, err)
+		}
+
+		if _, err := requiredText("station", req.Station, maxNameLength); err != nil {
+			problems = append(problems, err)
+		}
 	}
 
-	station := strings.TrimSpace(req.Station)
-	if station == "" {
-		return fmt.Errorf("station must not be empty")
-	}
-	if len(station) > 100 {
-		return fmt.Errorf("station must not exceed 100 characters")
+	if req.Timestamp == nil {
+		problems = append(problems, newValidationError("timestamp", "timestamp is required"))
+	} else if req.Timestamp.AsTime().After(time.Now().UTC().Add(s.cfg.IncidentFutureTolerance)) {
+		problems = append(problems, newValidationError("timestamp", "timestamp cannot be in the future"))
 	}
 
-	if req.Timestamp == nil {
-		return fmt.Errorf("timestamp is required")
+	if req.DurationMinutes < 0 || req.DurationMinutes > 1440 {
+		problems = append(problems, newValidationError("duration_minutes", "duration_minutes must be between 0 and 1440"))
 	}
 
-	ts := req.Timestamp.AsTime()
-	if ts.After(time.Now().UTC()) {
-		return fmt.Errorf("timestamp cannot be in the future")
+	fields.incidentType = normalizeIncidentType(req.IncidentType)
+	if err := validateIncidentType(fields.incidentType); err != nil {
+		problems = append(problems, err)
 	}
 
-	if req.DurationMinutes < 0 || req.DurationMinutes > 1440 {
-		return fmt.Errorf("duration_minutes must be between 0 and 1440")
+	if source, err := optionalText("source", req.Source, maxSourceLength); err != nil {
+		problems = append(problems, err)
+	} else {
+		fields.source = source
 	}
 
-	validTypes := map[string]bool{
-		"mechanical": true,
-		"power":      true,
-		"signal":     true,
-		"weather":    true,
-		"other":      true,
+	if tags, err := normalizeTags(req.Tags); err != nil {
+		problems = append(problems, err)
+	} else {
+		fields.tags = tags
 	}
-	if !validTypes[req.IncidentType] {
-		return fmt.Errorf("incident_type must be one of: mechanical, power, signal, weather, other")
+
+	return fields, problems
+}
+
+// ValidateIncident checks a CreateIncident payload against the same rules as
+// CreateIncident and reports every problem found. It never reads or writes
+// the database, so integrators can test payloads without side effects.
+func (s *Service) ValidateIncident(ctx context.Context, req *pb.CreateIncidentRequest) (*pb.ValidateIncidentResponse, error) {
+	_, problems := s.incidentRequestProblems(req)
+
+	messages := make([]string, len(problems))
+	for i, p := range problems {
+		messages[i] = p.Error()
 	}
 
-	return nil
+	log.Info(ctx, "Validated incident payload", "problems", len(messages))
+
+	return &pb.ValidateIncidentResponse{
+		Valid:    len(messages) == 0,
+		Problems: messages,
+	}, nil
 }
 
 func (s *Service) CreateLine(ctx context.Context, req *pb.CreateLineRequest) (*pb.LineResponse, error) {
-	name := strings.TrimSpace(req.Name)
-	if name == "" {
-		return nil, status.Error(codes.InvalidArgument, "name must not be empty")
+	name, err := requiredText("name", req.Name, maxNameLength)
+	if err != nil {
+		return nil, validationStatus(err)
 	}
-	if len(name) > 100 {
-		return nil, status.Error(codes.InvalidArgument, "name must not exceed 100 characters")
+
+	attrs, err := lineAttributes(req.Color, req.DisplayOrder)
+	if err != nil {
+		return nil, validationStatus(err)
 	}
 
 	log.Info(ctx, "Creating line", "name", name)
 
-	line, err := s.repo.CreateLine(ctx, name)
+	line, err := s.repo.CreateLine(ctx, name, attrs)
+	if err == ErrAlreadyExists {
+		return nil, status.Errorf(codes.AlreadyExists, "a line named %q already exists", name)
+	}
 	if err != nil {
 		log.Error(ctx, "Failed to create line", "error", err)
 		return nil, status.Error(codes.Internal, "failed to create line")
@@ -288,29 +2392,34 @@ func (s *Service) CreateLine(ctx context.Context, req *pb.CreateLineRequest) (*p
 
 	log.Info(ctx, "Line created successfully", "line_id", line.ID.String())
 
-	return &pb.LineResponse{
-		Id:        line.ID.String(),
-		Name:      line.Name,
-		CreatedAt: timestamppb.New(line.CreatedAt),
-	}, nil
+	return lineToProto(line), nil
 }
 
-func (s *Service) ListLines(ctx context.Context, _ *emptypb.Empty) (*pb.ListLinesResponse, error) {
-	log.Info(ctx, "Listing all lines")
+func (s *Service) ListLines(ctx context.Context, req *pb.ListLinesRequest) (*pb.ListLinesResponse, error) {
+	nameContains, err := optionalText("name_contains", req.NameContains, maxNameLength)
+	if err != nil {
+		return nil, validationStatus(err)
+	}
+
+	order, err := parseLineSort(req.SortBy, req.SortOrder)
+	if err != nil {
+		return nil, validationStatus(err)
+	}
+	if order.By.needsStats() && !req.IncludeStats {
+		return nil, validationStatus(newValidationError("sort_by", "sort_by %s requires include_stats", order.By))
+	}
+
+	log.Info(ctx, "Listing lines", "name_contains", nameContains, "include_stats", req.IncludeStats, "sort_by", string(order.By), "descending", order.Descending)
 
-	lines, err := s.repo.ListLines(ctx)
+	lines, err := s.repo.ListLines(ctx, nameContains, req.IncludeStats, order)
 	if err != nil {
 		log.Error(ctx, "Failed to list lines", "error", err)
 		return nil, status.Error(codes.Internal, "failed to list lines")
 	}
 
 	responses := make([]*pb.LineResponse, len(lines))
-	for i, line := range lines {
-		responses[i] = &pb.LineResponse{
-			Id:        line.ID.String(),
-			Name:      line.Name,
-			CreatedAt: timestamppb.New(line.CreatedAt),
-		}
+	for i := range lines {
+		responses[i] = lineToProto(&lines[i])
 	}
 
 	return &pb.ListLinesResponse{Lines: responses}, nil
@@ -333,33 +2442,34 @@ func (s *Service) GetLine(ctx context.Context, req *pb.GetLineRequest) (*pb.Line
 		return nil, status.Error(codes.Internal, "failed to get line")
 	}
 
-	return &pb.LineResponse{
-		Id:        line.ID.String(),
-		Name:      line.Name,
-		CreatedAt: timestamppb.New(line.CreatedAt),
-	}, nil
+	return lineToProto(line), nil
 }
 
 func (s *Service) UpdateLine(ctx context.Context, req *pb.UpdateLineRequest) (*pb.LineResponse, error) {
 	id, err := uuid.Parse(req.Id)
 	if err != nil {
-		return nil, status.Error(codes.InvalidArgument, "invalid line ID")
+		return nil, validationStatus(newValidationError("id", "invalid line ID"))
 	}
 
-	name := strings.TrimSpace(req.Name)
-	if name == "" {
-		return nil, status.Error(codes.InvalidArgument, "name must not be empty")
+	name, err := requiredText("name", req.Name, maxNameLength)
+	if err != nil {
+		return nil, validationStatus(err)
 	}
-	if len(name) > 100 {
-		return nil, status.Error(codes.InvalidArgument, "name must not exceed 100 characters")
+
+	attrs, err := lineAttributes(req.Color, req.DisplayOrder)
+	if err != nil {
+		return nil, validationStatus(err)
 	}
 
 	log.Info(ctx, "Updating line", "id", id.String(), "name", name)
 
-	line, err := s.repo.UpdateLine(ctx, id, name)
+	line, err := s.repo.UpdateLine(ctx, id, name, attrs)
 	if err == ErrNotFound {
 		return nil, status.Error(codes.NotFound, "line not found")
 	}
+	if err == ErrAlreadyExists {
+		return nil, status.Errorf(codes.AlreadyExists, "another line named %q already exists", name)
+	}
 	if err != nil {
 		log.Error(ctx, "Failed to update line", "error", err)
 		return nil, status.Error(codes.Internal, "failed to update line")
@@ -367,22 +2477,22 @@ func (s *Service) UpdateLine(ctx context.Context, req *pb.UpdateLineRequest) (*p
 
 	log.Info(ctx, "Line updated successfully", "line_id", line.ID.String())
 
-	return &pb.LineResponse{
-		Id:        line.ID.String(),
-		Name:      line.Name,
-		CreatedAt: timestamppb.New(line.CreatedAt),
-	}, nil
+	return lineToProto(line), nil
 }
 
 func (s *Service) DeleteLine(ctx context.Context, req *pb.DeleteLineRequest) (*emptypb.Empty, error) {
 	id, err := uuid.Parse(req.Id)
 	if err != nil {
-		return nil, status.Error(codes.InvalidArgument, "invalid line ID")
+		return nil, validationStatus(newValidationError("id", "invalid line ID"))
 	}
 
-	log.Info(ctx, "Deleting line", "id", id.String())
+	log.Info(ctx, "Deleting line", "id", id.String(), "idempotent", req.Idempotent)
 
 	err = s.repo.DeleteLine(ctx, id)
+	if err == ErrNotFound && req.Idempotent {
+		log.Info(ctx, "Line already deleted", "line_id", id.String())
+		return &emptypb.Empty{}, nil
+	}
 	if err == ErrNotFound {
 		return nil, status.Error(codes.NotFound, "line not found")
 	}
@@ -396,20 +2506,96 @@ func (s *Service) DeleteLine(ctx context.Context, req *pb.DeleteLineRequest) (*e
 	return &emptypb.Empty{}, nil
 }
 
-func (s *Service) CreateStation(ctx context.Context, req *pb.CreateStationRequest) (*pb.StationResponse, error) {
-	name := strings.TrimSpace(req.Name)
-	if name == "" {
-		return nil, status.Error(codes.InvalidArgument, "name must not be empty")
+func (s *Service) GetLineSummary(ctx context.Context, req *pb.GetLineSummaryRequest) (*pb.LineSummaryResponse, error) {
+	id, err := uuid.Parse(req.Id)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid line ID")
 	}
-	if len(name) > 100 {
-		return nil, status.Error(codes.InvalidArgument, "name must not exceed 100 characters")
+
+	log.Info(ctx, "Getting line summary", "id", id.String())
+
+	summary, err := s.repo.GetLineSummary(ctx, id)
+	if err == ErrNotFound {
+		return nil, status.Error(codes.NotFound, "line not found")
+	}
+	if err != nil {
+		log.Error(ctx, "Failed to get line summary", "error", err)
+		return nil, status.Error(codes.Internal, "failed to get line summary")
 	}
 
-	lineID, err := uuid.Parse(req.LineId)
+	resp := &pb.LineSummaryResponse{
+		LineId:             summary.LineID.String(),
+		LineName:           summary.LineName,
+		StationCount:       summary.StationCount,
+		ActiveStationCount: summary.ActiveStationCount,
+		ClosedStationCount: summary.ClosedStationCount,
+		TotalIncidents:     summary.TotalIncidents,
+	}
+	if summary.LastIncidentAt != nil {
+		resp.LastIncidentAt = timestamppb.New(*summary.LastIncidentAt)
+	}
+
+	return resp, nil
+}
+
+func (s *Service) PreviewLineDeletion(ctx context.Context, req *pb.PreviewLineDeletionRequest) (*pb.LineDeletionPreviewResponse, error) {
+	id, err := uuid.Parse(req.Id)
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, "invalid line ID")
 	}
 
+	log.Info(ctx, "Previewing line deletion", "id", id.String())
+
+	impact, err := s.repo.PreviewLineDeletion(ctx, id)
+	if err == ErrNotFound {
+		return nil, status.Error(codes.NotFound, "line not found")
+	}
+	if err != nil {
+		log.Error(ctx, "Failed to preview line deletion", "error", err)
+		return nil, status.Error(codes.Internal, "failed to preview line deletion")
+	}
+
+	return &pb.LineDeletionPreviewResponse{
+		LineId:             impact.LineID.String(),
+		LineName:           impact.LineName,
+		StationCount:       impact.StationCount,
+		SharedStationCount: impact.SharedStationCount,
+		IncidentCount:      impact.IncidentCount,
+	}, nil
+}
+
+func (s *Service) CreateStation(ctx context.Context, req *pb.CreateStationRequest) (*pb.StationResponse, error) {
+	name, err := requiredText("name", req.Name, maxNameLength)
+	if err != nil {
+		return nil, validationStatus(err)
+	}
+
+	var lineID uuid.UUID
+	lineName := strings.TrimSpace(req.LineName)
+	switch {
+	case req.LineId != "" && lineName != "":
+		return nil, validationStatus(newValidationError("line_name", "set only one of line_id and line_name"))
+	case req.LineId != "":
+		lineID, err = uuid.Parse(req.LineId)
+		if err != nil {
+			return nil, validationStatus(newValidationError("line_id", "invalid line ID"))
+		}
+	case lineName != "":
+		if _, err := requiredText("line_name", lineName, maxNameLength); err != nil {
+			return nil, validationStatus(err)
+		}
+	default:
+		return nil, validationStatus(newValidationError("line_id", "invalid line ID"))
+	}
+	if req.CreateLineIfMissing && lineName == "" {
+		return nil, validationStatus(newValidationError("create_line_if_missing", "create_line_if_missing requires line_name"))
+	}
+
+	additionalLineIDs, err := parseUUIDs("additional_line_ids", req.AdditionalLineIds)
+	if err != nil {
+		return nil, err
+	}
+
 	statusVal := strings.TrimSpace(req.Status)
 	if statusVal == "" {
 		statusVal = "active"
@@ -421,13 +2607,26 @@ func (s *Service) CreateStation(ctx context.Context, req *pb.CreateStationReques
 		"closed":      true,
 	}
 	if !validStatuses[statusVal] {
-		return nil, status.Error(codes.InvalidArgument, "status must be one of: active, inactive, maintenance, closed")
+		return nil, validationStatus(newValidationError("status", "status must be one of: active, inactive, maintenance, closed"))
+	}
+
+	coords, err := stationCoordinates(req.Latitude, req.Longitude)
+	if err != nil {
+		return nil, validationStatus(err)
+	}
+
+	if lineName != "" {
+		line, err := s.resolveStationLine(ctx, lineName, req.CreateLineIfMissing)
+		if err != nil {
+			return nil, err
+		}
+		lineID = line.ID
 	}
 
 	log.Info(ctx, "Creating station", "name", name, "line_id", lineID.String(), "status", statusVal)
 
-	station, err := s.repo.CreateStation(ctx, name, lineID, statusVal)
-	if err == ErrNotFound {
+	station, err := s.repo.CreateStation(ctx, name, lineID, statusVal, additionalLineIDs, coords)
+	if err == ErrLineNotFound {
 		return nil, status.Error(codes.NotFound, "line not found")
 	}
 	if err != nil {
@@ -437,49 +2636,190 @@ func (s *Service) CreateStation(ctx context.Context, req *pb.CreateStationReques
 
 	log.Info(ctx, "Station created successfully", "station_id", station.ID.String())
 
-	return &pb.StationResponse{
-		Id:        station.ID.String(),
-		Name:      station.Name,
-		LineId:    station.LineID.String(),
-		LineName:  station.LineName,
-		Status:    station.Status,
-		CreatedAt: timestamppb.New(station.CreatedAt),
-	}, nil
+	return stationToProto(station), nil
+}
+
+// resolveStationLine looks up the line a new station is created on by name.
+// With createIfMissing a missing line is created, as CreateIncident does;
+// otherwise it is reported as NotFound.
+func (s *Service) resolveStationLine(ctx context.Context, name string, createIfMissing bool) (*Line, error) {
+	if createIfMissing {
+		line, created, err := s.repo.GetOrCreateLine(ctx, name)
+		if err != nil {
+			log.Error(ctx, "Failed to get or create line", "error", err)
+			return nil, status.Error(codes.Internal, "failed to create station")
+		}
+		if created {
+			log.Info(ctx, "Line created for station", "line", name, "line_id", line.ID.String())
+		}
+		return line, nil
+	}
+
+	line, err := s.repo.FindLineByName(ctx, name)
+	if err == ErrNotFound {
+		return nil, status.Error(codes.NotFound, "line not found")
+	}
+	if err != nil {
+		log.Error(ctx, "Failed to find line", "error", err)
+		return nil, status.Error(codes.Internal, "failed to create station")
+	}
+	return line, nil
 }
 
 func (s *Service) ListStations(ctx context.Context, req *pb.ListStationsRequest) (*pb.ListStationsResponse, error) {
-	var lineID *uuid.UUID
+	var filter StationFilter
 	if req.LineId != "" {
 		id, err := uuid.Parse(req.LineId)
 		if err != nil {
 			return nil, status.Error(codes.InvalidArgument, "invalid line ID")
 		}
-		lineID = &id
+		filter.LineID = &id
+	}
+	if req.CreatedFrom != nil {
+		t := req.CreatedFrom.AsTime()
+		filter.CreatedFrom = &t
+	}
+	if req.CreatedTo != nil {
+		t := req.CreatedTo.AsTime()
+		filter.CreatedTo = &t
+	}
+	if filter.CreatedFrom != nil && filter.CreatedTo != nil && filter.CreatedTo.Before(*filter.CreatedFrom) {
+		return nil, status.Error(codes.InvalidArgument, "created_to must not be before created_from")
 	}
 
-	log.Info(ctx, "Listing stations", "line_id", req.LineId)
+	log.Info(ctx, "Listing stations",
+		"line_id", req.LineId,
+		"created_from", filter.CreatedFrom,
+		"created_to", filter.CreatedTo,
+		"include_incident_count", req.IncludeIncidentCount)
 
-	stations, err := s.repo.ListStations(ctx, lineID)
+	stations, err := s.repo.ListStations(ctx, filter, req.IncludeIncidentCount)
 	if err != nil {
 		log.Error(ctx, "Failed to list stations", "error", err)
 		return nil, status.Error(codes.Internal, "failed to list stations")
 	}
 
 	responses := make([]*pb.StationResponse, len(stations))
-	for i, station := range stations {
-		responses[i] = &pb.StationResponse{
-			Id:        station.ID.String(),
-			Name:      station.Name,
-			LineId:    station.LineID.String(),
-			LineName:  station.LineName,
-			Status:    station.Status,
-			CreatedAt: timestamppb.New(station.CreatedAt),
-		}
+	for i := range stations {
+		responses[i] = stationToProto(&stations[i])
 	}
 
 	return &pb.ListStationsResponse{Stations: responses}, nil
 }
 
+var lineColorPattern = regexp.MustCompile(`^#[0-9A-Fa-f]{6}$`)
+
+func lineAttributes(color *string, displayOrder *int32) (LineAttributes, error) {
+	var attrs LineAttributes
+	if color != nil {
+		trimmed := strings.TrimSpace(*color)
+		if trimmed != "" && !lineColorPattern.MatchString(trimmed) {
+			return attrs, newValidationError("color", "color must be a hex string like #RRGGBB")
+		}
+		attrs.Color = &trimmed
+	}
+	if displayOrder != nil {
+		if *displayOrder < 0 {
+			return attrs, newValidationError("display_order", "display_order must not be negative")
+		}
+		attrs.DisplayOrder = displayOrder
+	}
+	return attrs, nil
+}
+
+// stringValue returns the string s points to, or "" for a NULL column.
+func stringValue(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+func lineToProto(line *Line) *pb.LineResponse {
+	resp := &pb.LineResponse{
+		Id:        line.ID.String(),
+		Name:      line.Name,
+		Color:     stringValue(line.Color),
+		CreatedAt: timestamppb.New(line.CreatedAt),
+	}
+	resp.DisplayOrder = line.DisplayOrder
+	resp.IncidentCount = line.IncidentCount
+	resp.DowntimeMinutes = line.DowntimeMinutes
+	resp.MtbfMinutes = line.MTBFMinutes
+	if line.LastIncidentAt != nil {
+		resp.LastIncidentAt = timestamppb.New(*line.LastIncidentAt)
+	}
+	return resp
+}
+
+func incidentDetailsToProto(incident *IncidentWithDetails) *pb.IncidentResponse {
+	return &pb.IncidentResponse{
+		Id:              incident.ID.String(),
+		Line:            incident.LineName,
+		Station:         incident.StationName,
+		Timestamp:       timestamppb.New(incident.Timestamp),
+		DurationMinutes: incident.DurationMinutes,
+		IncidentType:    incident.IncidentType,
+		LineId:          incident.LineID.String(),
+		StationId:       incident.StationID.String(),
+		Status:          incident.Status,
+		Source:          stringValue(incident.Source),
+		CreatedAt:       timestamppb.New(incident.CreatedAt),
+		Tags:            incident.Tags,
+	}
+}
+
+func stationCoordinates(latitude, longitude *float64) (*StationCoordinates, error) {
+	if latitude == nil && longitude == nil {
+		return nil, nil
+	}
+	if latitude == nil || longitude == nil {
+		field := "latitude"
+		if longitude == nil {
+			field = "longitude"
+		}
+		return nil, newValidationError(field, "latitude and longitude must be provided together")
+	}
+	if math.IsNaN(*latitude) || *latitude < -90 || *latitude > 90 {
+		return nil, newValidationError("latitude", "latitude must be between -90 and 90")
+	}
+	if math.IsNaN(*longitude) || *longitude < -180 || *longitude > 180 {
+		return nil, newValidationError("longitude", "longitude must be between -180 and 180")
+	}
+	return &StationCoordinates{Latitude: *latitude, Longitude: *longitude}, nil
+}
+
+func stationToProto(station *StationWithLine) *pb.StationResponse {
+	resp := &pb.StationResponse{
+		Id:            station.ID.String(),
+		Name:          station.Name,
+		LineId:        station.LineID.String(),
+		LineName:      station.LineName,
+		Status:        station.Status,
+		CreatedAt:     timestamppb.New(station.CreatedAt),
+		IncidentCount: station.IncidentCount,
+		Lines:         stationLinesToProto(station.Lines),
+	}
+	if station.Latitude != nil && station.Longitude != nil {
+		resp.Latitude = station.Latitude
+		resp.Longitude = station.Longitude
+	}
+	resp.Position = station.Position
+	return resp
+}
+
+func stationLinesToProto(refs []StationLineRef) []*pb.StationLine {
+	lines := make([]*pb.StationLine, len(refs))
+	for i, ref := range refs {
+		lines[i] = &pb.StationLine{
+			Id:      ref.LineID.String(),
+			Name:    ref.LineName,
+			Primary: ref.IsPrimary,
+		}
+	}
+	return lines
+}
+
 func (s *Service) GetStation(ctx context.Context, req *pb.GetStationRequest) (*pb.StationResponse, error) {
 	id, err := uuid.Parse(req.Id)
 	if err != nil {
@@ -497,27 +2837,123 @@ func (s *Service) GetStation(ctx context.Context, req *pb.GetStationRequest) (*p
 		return nil, status.Error(codes.Internal, "failed to get station")
 	}
 
-	return &pb.StationResponse{
-		Id:        station.ID.String(),
-		Name:      station.Name,
-		LineId:    station.LineID.String(),
-		LineName:  station.LineName,
-		Status:    station.Status,
-		CreatedAt: timestamppb.New(station.CreatedAt),
+	return stationToProto(station), nil
+}
+
+func (s *Service) GetStationWithIncidents(ctx context.Context, req *pb.GetStationWithIncidentsRequest) (*pb.StationWithIncidentsResponse, error) {
+	id, err := uuid.Parse(req.Id)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid station ID")
+	}
+
+	limit, err := s.listLimit(req.Limit, 10, 100, req.StrictLimit)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	log.Info(ctx, "Getting station with incidents", "id", id.String(), "limit", limit)
+
+	station, err := s.repo.GetStation(ctx, id)
+	if err == ErrNotFound {
+		return nil, status.Error(codes.NotFound, "station not found")
+	}
+	if err != nil {
+		log.Error(ctx, "Failed to get station", "error", err)
+		return nil, status.Error(codes.Internal, "failed to get station")
+	}
+
+	incidents, err := s.repo.GetRecentDisruptions(ctx, IncidentFilter{StationID: &id}, limit)
+	if err != nil {
+		log.Error(ctx, "Failed to get station incidents", "error", err)
+		return nil, status.Error(codes.Internal, "failed to get station incidents")
+	}
+
+	items := make([]*pb.IncidentResponse, len(incidents))
+	for i := range incidents {
+		items[i] = incidentDetailsToProto(&incidents[i])
+	}
+
+	return &pb.StationWithIncidentsResponse{
+		Station:   stationToProto(station),
+		Incidents: items,
 	}, nil
 }
 
-func (s *Service) UpdateStation(ctx context.Context, req *pb.UpdateStationRequest) (*pb.StationResponse, error) {
+// GetStationDetails is the read model for the station detail page. The
+// station and its line, its incident statistics and its recent incidents are
+// fetched concurrently.
+func (s *Service) GetStationDetails(ctx context.Context, req *pb.GetStationDetailsRequest) (*pb.StationDetailsResponse, error) {
 	id, err := uuid.Parse(req.Id)
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, "invalid station ID")
 	}
 
+	limit, err := s.listLimit(req.Limit, 10, 100, req.StrictLimit)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	log.Info(ctx, "Getting station details", "id", id.String(), "limit", limit)
+
+	var (
+		station   *StationWithLine
+		line      *Line
+		stats     *StationMTBF
+		incidents []IncidentWithDetails
+	)
+	g, gctx := errgroup.WithContext(ctx)
+	g.Go(func() error {
+		var err error
+		station, err = s.repo.GetStation(gctx, id)
+		if err != nil {
+			return err
+		}
+		line, err = s.repo.GetLine(gctx, station.LineID)
+		return err
+	})
+	g.Go(func() error {
+		var err error
+		stats, err = s.repo.GetStationMTBF(gctx, id)
+		return err
+	})
+	g.Go(func() error {
+		var err error
+		incidents, err = s.repo.GetRecentDisruptions(gctx, IncidentFilter{StationID: &id}, limit)
+		return err
+	})
+	if err := g.Wait(); err == ErrNotFound {
+		return nil, status.Error(codes.NotFound, "station not found")
+	} else if err != nil {
+		log.Error(ctx, "Failed to get station details", "error", err)
+		return nil, status.Error(codes.Internal, "failed to get station details")
+	}
+
+	items := make([]*pb.IncidentResponse, len(incidents))
+	for i := range incidents {
+		items[i] = incidentDetailsToProto(&incidents[i])
+	}
+
+	return &pb.StationDetailsResponse{
+		Station:         stationToProto(station),
+		Line:            lineToProto(line),
+		IncidentCount:   stats.IncidentCount,
+		DowntimeMinutes: stats.DowntimeMinutes,
+		MtbfMinutes:     stats.MTBFMinutes,
+		RecentIncidents: items,
+	}, nil
+}
+
+func (s *Service) UpdateStation(ctx context.Context, req *pb.UpdateStationRequest) (*pb.StationResponse, error) {
+	id, err := uuid.Parse(req.Id)
+	if err != nil {
+		return nil, validationStatus(newValidationError("id", "invalid station ID"))
+	}
+
 	var name *string
 	if req.Name != "" {
-		trimmed := strings.TrimSpace(req.Name)
-		if len(trimmed) > 100 {
-			return nil, status.Error(codes.InvalidArgument, "name must not exceed 100 characters")
+		trimmed, err := optionalText("name", req.Name, maxNameLength)
+		if err != nil {
+			return nil, validationStatus(err)
 		}
 		name = &trimmed
 	}
@@ -532,18 +2968,23 @@ func (s *Service) UpdateStation(ctx context.Context, req *pb.UpdateStationReques
 			"closed":      true,
 		}
 		if !validStatuses[trimmed] {
-			return nil, status.Error(codes.InvalidArgument, "status must be one of: active, inactive, maintenance, closed")
+			return nil, validationStatus(newValidationError("status", "status must be one of: active, inactive, maintenance, closed"))
 		}
 		statusVal = &trimmed
 	}
 
-	if name == nil && statusVal == nil {
-		return nil, status.Error(codes.InvalidArgument, "at least one field (name or status) must be provided")
+	coords, err := stationCoordinates(req.Latitude, req.Longitude)
+	if err != nil {
+		return nil, validationStatus(err)
+	}
+
+	if name == nil && statusVal == nil && coords == nil {
+		return nil, validationStatus(newValidationError("name", "at least one field (name, status or coordinates) must be provided"))
 	}
 
 	log.Info(ctx, "Updating station", "id", id.String())
 
-	station, err := s.repo.UpdateStation(ctx, id, name, statusVal)
+	station, err := s.repo.UpdateStation(ctx, id, name, statusVal, coords)
 	if err == ErrNotFound {
 		return nil, status.Error(codes.NotFound, "station not found")
 	}
@@ -554,25 +2995,22 @@ func (s *Service) UpdateStation(ctx context.Context, req *pb.UpdateStationReques
 
 	log.Info(ctx, "Station updated successfully", "station_id", station.ID.String())
 
-	return &pb.StationResponse{
-		Id:        station.ID.String(),
-		Name:      station.Name,
-		LineId:    station.LineID.String(),
-		LineName:  station.LineName,
-		Status:    station.Status,
-		CreatedAt: timestamppb.New(station.CreatedAt),
-	}, nil
+	return stationToProto(station), nil
 }
 
 func (s *Service) DeleteStation(ctx context.Context, req *pb.DeleteStationRequest) (*emptypb.Empty, error) {
 	id, err := uuid.Parse(req.Id)
 	if err != nil {
-		return nil, status.Error(codes.InvalidArgument, "invalid station ID")
+		return nil, validationStatus(newValidationError("id", "invalid station ID"))
 	}
 
-	log.Info(ctx, "Deleting station", "id", id.String())
+	log.Info(ctx, "Deleting station", "id", id.String(), "force", req.Force, "idempotent", req.Idempotent)
 
-	err = s.repo.DeleteStation(ctx, id)
+	err = s.repo.DeleteStation(ctx, id, req.Force)
+	if err == ErrNotFound && req.Idempotent {
+		log.Info(ctx, "Station already deleted", "station_id", id.String())
+		return &emptypb.Empty{}, nil
+	}
 	if err == ErrNotFound {
 		return nil, status.Error(codes.NotFound, "station not found")
 	}
@@ -585,3 +3023,90 @@ func (s *Service) DeleteStation(ctx context.Context, req *pb.DeleteStationReques
 
 	return &emptypb.Empty{}, nil
 }
+
+func (s *Service) ReorderStations(ctx context.Context, req *pb.ReorderStationsRequest) (*pb.ListStationsResponse, error) {
+	lineID, err := uuid.Parse(req.LineId)
+	if err != nil {
+		return nil, validationStatus(newValidationError("line_id", "invalid line ID"))
+	}
+
+	stationIDs, err := parseUUIDs("station_ids", req.StationIds)
+	if err != nil {
+		return nil, err
+	}
+	seen := make(map[uuid.UUID]bool, len(stationIDs))
+	for i, id := range stationIDs {
+		if seen[id] {
+			return nil, validationStatus(newValidationError(fmt.Sprintf("station_ids[%d]", i), "station %s is listed more than once", id))
+		}
+		seen[id] = true
+	}
+
+	log.Info(ctx, "Reordering stations", "line_id", lineID.String(), "station_count", len(stationIDs))
+
+	err = s.repo.ReorderStations(ctx, lineID, stationIDs)
+	if err == ErrLineNotFound {
+		return nil, status.Error(codes.NotFound, "line not found")
+	}
+	if err == ErrStationLineMismatch {
+		return nil, status.Error(codes.FailedPrecondition, "every station must exist and be on the line")
+	}
+	if err != nil {
+		log.Error(ctx, "Failed to reorder stations", "error", err)
+		return nil, status.Error(codes.Internal, "failed to reorder stations")
+	}
+
+	stations, err := s.repo.ListStations(ctx, StationFilter{LineID: &lineID}, false)
+	if err != nil {
+		log.Error(ctx, "Failed to list stations", "error", err)
+		return nil, status.Error(codes.Internal, "failed to reorder stations")
+	}
+
+	responses := make([]*pb.StationResponse, len(stations))
+	for i := range stations {
+		responses[i] = stationToProto(&stations[i])
+	}
+
+	return &pb.ListStationsResponse{Stations: responses}, nil
+}
+
+func (s *Service) MergeStations(ctx context.Context, req *pb.MergeStationsRequest) (*pb.MergeStationsResponse, error) {
+	sourceID, err := uuid.Parse(req.SourceStationId)
+	if err != nil {
+		return nil, validationStatus(newValidationError("source_station_id", "invalid source_station_id"))
+	}
+	targetID, err := uuid.Parse(req.TargetStationId)
+	if err != nil {
+		return nil, validationStatus(newValidationError("target_station_id", "invalid target_station_id"))
+	}
+	if sourceID == targetID {
+		return nil, validationStatus(newValidationError("target_station_id", "source_station_id and target_station_id must differ"))
+	}
+
+	log.Info(ctx, "Merging stations",
+		"source_station_id", sourceID.String(),
+		"target_station_id", targetID.String(),
+		"force", req.Force)
+
+	moved, err := s.repo.MergeStations(ctx, sourceID, targetID, req.Force)
+	if err == ErrNotFound {
+		return nil, status.Error(codes.FailedPrecondition, "both stations must exist")
+	}
+	if err == ErrStationLineMismatch {
+		return nil, status.Error(codes.FailedPrecondition, "stations must belong to the same line")
+	}
+	if err == ErrAlreadyExists {
+		return nil, status.Error(codes.FailedPrecondition, "target station already has an incident at the same time as one being moved")
+	}
+	if err != nil {
+		log.Error(ctx, "Failed to merge stations", "error", err)
+		return nil, status.Error(codes.Internal, "failed to merge stations")
+	}
+
+	log.Info(ctx, "Stations merged successfully", "target_station_id", targetID.String(), "incidents_moved", moved)
+
+	return &pb.MergeStationsResponse{
+		TargetStationId: targetID.String(),
+		IncidentsMoved:  int32(moved),
+	}, nil
+}
@@ -2,52 +2,103 @@ package backend
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
+	"fmt"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/lib/pq"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	pb "github.com/bluesg/transport-analytics/proto"
 )
 
 type MockRepository struct {
-	CreateLineFn      func(ctx context.Context, name string) (*Line, error)
-	ListLinesFn       func(ctx context.Context) ([]Line, error)
-	GetLineFn         func(ctx context.Context, id uuid.UUID) (*Line, error)
-	UpdateLineFn      func(ctx context.Context, id uuid.UUID, name string) (*Line, error)
-	DeleteLineFn      func(ctx context.Context, id uuid.UUID) error
-	GetOrCreateLineFn func(ctx context.Context, name string) (*Line, error)
-
-	CreateStationFn      func(ctx context.Context, name string, lineID uuid.UUID, status string) (*StationWithLine, error)
-	ListStationsFn       func(ctx context.Context, lineID *uuid.UUID) ([]StationWithLine, error)
+	CreateLineFn          func(ctx context.Context, name string, attrs LineAttributes) (*Line, error)
+	ListLinesFn           func(ctx context.Context, nameContains string, includeStats bool, order LineSort) ([]Line, error)
+	GetLineFn             func(ctx context.Context, id uuid.UUID) (*Line, error)
+	UpdateLineFn          func(ctx context.Context, id uuid.UUID, name string, attrs LineAttributes) (*Line, error)
+	DeleteLineFn          func(ctx context.Context, id uuid.UUID) error
+	GetOrCreateLineFn     func(ctx context.Context, name string) (*Line, bool, error)
+	FindLineByNameFn      func(ctx context.Context, name string) (*Line, error)
+	GetLineSummaryFn      func(ctx context.Context, id uuid.UUID) (*LineSummary, error)
+	PreviewLineDeletionFn func(ctx context.Context, id uuid.UUID) (*LineDeletionImpact, error)
+
+	CreateStationFn      func(ctx context.Context, name string, lineID uuid.UUID, status string, additionalLineIDs []uuid.UUID, coords *StationCoordinates) (*StationWithLine, error)
+	ListStationsFn       func(ctx context.Context, filter StationFilter, includeIncidentCount bool) ([]StationWithLine, error)
 	GetStationFn         func(ctx context.Context, id uuid.UUID) (*StationWithLine, error)
-	UpdateStationFn      func(ctx context.Context, id uuid.UUID, name, status *string) (*StationWithLine, error)
-	DeleteStationFn      func(ctx context.Context, id uuid.UUID) error
-	GetOrCreateStationFn func(ctx context.Context, name string, lineID uuid.UUID) (*Station, error)
-
-	CreateIncidentFn             func(ctx context.Context, stationID, lineID uuid.UUID, ts time.Time, durationMinutes int32, incidentType string) (*Incident, error)
-	GetIncidentWithDetailsFn     func(ctx context.Context, incidentID uuid.UUID) (*IncidentWithDetails, error)
-	GetTopBreakdownsByLineFn     func(ctx context.Context, limit int32) ([]BreakdownCount, error)
-	GetTopBreakdownsByStationFn  func(ctx context.Context, limit int32) ([]BreakdownCount, error)
-	CalculateMTBFFn              func(ctx context.Context) ([]MTBFResult, error)
-	GetRecentDisruptionsFn       func(ctx context.Context, lineName, stationName string, limit int32) ([]IncidentWithDetails, error)
+	UpdateStationFn      func(ctx context.Context, id uuid.UUID, name, status *string, coords *StationCoordinates) (*StationWithLine, error)
+	DeleteStationFn      func(ctx context.Context, id uuid.UUID, force bool) error
+	MergeStationsFn      func(ctx context.Context, sourceID, targetID uuid.UUID, force bool) (int64, error)
+	ReorderStationsFn    func(ctx context.Context, lineID uuid.UUID, stationIDs []uuid.UUID) error
+	GetOrCreateStationFn func(ctx context.Context, name string, lineID uuid.UUID) (*Station, bool, error)
+	FindStationByNameFn  func(ctx context.Context, name string, lineID uuid.UUID) (*Station, error)
+
+	CreateIncidentFn                func(ctx context.Context, stationID, lineID uuid.UUID, ts time.Time, durationMinutes int32, incidentType, status, source string) (*Incident, error)
+	CreateIncidentWithOptionsFn     func(ctx context.Context, stationID, lineID uuid.UUID, ts time.Time, durationMinutes int32, incidentType, status, source string, opts IncidentCreateOptions) (*IncidentCreateResult, error)
+	UpdateIncidentStatusFn          func(ctx context.Context, id uuid.UUID, status, action string) (*IncidentWithDetails, error)
+	UpdateIncidentFn                func(ctx context.Context, id uuid.UUID, durationMinutes *int32, incidentType *string) (*IncidentWithDetails, error)
+	ReassignIncidentFn              func(ctx context.Context, id, lineID uuid.UUID) (*IncidentWithDetails, error)
+	DeleteIncidentFn                func(ctx context.Context, id uuid.UUID) error
+	GetIncidentHistoryFn            func(ctx context.Context, id uuid.UUID) ([]IncidentAuditEntry, error)
+	GetIncidentWithDetailsFn        func(ctx context.Context, incidentID uuid.UUID) (*IncidentWithDetails, error)
+	ListIncidentsByTagFn            func(ctx context.Context, tag string, limit int32, timeField TimeField) ([]IncidentWithDetails, error)
+	GetTopBreakdownsByLineFn        func(ctx context.Context, limit int32, includeDeleted bool) ([]BreakdownCount, *time.Time, error)
+	GetTopBreakdownsByStationFn     func(ctx context.Context, limit int32, lineID *uuid.UUID, includeDeleted bool) ([]BreakdownCount, *time.Time, error)
+	CalculateMTBFFn                 func(ctx context.Context, includeDeleted bool) ([]MTBFResult, *time.Time, error)
+	GetStationMTBFFn                func(ctx context.Context, stationID uuid.UUID) (*StationMTBF, error)
+	GetIncidentIntervalsFn          func(ctx context.Context, lineName string, start, end time.Time, includeDeleted bool) ([]IncidentInterval, error)
+	GetRecentDisruptionsFn          func(ctx context.Context, filter IncidentFilter, limit int32) ([]IncidentWithDetails, error)
+	GetRollingIncidentAverageFn     func(ctx context.Context, lineName string, start, end time.Time, includeDeleted bool) ([]DailyRollingAverage, error)
+	GetCumulativeIncidentsFn        func(ctx context.Context, lineID *uuid.UUID, start, end time.Time, includeDeleted bool) ([]DailyCumulativeCount, error)
+	GetBusiestPeriodFn              func(ctx context.Context, lineName string, start, end time.Time, window time.Duration, includeDeleted bool) (*BusiestPeriod, error)
+	GetStationIncidentAggregatesFn  func(ctx context.Context, lineName string, start, end time.Time, includeDeleted bool) ([]StationIncidentAggregate, error)
+	GetIncidentsDuringMaintenanceFn func(ctx context.Context, lineName string, start, end time.Time, includeDeleted bool) ([]StationIncidentAggregate, error)
+	GetIncidentTypeCountsByLineFn   func(ctx context.Context, start, end *time.Time, includeDeleted bool) ([]LineTypeCount, error)
+	GetIncidentTypeCountsByHourFn   func(ctx context.Context, lineName string, start, end time.Time, includeDeleted bool) ([]HourTypeCount, error)
+	GetLineIncidentTypeCountsFn     func(ctx context.Context, lineID uuid.UUID, start, end time.Time, includeDeleted bool) ([]TypeCount, error)
+	GetLastIncidentTimesFn          func(ctx context.Context, lineName string, includeDeleted bool) ([]LineLastIncident, error)
+	GetTopIncidentTypeByLineFn      func(ctx context.Context, start, end time.Time, includeDeleted bool) ([]LineTypeCount, error)
+	GetIncidentStatusCountsFn       func(ctx context.Context, lineName string, start, end *time.Time, includeDeleted bool) ([]StatusCount, error)
+	GetLongestIncidentsFn           func(ctx context.Context, start, end time.Time, includeDeleted bool) ([]IncidentWithDetails, error)
+	GetConcurrentIncidentsFn        func(ctx context.Context, lineName string, start, end time.Time, limit int32, includeDeleted bool) ([]ConcurrentIncidentPair, error)
+	GetNetworkOverviewFn            func(ctx context.Context, start, end time.Time, includeDeleted bool) (*NetworkOverview, error)
+	GetDurationPercentilesFn        func(ctx context.Context, start, end time.Time, includeDeleted bool) ([]LineDurationPercentiles, error)
+	GetLongestQuietPeriodsFn        func(ctx context.Context, start, end time.Time, includeDeleted bool) ([]LineQuietPeriod, error)
+	GetSLABreachesFn                func(ctx context.Context, thresholdMinutes int32, start, end time.Time, includeDeleted bool) ([]LineSLABreaches, error)
+	GetStationLineComparisonFn      func(ctx context.Context, stationID uuid.UUID, start, end time.Time) (*StationLineComparison, error)
+	GetStationStatusCountsFn        func(ctx context.Context) ([]LineStationStatusCount, error)
+	GetIncidentsForMapFn            func(ctx context.Context, lineName string, start, end time.Time, includeMissingGeo bool, includeDeleted bool) ([]MapIncident, error)
+	GetSegmentIncidentCountsFn      func(ctx context.Context, lineName string, stationNames []string, start, end *time.Time, includeDeleted bool) ([]BreakdownCount, error)
+	StreamIncidentsFn               func(ctx context.Context, start, end *time.Time, timeField TimeField, fn func(*IncidentWithDetails) error) error
+	FindOrphanedIncidentsFn         func(ctx context.Context, limit int32) ([]OrphanedIncident, error)
+	NormalizeStationStatusesFn      func(ctx context.Context) (int64, error)
+	PurgeIncidentsBeforeFn          func(ctx context.Context, cutoff time.Time, batchSize int) (int64, error)
+	PingFn                          func(ctx context.Context) error
+	GetSchemaVersionFn              func(ctx context.Context) (*SchemaVersion, error)
 }
 
-func (m *MockRepository) CreateLine(ctx context.Context, name string) (*Line, error) {
+func (m *MockRepository) CreateLine(ctx context.Context, name string, attrs LineAttributes) (*Line, error) {
 	if m.CreateLineFn != nil {
-		return m.CreateLineFn(ctx, name)
+		return m.CreateLineFn(ctx, name, attrs)
 	}
 	return nil, errors.New("not implemented")
 }
 
-func (m *MockRepository) ListLines(ctx context.Context) ([]Line, error) {
+func (m *MockRepository) ListLines(ctx context.Context, nameContains string, includeStats bool, order LineSort) ([]Line, error) {
 	if m.ListLinesFn != nil {
-		return m.ListLinesFn(ctx)
+		return m.ListLinesFn(ctx, nameContains, includeStats, order)
 	}
 	return nil, errors.New("not implemented")
 }
@@ -59,9 +110,9 @@ func (m *MockRepository) GetLine(ctx context.Context, id uuid.UUID) (*Line, erro
 	return nil, errors.New("not implemented")
 }
 
-func (m *MockRepository) UpdateLine(ctx context.Context, id uuid.UUID, name string) (*Line, error) {
+func (m *MockRepository) UpdateLine(ctx context.Context, id uuid.UUID, name string, attrs LineAttributes) (*Line, error) {
 	if m.UpdateLineFn != nil {
-		return m.UpdateLineFn(ctx, id, name)
+		return m.UpdateLineFn(ctx, id, name, attrs)
 	}
 	return nil, errors.New("not implemented")
 }
@@ -73,23 +124,44 @@ func (m *MockRepository) DeleteLine(ctx context.Context, id uuid.UUID) error {
 	return errors.New("not implemented")
 }
 
-func (m *MockRepository) GetOrCreateLine(ctx context.Context, name string) (*Line, error) {
+func (m *MockRepository) GetOrCreateLine(ctx context.Context, name string) (*Line, bool, error) {
 	if m.GetOrCreateLineFn != nil {
 		return m.GetOrCreateLineFn(ctx, name)
 	}
+	return nil, false, errors.New("not implemented")
+}
+
+func (m *MockRepository) FindLineByName(ctx context.Context, name string) (*Line, error) {
+	if m.FindLineByNameFn != nil {
+		return m.FindLineByNameFn(ctx, name)
+	}
+	return nil, errors.New("not implemented")
+}
+
+func (m *MockRepository) GetLineSummary(ctx context.Context, id uuid.UUID) (*LineSummary, error) {
+	if m.GetLineSummaryFn != nil {
+		return m.GetLineSummaryFn(ctx, id)
+	}
 	return nil, errors.New("not implemented")
 }
 
-func (m *MockRepository) CreateStation(ctx context.Context, name string, lineID uuid.UUID, status string) (*StationWithLine, error) {
+func (m *MockRepository) PreviewLineDeletion(ctx context.Context, id uuid.UUID) (*LineDeletionImpact, error) {
+	if m.PreviewLineDeletionFn != nil {
+		return m.PreviewLineDeletionFn(ctx, id)
+	}
+	return nil, errors.New("not implemented")
+}
+
+func (m *MockRepository) CreateStation(ctx context.Context, name string, lineID uuid.UUID, status string, additionalLineIDs []uuid.UUID, coords *StationCoordinates) (*StationWithLine, error) {
 	if m.CreateStationFn != nil {
-		return m.CreateStationFn(ctx, name, lineID, status)
+		return m.CreateStationFn(ctx, name, lineID, status, additionalLineIDs, coords)
 	}
 	return nil, errors.New("not implemented")
 }
 
-func (m *MockRepository) ListStations(ctx context.Context, lineID *uuid.UUID) ([]StationWithLine, error) {
+func (m *MockRepository) ListStations(ctx context.Context, filter StationFilter, includeIncidentCount bool) ([]StationWithLine, error) {
 	if m.ListStationsFn != nil {
-		return m.ListStationsFn(ctx, lineID)
+		return m.ListStationsFn(ctx, filter, includeIncidentCount)
 	}
 	return nil, errors.New("not implemented")
 }
@@ -101,30 +173,93 @@ func (m *MockRepository) GetStation(ctx context.Context, id uuid.UUID) (*Station
 	return nil, errors.New("not implemented")
 }
 
-func (m *MockRepository) UpdateStation(ctx context.Context, id uuid.UUID, name, status *string) (*StationWithLine, error) {
+func (m *MockRepository) UpdateStation(ctx context.Context, id uuid.UUID, name, status *string, coords *StationCoordinates) (*StationWithLine, error) {
 	if m.UpdateStationFn != nil {
-		return m.UpdateStationFn(ctx, id, name, status)
+		return m.UpdateStationFn(ctx, id, name, status, coords)
 	}
 	return nil, errors.New("not implemented")
 }
 
-func (m *MockRepository) DeleteStation(ctx context.Context, id uuid.UUID) error {
+func (m *MockRepository) DeleteStation(ctx context.Context, id uuid.UUID, force bool) error {
 	if m.DeleteStationFn != nil {
-		return m.DeleteStationFn(ctx, id)
+		return m.DeleteStationFn(ctx, id, force)
+	}
+	return errors.New("not implemented")
+}
+
+func (m *MockRepository) ReorderStations(ctx context.Context, lineID uuid.UUID, stationIDs []uuid.UUID) error {
+	if m.ReorderStationsFn != nil {
+		return m.ReorderStationsFn(ctx, lineID, stationIDs)
 	}
 	return errors.New("not implemented")
 }
 
-func (m *MockRepository) GetOrCreateStation(ctx context.Context, name string, lineID uuid.UUID) (*Station, error) {
+func (m *MockRepository) MergeStations(ctx context.Context, sourceID, targetID uuid.UUID, force bool) (int64, error) {
+	if m.MergeStationsFn != nil {
+		return m.MergeStationsFn(ctx, sourceID, targetID, force)
+	}
+	return 0, errors.New("not implemented")
+}
+
+func (m *MockRepository) GetOrCreateStation(ctx context.Context, name string, lineID uuid.UUID) (*Station, bool, error) {
 	if m.GetOrCreateStationFn != nil {
 		return m.GetOrCreateStationFn(ctx, name, lineID)
 	}
+	return nil, false, errors.New("not implemented")
+}
+
+func (m *MockRepository) FindStationByName(ctx context.Context, name string, lineID uuid.UUID) (*Station, error) {
+	if m.FindStationByNameFn != nil {
+		return m.FindStationByNameFn(ctx, name, lineID)
+	}
 	return nil, errors.New("not implemented")
 }
 
-func (m *MockRepository) CreateIncident(ctx context.Context, stationID, lineID uuid.UUID, ts time.Time, durationMinutes int32, incidentType string) (*Incident, error) {
+func (m *MockRepository) CreateIncident(ctx context.Context, stationID, lineID uuid.UUID, ts time.Time, durationMinutes int32, incidentType, status, source string) (*Incident, error) {
 	if m.CreateIncidentFn != nil {
-		return m.CreateIncidentFn(ctx, stationID, lineID, ts, durationMinutes, incidentType)
+		return m.CreateIncidentFn(ctx, stationID, lineID, ts, durationMinutes, incidentType, status, source)
+	}
+	return nil, errors.New("not implemented")
+}
+
+func (m *MockRepository) CreateIncidentWithOptions(ctx context.Context, stationID, lineID uuid.UUID, ts time.Time, durationMinutes int32, incidentType, status, source string, opts IncidentCreateOptions) (*IncidentCreateResult, error) {
+	if m.CreateIncidentWithOptionsFn != nil {
+		return m.CreateIncidentWithOptionsFn(ctx, stationID, lineID, ts, durationMinutes, incidentType, status, source, opts)
+	}
+	return nil, errors.New("not implemented")
+}
+
+func (m *MockRepository) UpdateIncidentStatus(ctx context.Context, id uuid.UUID, status, action string) (*IncidentWithDetails, error) {
+	if m.UpdateIncidentStatusFn != nil {
+		return m.UpdateIncidentStatusFn(ctx, id, status, action)
+	}
+	return nil, errors.New("not implemented")
+}
+
+func (m *MockRepository) UpdateIncident(ctx context.Context, id uuid.UUID, durationMinutes *int32, incidentType *string) (*IncidentWithDetails, error) {
+	if m.UpdateIncidentFn != nil {
+		return m.UpdateIncidentFn(ctx, id, durationMinutes, incidentType)
+	}
+	return nil, errors.New("not implemented")
+}
+
+func (m *MockRepository) ReassignIncident(ctx context.Context, id, lineID uuid.UUID) (*IncidentWithDetails, error) {
+	if m.ReassignIncidentFn != nil {
+		return m.ReassignIncidentFn(ctx, id, lineID)
+	}
+	return nil, errors.New("not implemented")
+}
+
+func (m *MockRepository) DeleteIncident(ctx context.Context, id uuid.UUID) error {
+	if m.DeleteIncidentFn != nil {
+		return m.DeleteIncidentFn(ctx, id)
+	}
+	return errors.New("not implemented")
+}
+
+func (m *MockRepository) GetIncidentHistory(ctx context.Context, id uuid.UUID) ([]IncidentAuditEntry, error) {
+	if m.GetIncidentHistoryFn != nil {
+		return m.GetIncidentHistoryFn(ctx, id)
 	}
 	return nil, errors.New("not implemented")
 }
@@ -136,40 +271,254 @@ func (m *MockRepository) GetIncidentWithDetails(ctx context.Context, incidentID
 	return nil, errors.New("not implemented")
 }
 
-func (m *MockRepository) GetTopBreakdownsByLine(ctx context.Context, limit int32) ([]BreakdownCount, error) {
-	if m.GetTopBreakdownsByLineFn != nil {
-		return m.GetTopBreakdownsByLineFn(ctx, limit)
+func (m *MockRepository) ListIncidentsByTag(ctx context.Context, tag string, limit int32, timeField TimeField) ([]IncidentWithDetails, error) {
+	if m.ListIncidentsByTagFn != nil {
+		return m.ListIncidentsByTagFn(ctx, tag, limit, timeField)
 	}
 	return nil, errors.New("not implemented")
 }
 
-func (m *MockRepository) GetTopBreakdownsByStation(ctx context.Context, limit int32) ([]BreakdownCount, error) {
+func (m *MockRepository) GetTopBreakdownsByLine(ctx context.Context, limit int32, includeDeleted bool) ([]BreakdownCount, *time.Time, error) {
+	if m.GetTopBreakdownsByLineFn != nil {
+		return m.GetTopBreakdownsByLineFn(ctx, limit, includeDeleted)
+	}
+	return nil, nil, errors.New("not implemented")
+}
+
+func (m *MockRepository) GetTopBreakdownsByStation(ctx context.Context, limit int32, lineID *uuid.UUID, includeDeleted bool) ([]BreakdownCount, *time.Time, error) {
 	if m.GetTopBreakdownsByStationFn != nil {
-		return m.GetTopBreakdownsByStationFn(ctx, limit)
+		return m.GetTopBreakdownsByStationFn(ctx, limit, lineID, includeDeleted)
 	}
-	return nil, errors.New("not implemented")
+	return nil, nil, errors.New("not implemented")
 }
 
-func (m *MockRepository) CalculateMTBF(ctx context.Context) ([]MTBFResult, error) {
+func (m *MockRepository) CalculateMTBF(ctx context.Context, includeDeleted bool) ([]MTBFResult, *time.Time, error) {
 	if m.CalculateMTBFFn != nil {
-		return m.CalculateMTBFFn(ctx)
+		return m.CalculateMTBFFn(ctx, includeDeleted)
+	}
+	return nil, nil, errors.New("not implemented")
+}
+
+func (m *MockRepository) GetStationMTBF(ctx context.Context, stationID uuid.UUID) (*StationMTBF, error) {
+	if m.GetStationMTBFFn != nil {
+		return m.GetStationMTBFFn(ctx, stationID)
+	}
+	return nil, errors.New("not implemented")
+}
+
+func (m *MockRepository) GetIncidentIntervals(ctx context.Context, lineName string, start, end time.Time, includeDeleted bool) ([]IncidentInterval, error) {
+	if m.GetIncidentIntervalsFn != nil {
+		return m.GetIncidentIntervalsFn(ctx, lineName, start, end, includeDeleted)
 	}
 	return nil, errors.New("not implemented")
 }
 
-func (m *MockRepository) GetRecentDisruptions(ctx context.Context, lineName, stationName string, limit int32) ([]IncidentWithDetails, error) {
+func (m *MockRepository) GetRecentDisruptions(ctx context.Context, filter IncidentFilter, limit int32) ([]IncidentWithDetails, error) {
 	if m.GetRecentDisruptionsFn != nil {
-		return m.GetRecentDisruptionsFn(ctx, lineName, stationName, limit)
+		return m.GetRecentDisruptionsFn(ctx, filter, limit)
+	}
+	return nil, errors.New("not implemented")
+}
+
+func (m *MockRepository) GetStationIncidentAggregates(ctx context.Context, lineName string, start, end time.Time, includeDeleted bool) ([]StationIncidentAggregate, error) {
+	if m.GetStationIncidentAggregatesFn != nil {
+		return m.GetStationIncidentAggregatesFn(ctx, lineName, start, end, includeDeleted)
+	}
+	return nil, errors.New("not implemented")
+}
+
+func (m *MockRepository) GetIncidentsDuringMaintenance(ctx context.Context, lineName string, start, end time.Time, includeDeleted bool) ([]StationIncidentAggregate, error) {
+	if m.GetIncidentsDuringMaintenanceFn != nil {
+		return m.GetIncidentsDuringMaintenanceFn(ctx, lineName, start, end, includeDeleted)
+	}
+	return nil, errors.New("not implemented")
+}
+
+func (m *MockRepository) GetIncidentsForMap(ctx context.Context, lineName string, start, end time.Time, includeMissingGeo bool, includeDeleted bool) ([]MapIncident, error) {
+	if m.GetIncidentsForMapFn != nil {
+		return m.GetIncidentsForMapFn(ctx, lineName, start, end, includeMissingGeo, includeDeleted)
+	}
+	return nil, errors.New("not implemented")
+}
+
+func (m *MockRepository) GetStationStatusCounts(ctx context.Context) ([]LineStationStatusCount, error) {
+	if m.GetStationStatusCountsFn != nil {
+		return m.GetStationStatusCountsFn(ctx)
+	}
+	return nil, errors.New("not implemented")
+}
+
+func (m *MockRepository) GetStationLineComparison(ctx context.Context, stationID uuid.UUID, start, end time.Time) (*StationLineComparison, error) {
+	if m.GetStationLineComparisonFn != nil {
+		return m.GetStationLineComparisonFn(ctx, stationID, start, end)
+	}
+	return nil, errors.New("not implemented")
+}
+
+func (m *MockRepository) GetIncidentTypeCountsByHour(ctx context.Context, lineName string, start, end time.Time, includeDeleted bool) ([]HourTypeCount, error) {
+	if m.GetIncidentTypeCountsByHourFn != nil {
+		return m.GetIncidentTypeCountsByHourFn(ctx, lineName, start, end, includeDeleted)
+	}
+	return nil, errors.New("not implemented")
+}
+
+func (m *MockRepository) GetLineIncidentTypeCounts(ctx context.Context, lineID uuid.UUID, start, end time.Time, includeDeleted bool) ([]TypeCount, error) {
+	if m.GetLineIncidentTypeCountsFn != nil {
+		return m.GetLineIncidentTypeCountsFn(ctx, lineID, start, end, includeDeleted)
+	}
+	return nil, errors.New("not implemented")
+}
+
+func (m *MockRepository) GetLastIncidentTimes(ctx context.Context, lineName string, includeDeleted bool) ([]LineLastIncident, error) {
+	if m.GetLastIncidentTimesFn != nil {
+		return m.GetLastIncidentTimesFn(ctx, lineName, includeDeleted)
+	}
+	return nil, errors.New("not implemented")
+}
+
+func (m *MockRepository) GetTopIncidentTypeByLine(ctx context.Context, start, end time.Time, includeDeleted bool) ([]LineTypeCount, error) {
+	if m.GetTopIncidentTypeByLineFn != nil {
+		return m.GetTopIncidentTypeByLineFn(ctx, start, end, includeDeleted)
+	}
+	return nil, errors.New("not implemented")
+}
+
+func (m *MockRepository) GetIncidentStatusCounts(ctx context.Context, lineName string, start, end *time.Time, includeDeleted bool) ([]StatusCount, error) {
+	if m.GetIncidentStatusCountsFn != nil {
+		return m.GetIncidentStatusCountsFn(ctx, lineName, start, end, includeDeleted)
+	}
+	return nil, errors.New("not implemented")
+}
+
+func (m *MockRepository) GetConcurrentIncidents(ctx context.Context, lineName string, start, end time.Time, limit int32, includeDeleted bool) ([]ConcurrentIncidentPair, error) {
+	if m.GetConcurrentIncidentsFn != nil {
+		return m.GetConcurrentIncidentsFn(ctx, lineName, start, end, limit, includeDeleted)
+	}
+	return nil, errors.New("not implemented")
+}
+
+func (m *MockRepository) GetNetworkOverview(ctx context.Context, start, end time.Time, includeDeleted bool) (*NetworkOverview, error) {
+	if m.GetNetworkOverviewFn != nil {
+		return m.GetNetworkOverviewFn(ctx, start, end, includeDeleted)
+	}
+	return nil, errors.New("not implemented")
+}
+
+func (m *MockRepository) GetDurationPercentiles(ctx context.Context, start, end time.Time, includeDeleted bool) ([]LineDurationPercentiles, error) {
+	if m.GetDurationPercentilesFn != nil {
+		return m.GetDurationPercentilesFn(ctx, start, end, includeDeleted)
+	}
+	return nil, errors.New("not implemented")
+}
+
+func (m *MockRepository) GetLongestQuietPeriods(ctx context.Context, start, end time.Time, includeDeleted bool) ([]LineQuietPeriod, error) {
+	if m.GetLongestQuietPeriodsFn != nil {
+		return m.GetLongestQuietPeriodsFn(ctx, start, end, includeDeleted)
+	}
+	return nil, errors.New("not implemented")
+}
+
+func (m *MockRepository) GetSLABreaches(ctx context.Context, thresholdMinutes int32, start, end time.Time, includeDeleted bool) ([]LineSLABreaches, error) {
+	if m.GetSLABreachesFn != nil {
+		return m.GetSLABreachesFn(ctx, thresholdMinutes, start, end, includeDeleted)
+	}
+	return nil, errors.New("not implemented")
+}
+
+func (m *MockRepository) GetLongestIncidents(ctx context.Context, start, end time.Time, includeDeleted bool) ([]IncidentWithDetails, error) {
+	if m.GetLongestIncidentsFn != nil {
+		return m.GetLongestIncidentsFn(ctx, start, end, includeDeleted)
+	}
+	return nil, errors.New("not implemented")
+}
+
+func (m *MockRepository) GetIncidentTypeCountsByLine(ctx context.Context, start, end *time.Time, includeDeleted bool) ([]LineTypeCount, error) {
+	if m.GetIncidentTypeCountsByLineFn != nil {
+		return m.GetIncidentTypeCountsByLineFn(ctx, start, end, includeDeleted)
+	}
+	return nil, errors.New("not implemented")
+}
+
+func (m *MockRepository) GetBusiestPeriod(ctx context.Context, lineName string, start, end time.Time, window time.Duration, includeDeleted bool) (*BusiestPeriod, error) {
+	if m.GetBusiestPeriodFn != nil {
+		return m.GetBusiestPeriodFn(ctx, lineName, start, end, window, includeDeleted)
+	}
+	return nil, errors.New("not implemented")
+}
+
+func (m *MockRepository) GetCumulativeIncidents(ctx context.Context, lineID *uuid.UUID, start, end time.Time, includeDeleted bool) ([]DailyCumulativeCount, error) {
+	if m.GetCumulativeIncidentsFn != nil {
+		return m.GetCumulativeIncidentsFn(ctx, lineID, start, end, includeDeleted)
+	}
+	return nil, errors.New("not implemented")
+}
+
+func (m *MockRepository) GetRollingIncidentAverage(ctx context.Context, lineName string, start, end time.Time, includeDeleted bool) ([]DailyRollingAverage, error) {
+	if m.GetRollingIncidentAverageFn != nil {
+		return m.GetRollingIncidentAverageFn(ctx, lineName, start, end, includeDeleted)
+	}
+	return nil, errors.New("not implemented")
+}
+
+func (m *MockRepository) GetSegmentIncidentCounts(ctx context.Context, lineName string, stationNames []string, start, end *time.Time, includeDeleted bool) ([]BreakdownCount, error) {
+	if m.GetSegmentIncidentCountsFn != nil {
+		return m.GetSegmentIncidentCountsFn(ctx, lineName, stationNames, start, end, includeDeleted)
+	}
+	return nil, errors.New("not implemented")
+}
+
+func (m *MockRepository) StreamIncidents(ctx context.Context, start, end *time.Time, timeField TimeField, fn func(*IncidentWithDetails) error) error {
+	if m.StreamIncidentsFn != nil {
+		return m.StreamIncidentsFn(ctx, start, end, timeField, fn)
+	}
+	return errors.New("not implemented")
+}
+
+func (m *MockRepository) FindOrphanedIncidents(ctx context.Context, limit int32) ([]OrphanedIncident, error) {
+	if m.FindOrphanedIncidentsFn != nil {
+		return m.FindOrphanedIncidentsFn(ctx, limit)
+	}
+	return nil, errors.New("not implemented")
+}
+
+func (m *MockRepository) PurgeIncidentsBefore(ctx context.Context, cutoff time.Time, batchSize int) (int64, error) {
+	if m.PurgeIncidentsBeforeFn != nil {
+		return m.PurgeIncidentsBeforeFn(ctx, cutoff, batchSize)
+	}
+	return 0, errors.New("not implemented")
+}
+
+func (m *MockRepository) Ping(ctx context.Context) error {
+	if m.PingFn != nil {
+		return m.PingFn(ctx)
+	}
+	return errors.New("not implemented")
+}
+
+func (m *MockRepository) GetSchemaVersion(ctx context.Context) (*SchemaVersion, error) {
+	if m.GetSchemaVersionFn != nil {
+		return m.GetSchemaVersionFn(ctx)
 	}
 	return nil, errors.New("not implemented")
 }
 
+func (m *MockRepository) NormalizeStationStatuses(ctx context.Context) (int64, error) {
+	if m.NormalizeStationStatusesFn != nil {
+		return m.NormalizeStationStatusesFn(ctx)
+	}
+	return 0, errors.New("not implemented")
+}
+
 func setupServiceWithMock() (*Service, *MockRepository) {
 	mockRepo := &MockRepository{}
 	service := &Service{repo: mockRepo}
 	return service, mockRepo
 }
 
+// ptr returns a pointer to v, for filling nullable model fields.
+func ptr[T any](v T) *T {
+	return &v
+}
 
 func TestCreateLine_Success(t *testing.T) {
 	service, mockRepo := setupServiceWithMock()
@@ -178,7 +527,7 @@ func TestCreateLine_Success(t *testing.T) {
 	lineID := uuid.New()
 	now := time.Now()
 
-	mockRepo.CreateLineFn = func(ctx context.Context, name string) (*Line, error) {
+	mockRepo.CreateLineFn = func(ctx context.Context, name string, attrs LineAttributes) (*Line, error) {
 		assert.Equal(t, "Test Line", name)
 		return &Line{
 			ID:        lineID,
@@ -245,7 +594,7 @@ func TestCreateLine_RepositoryError(t *testing.T) {
 	service, mockRepo := setupServiceWithMock()
 	ctx := context.Background()
 
-	mockRepo.CreateLineFn = func(ctx context.Context, name string) (*Line, error) {
+	mockRepo.CreateLineFn = func(ctx context.Context, name string, attrs LineAttributes) (*Line, error) {
 		return nil, errors.New("database error")
 	}
 
@@ -259,6 +608,25 @@ func TestCreateLine_RepositoryError(t *testing.T) {
 	assert.Equal(t, codes.Internal, st.Code())
 }
 
+func TestCreateLine_AlreadyExists(t *testing.T) {
+	service, mockRepo := setupServiceWithMock()
+	ctx := context.Background()
+
+	mockRepo.CreateLineFn = func(ctx context.Context, name string, attrs LineAttributes) (*Line, error) {
+		return nil, ErrAlreadyExists
+	}
+
+	req := &pb.CreateLineRequest{Name: "circle line"}
+	resp, err := service.CreateLine(ctx, req)
+
+	require.Error(t, err)
+	assert.Nil(t, resp)
+	st, ok := status.FromError(err)
+	require.True(t, ok)
+	assert.Equal(t, codes.AlreadyExists, st.Code())
+	assert.Contains(t, st.Message(), "circle line")
+}
+
 func TestListLines_Success(t *testing.T) {
 	service, mockRepo := setupServiceWithMock()
 	ctx := context.Background()
@@ -270,11 +638,11 @@ func TestListLines_Success(t *testing.T) {
 		{ID: uuid.New(), Name: "Line 3", CreatedAt: now},
 	}
 
-	mockRepo.ListLinesFn = func(ctx context.Context) ([]Line, error) {
+	mockRepo.ListLinesFn = func(ctx context.Context, nameContains string, includeStats bool, order LineSort) ([]Line, error) {
 		return mockLines, nil
 	}
 
-	resp, err := service.ListLines(ctx, nil)
+	resp, err := service.ListLines(ctx, &pb.ListLinesRequest{})
 
 	require.NoError(t, err)
 	assert.Len(t, resp.Lines, 3)
@@ -287,11 +655,11 @@ func TestListLines_EmptyResult(t *testing.T) {
 	service, mockRepo := setupServiceWithMock()
 	ctx := context.Background()
 
-	mockRepo.ListLinesFn = func(ctx context.Context) ([]Line, error) {
+	mockRepo.ListLinesFn = func(ctx context.Context, nameContains string, includeStats bool, order LineSort) ([]Line, error) {
 		return []Line{}, nil
 	}
 
-	resp, err := service.ListLines(ctx, nil)
+	resp, err := service.ListLines(ctx, &pb.ListLinesRequest{})
 
 	require.NoError(t, err)
 	assert.Len(t, resp.Lines, 0)
@@ -301,11 +669,11 @@ func TestListLines_RepositoryError(t *testing.T) {
 	service, mockRepo := setupServiceWithMock()
 	ctx := context.Background()
 
-	mockRepo.ListLinesFn = func(ctx context.Context) ([]Line, error) {
+	mockRepo.ListLinesFn = func(ctx context.Context, nameContains string, includeStats bool, order LineSort) ([]Line, error) {
 		return nil, errors.New("database error")
 	}
 
-	resp, err := service.ListLines(ctx, nil)
+	resp, err := service.ListLines(ctx, &pb.ListLinesRequest{})
 
 	require.Error(t, err)
 	assert.Nil(t, resp)
@@ -314,6 +682,139 @@ func TestListLines_RepositoryError(t *testing.T) {
 	assert.Equal(t, codes.Internal, st.Code())
 }
 
+func TestListLines_NameContains(t *testing.T) {
+	service, mockRepo := setupServiceWithMock()
+	ctx := context.Background()
+
+	mockRepo.ListLinesFn = func(ctx context.Context, nameContains string, includeStats bool, order LineSort) ([]Line, error) {
+		assert.Equal(t, "circle", nameContains)
+		return []Line{{ID: uuid.New(), Name: "Circle Line"}}, nil
+	}
+
+	resp, err := service.ListLines(ctx, &pb.ListLinesRequest{NameContains: "  circle "})
+
+	require.NoError(t, err)
+	require.Len(t, resp.Lines, 1)
+	assert.Equal(t, "Circle Line", resp.Lines[0].Name)
+}
+
+func TestListLines_IncludeStats(t *testing.T) {
+	service, mockRepo := setupServiceWithMock()
+	ctx := context.Background()
+
+	count := int32(4)
+	last := time.Date(2024, 1, 15, 8, 30, 0, 0, time.UTC)
+	zero := int32(0)
+	mockRepo.ListLinesFn = func(ctx context.Context, nameContains string, includeStats bool, order LineSort) ([]Line, error) {
+		if !includeStats {
+			return []Line{{ID: uuid.New(), Name: "Circle Line"}}, nil
+		}
+		return []Line{
+			{ID: uuid.New(), Name: "Circle Line", IncidentCount: &count, LastIncidentAt: &last},
+			{ID: uuid.New(), Name: "Thomson Line", IncidentCount: &zero},
+		}, nil
+	}
+
+	resp, err := service.ListLines(ctx, &pb.ListLinesRequest{IncludeStats: true})
+
+	require.NoError(t, err)
+	require.Len(t, resp.Lines, 2)
+	require.NotNil(t, resp.Lines[0].IncidentCount)
+	assert.Equal(t, int32(4), *resp.Lines[0].IncidentCount)
+	assert.Equal(t, last, resp.Lines[0].LastIncidentAt.AsTime())
+	require.NotNil(t, resp.Lines[1].IncidentCount)
+	assert.Equal(t, int32(0), *resp.Lines[1].IncidentCount)
+	assert.Nil(t, resp.Lines[1].LastIncidentAt)
+
+	resp, err = service.ListLines(ctx, &pb.ListLinesRequest{})
+
+	require.NoError(t, err)
+	require.Len(t, resp.Lines, 1)
+	assert.Nil(t, resp.Lines[0].IncidentCount)
+	assert.Nil(t, resp.Lines[0].LastIncidentAt)
+}
+
+func TestListLines_Sort(t *testing.T) {
+	service, mockRepo := setupServiceWithMock()
+	ctx := context.Background()
+
+	downtime, mtbf := int32(90), 42.5
+	var got LineSort
+	mockRepo.ListLinesFn = func(ctx context.Context, nameContains string, includeStats bool, order LineSort) ([]Line, error) {
+		got = order
+		return []Line{{ID: uuid.New(), Name: "Circle Line", DowntimeMinutes: &downtime, MTBFMinutes: &mtbf}}, nil
+	}
+
+	resp, err := service.ListLines(ctx, &pb.ListLinesRequest{IncludeStats: true, SortBy: " MTBF ", SortOrder: "desc"})
+
+	require.NoError(t, err)
+	assert.Equal(t, LineSort{By: LineSortMTBF, Descending: true}, got)
+	require.Len(t, resp.Lines, 1)
+	assert.Equal(t, int32(90), resp.Lines[0].GetDowntimeMinutes())
+	assert.Equal(t, 42.5, resp.Lines[0].GetMtbfMinutes())
+
+	_, err = service.ListLines(ctx, &pb.ListLinesRequest{})
+
+	require.NoError(t, err)
+	assert.Equal(t, LineSort{}, got)
+}
+
+func TestListLines_InvalidSort(t *testing.T) {
+	service, _ := setupServiceWithMock()
+
+	tests := []struct {
+		name  string
+		req   *pb.ListLinesRequest
+		field string
+	}{
+		{"unknown sort_by", &pb.ListLinesRequest{IncludeStats: true, SortBy: "color"}, "sort_by"},
+		{"unknown sort_order", &pb.ListLinesRequest{SortBy: "name", SortOrder: "up"}, "sort_order"},
+		{"metric without stats", &pb.ListLinesRequest{SortBy: "downtime"}, "sort_by"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := service.ListLines(context.Background(), tt.req)
+
+			require.Error(t, err)
+			assert.Nil(t, resp)
+			st, ok := status.FromError(err)
+			require.True(t, ok)
+			assert.Equal(t, codes.InvalidArgument, st.Code())
+			require.Len(t, st.Details(), 1)
+			badRequest, ok := st.Details()[0].(*errdetails.BadRequest)
+			require.True(t, ok)
+			assert.Equal(t, tt.field, badRequest.FieldViolations[0].Field)
+		})
+	}
+}
+
+func TestListLines_NameContainsTooLong(t *testing.T) {
+	service, _ := setupServiceWithMock()
+
+	resp, err := service.ListLines(context.Background(), &pb.ListLinesRequest{NameContains: strings.Repeat("x", 101)})
+
+	require.Error(t, err)
+	assert.Nil(t, resp)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	st, _ := status.FromError(err)
+	require.Len(t, st.Details(), 1)
+	badRequest, ok := st.Details()[0].(*errdetails.BadRequest)
+	require.True(t, ok)
+	assert.Equal(t, "name_contains", badRequest.FieldViolations[0].Field)
+}
+
+func TestListLines_NameContainsCountsCharacters(t *testing.T) {
+	service, mockRepo := setupServiceWithMock()
+	mockRepo.ListLinesFn = func(ctx context.Context, nameContains string, includeStats bool, sort LineSort) ([]Line, error) {
+		return nil, nil
+	}
+
+	// 100 characters but 300 bytes.
+	_, err := service.ListLines(context.Background(), &pb.ListLinesRequest{NameContains: strings.Repeat("線", 100)})
+	require.NoError(t, err)
+}
+
 func TestGetLine_Success(t *testing.T) {
 	service, mockRepo := setupServiceWithMock()
 	ctx := context.Background()
@@ -381,7 +882,7 @@ func TestUpdateLine_Success(t *testing.T) {
 	lineID := uuid.New()
 	now := time.Now()
 
-	mockRepo.UpdateLineFn = func(ctx context.Context, id uuid.UUID, name string) (*Line, error) {
+	mockRepo.UpdateLineFn = func(ctx context.Context, id uuid.UUID, name string, attrs LineAttributes) (*Line, error) {
 		assert.Equal(t, lineID, id)
 		assert.Equal(t, "Updated Line", name)
 		return &Line{
@@ -460,7 +961,7 @@ func TestUpdateLine_NotFound(t *testing.T) {
 
 	lineID := uuid.New()
 
-	mockRepo.UpdateLineFn = func(ctx context.Context, id uuid.UUID, name string) (*Line, error) {
+	mockRepo.UpdateLineFn = func(ctx context.Context, id uuid.UUID, name string, attrs LineAttributes) (*Line, error) {
 		return nil, ErrNotFound
 	}
 
@@ -477,6 +978,28 @@ func TestUpdateLine_NotFound(t *testing.T) {
 	assert.Equal(t, codes.NotFound, st.Code())
 }
 
+func TestUpdateLine_AlreadyExists(t *testing.T) {
+	service, mockRepo := setupServiceWithMock()
+	ctx := context.Background()
+
+	mockRepo.UpdateLineFn = func(ctx context.Context, id uuid.UUID, name string, attrs LineAttributes) (*Line, error) {
+		assert.Equal(t, "Circle Line", name)
+		return nil, ErrAlreadyExists
+	}
+
+	req := &pb.UpdateLineRequest{
+		Id:   uuid.New().String(),
+		Name: "  Circle Line  ",
+	}
+	resp, err := service.UpdateLine(ctx, req)
+
+	require.Error(t, err)
+	assert.Nil(t, resp)
+	st, ok := status.FromError(err)
+	require.True(t, ok)
+	assert.Equal(t, codes.AlreadyExists, st.Code())
+}
+
 func TestDeleteLine_Success(t *testing.T) {
 	service, mockRepo := setupServiceWithMock()
 	ctx := context.Background()
@@ -529,14 +1052,27 @@ func TestDeleteLine_NotFound(t *testing.T) {
 	assert.Equal(t, codes.NotFound, st.Code())
 }
 
-func TestDeleteLine_RepositoryError(t *testing.T) {
+func TestDeleteLine_IdempotentAlreadyDeleted(t *testing.T) {
 	service, mockRepo := setupServiceWithMock()
-	ctx := context.Background()
-
-	lineID := uuid.New()
 
 	mockRepo.DeleteLineFn = func(ctx context.Context, id uuid.UUID) error {
-		return errors.New("database error")
+		return ErrNotFound
+	}
+
+	resp, err := service.DeleteLine(context.Background(), &pb.DeleteLineRequest{Id: uuid.New().String(), Idempotent: true})
+
+	require.NoError(t, err)
+	assert.NotNil(t, resp)
+}
+
+func TestDeleteLine_RepositoryError(t *testing.T) {
+	service, mockRepo := setupServiceWithMock()
+	ctx := context.Background()
+
+	lineID := uuid.New()
+
+	mockRepo.DeleteLineFn = func(ctx context.Context, id uuid.UUID) error {
+		return errors.New("database error")
 	}
 
 	req := &pb.DeleteLineRequest{Id: lineID.String()}
@@ -549,6 +1085,85 @@ func TestDeleteLine_RepositoryError(t *testing.T) {
 	assert.Equal(t, codes.Internal, st.Code())
 }
 
+func TestGetLineSummary_Success(t *testing.T) {
+	service, mockRepo := setupServiceWithMock()
+	ctx := context.Background()
+
+	lineID := uuid.New()
+	lastIncident := time.Now().Add(-time.Hour)
+
+	mockRepo.GetLineSummaryFn = func(ctx context.Context, id uuid.UUID) (*LineSummary, error) {
+		assert.Equal(t, lineID, id)
+		return &LineSummary{
+			LineID:             lineID,
+			LineName:           "Test Line",
+			StationCount:       10,
+			ActiveStationCount: 8,
+			ClosedStationCount: 1,
+			TotalIncidents:     42,
+			LastIncidentAt:     &lastIncident,
+		}, nil
+	}
+
+	resp, err := service.GetLineSummary(ctx, &pb.GetLineSummaryRequest{Id: lineID.String()})
+
+	require.NoError(t, err)
+	assert.Equal(t, lineID.String(), resp.LineId)
+	assert.Equal(t, "Test Line", resp.LineName)
+	assert.Equal(t, int32(10), resp.StationCount)
+	assert.Equal(t, int32(8), resp.ActiveStationCount)
+	assert.Equal(t, int32(1), resp.ClosedStationCount)
+	assert.Equal(t, int32(42), resp.TotalIncidents)
+	require.NotNil(t, resp.LastIncidentAt)
+	assert.True(t, lastIncident.Equal(resp.LastIncidentAt.AsTime()))
+}
+
+func TestGetLineSummary_NoIncidents(t *testing.T) {
+	service, mockRepo := setupServiceWithMock()
+	ctx := context.Background()
+
+	lineID := uuid.New()
+
+	mockRepo.GetLineSummaryFn = func(ctx context.Context, id uuid.UUID) (*LineSummary, error) {
+		return &LineSummary{LineID: lineID, LineName: "Empty Line"}, nil
+	}
+
+	resp, err := service.GetLineSummary(ctx, &pb.GetLineSummaryRequest{Id: lineID.String()})
+
+	require.NoError(t, err)
+	assert.Equal(t, int32(0), resp.TotalIncidents)
+	assert.Nil(t, resp.LastIncidentAt)
+}
+
+func TestGetLineSummary_InvalidUUID(t *testing.T) {
+	service, _ := setupServiceWithMock()
+	ctx := context.Background()
+
+	resp, err := service.GetLineSummary(ctx, &pb.GetLineSummaryRequest{Id: "invalid-uuid"})
+
+	require.Error(t, err)
+	assert.Nil(t, resp)
+	st, ok := status.FromError(err)
+	require.True(t, ok)
+	assert.Equal(t, codes.InvalidArgument, st.Code())
+}
+
+func TestGetLineSummary_NotFound(t *testing.T) {
+	service, mockRepo := setupServiceWithMock()
+	ctx := context.Background()
+
+	mockRepo.GetLineSummaryFn = func(ctx context.Context, id uuid.UUID) (*LineSummary, error) {
+		return nil, ErrNotFound
+	}
+
+	resp, err := service.GetLineSummary(ctx, &pb.GetLineSummaryRequest{Id: uuid.New().String()})
+
+	require.Error(t, err)
+	assert.Nil(t, resp)
+	st, ok := status.FromError(err)
+	require.True(t, ok)
+	assert.Equal(t, codes.NotFound, st.Code())
+}
 
 func TestCreateStation_Success(t *testing.T) {
 	service, mockRepo := setupServiceWithMock()
@@ -558,7 +1173,7 @@ func TestCreateStation_Success(t *testing.T) {
 	lineID := uuid.New()
 	now := time.Now()
 
-	mockRepo.CreateStationFn = func(ctx context.Context, name string, lID uuid.UUID, status string) (*StationWithLine, error) {
+	mockRepo.CreateStationFn = func(ctx context.Context, name string, lID uuid.UUID, status string, additionalLineIDs []uuid.UUID, coords *StationCoordinates) (*StationWithLine, error) {
 		assert.Equal(t, "Test Station", name)
 		assert.Equal(t, lineID, lID)
 		assert.Equal(t, "active", status)
@@ -587,6 +1202,73 @@ func TestCreateStation_Success(t *testing.T) {
 	assert.Equal(t, "active", resp.Status)
 }
 
+func TestCreateStation_AdditionalLines(t *testing.T) {
+	service, mockRepo := setupServiceWithMock()
+	ctx := context.Background()
+
+	stationID := uuid.New()
+	primaryID := uuid.New()
+	secondaryID := uuid.New()
+	now := time.Now()
+
+	mockRepo.CreateStationFn = func(ctx context.Context, name string, lID uuid.UUID, status string, additionalLineIDs []uuid.UUID, coords *StationCoordinates) (*StationWithLine, error) {
+		assert.Equal(t, primaryID, lID)
+		assert.Equal(t, []uuid.UUID{secondaryID}, additionalLineIDs)
+		return &StationWithLine{
+			ID:        stationID,
+			Name:      name,
+			LineID:    lID,
+			LineName:  "North South Line",
+			Status:    status,
+			CreatedAt: now,
+			Lines: []StationLineRef{
+				{StationID: stationID, LineID: primaryID, LineName: "North South Line", IsPrimary: true},
+				{StationID: stationID, LineID: secondaryID, LineName: "East West Line"},
+			},
+		}, nil
+	}
+
+	req := &pb.CreateStationRequest{
+		Name:              "City Hall",
+		LineId:            primaryID.String(),
+		AdditionalLineIds: []string{secondaryID.String()},
+	}
+	resp, err := service.CreateStation(ctx, req)
+
+	require.NoError(t, err)
+	assert.Equal(t, primaryID.String(), resp.LineId)
+	require.Len(t, resp.Lines, 2)
+	assert.Equal(t, primaryID.String(), resp.Lines[0].Id)
+	assert.True(t, resp.Lines[0].Primary)
+	assert.Equal(t, "East West Line", resp.Lines[1].Name)
+	assert.False(t, resp.Lines[1].Primary)
+}
+
+func TestCreateStation_InvalidAdditionalLineID(t *testing.T) {
+	service, _ := setupServiceWithMock()
+	ctx := context.Background()
+
+	req := &pb.CreateStationRequest{
+		Name:              "City Hall",
+		LineId:            uuid.New().String(),
+		AdditionalLineIds: []string{"not-a-uuid", uuid.New().String(), "-1"},
+	}
+	resp, err := service.CreateStation(ctx, req)
+
+	require.Error(t, err)
+	assert.Nil(t, resp)
+	st, ok := status.FromError(err)
+	require.True(t, ok)
+	assert.Equal(t, codes.InvalidArgument, st.Code())
+
+	require.Len(t, st.Details(), 1)
+	badRequest, ok := st.Details()[0].(*errdetails.BadRequest)
+	require.True(t, ok)
+	require.Len(t, badRequest.FieldViolations, 2)
+	assert.Equal(t, "additional_line_ids[0]", badRequest.FieldViolations[0].Field)
+	assert.Equal(t, "additional_line_ids[2]", badRequest.FieldViolations[1].Field)
+}
+
 func TestCreateStation_DefaultStatus(t *testing.T) {
 	service, mockRepo := setupServiceWithMock()
 	ctx := context.Background()
@@ -595,7 +1277,7 @@ func TestCreateStation_DefaultStatus(t *testing.T) {
 	lineID := uuid.New()
 	now := time.Now()
 
-	mockRepo.CreateStationFn = func(ctx context.Context, name string, lID uuid.UUID, status string) (*StationWithLine, error) {
+	mockRepo.CreateStationFn = func(ctx context.Context, name string, lID uuid.UUID, status string, additionalLineIDs []uuid.UUID, coords *StationCoordinates) (*StationWithLine, error) {
 		assert.Equal(t, "active", status) // Should default to active
 		return &StationWithLine{
 			ID:        stationID,
@@ -700,8 +1382,8 @@ func TestCreateStation_LineNotFound(t *testing.T) {
 
 	lineID := uuid.New()
 
-	mockRepo.CreateStationFn = func(ctx context.Context, name string, lID uuid.UUID, status string) (*StationWithLine, error) {
-		return nil, ErrNotFound
+	mockRepo.CreateStationFn = func(ctx context.Context, name string, lID uuid.UUID, status string, additionalLineIDs []uuid.UUID, coords *StationCoordinates) (*StationWithLine, error) {
+		return nil, ErrLineNotFound
 	}
 
 	req := &pb.CreateStationRequest{
@@ -719,13 +1401,116 @@ func TestCreateStation_LineNotFound(t *testing.T) {
 	assert.Contains(t, st.Message(), "line not found")
 }
 
+func TestCreateStation_LineName(t *testing.T) {
+	service, mockRepo := setupServiceWithMock()
+	ctx := context.Background()
+
+	lineID := uuid.New()
+	mockRepo.FindLineByNameFn = func(ctx context.Context, name string) (*Line, error) {
+		assert.Equal(t, "Circle Line", name)
+		return &Line{ID: lineID, Name: name}, nil
+	}
+	mockRepo.GetOrCreateLineFn = func(ctx context.Context, name string) (*Line, bool, error) {
+		t.Fatal("line must not be created without create_line_if_missing")
+		return nil, false, nil
+	}
+	mockRepo.CreateStationFn = func(ctx context.Context, name string, lID uuid.UUID, status string, additionalLineIDs []uuid.UUID, coords *StationCoordinates) (*StationWithLine, error) {
+		assert.Equal(t, lineID, lID)
+		return &StationWithLine{ID: uuid.New(), Name: name, LineID: lID, LineName: "Circle Line", Status: status}, nil
+	}
+
+	resp, err := service.CreateStation(ctx, &pb.CreateStationRequest{Name: "Bishan", LineName: " Circle Line "})
+
+	require.NoError(t, err)
+	assert.Equal(t, lineID.String(), resp.LineId)
+}
+
+func TestCreateStation_CreateLineIfMissing(t *testing.T) {
+	service, mockRepo := setupServiceWithMock()
+	ctx := context.Background()
+
+	lineID := uuid.New()
+	mockRepo.GetOrCreateLineFn = func(ctx context.Context, name string) (*Line, bool, error) {
+		assert.Equal(t, "Thomson Line", name)
+		return &Line{ID: lineID, Name: name}, true, nil
+	}
+	mockRepo.CreateStationFn = func(ctx context.Context, name string, lID uuid.UUID, status string, additionalLineIDs []uuid.UUID, coords *StationCoordinates) (*StationWithLine, error) {
+		assert.Equal(t, lineID, lID)
+		return &StationWithLine{ID: uuid.New(), Name: name, LineID: lID, LineName: "Thomson Line", Status: status}, nil
+	}
+
+	resp, err := service.CreateStation(ctx, &pb.CreateStationRequest{
+		Name:                "Caldecott",
+		LineName:            "Thomson Line",
+		CreateLineIfMissing: true,
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, lineID.String(), resp.LineId)
+}
+
+func TestCreateStation_LineNameErrors(t *testing.T) {
+	service, mockRepo := setupServiceWithMock()
+	ctx := context.Background()
+
+	mockRepo.FindLineByNameFn = func(ctx context.Context, name string) (*Line, error) {
+		return nil, ErrNotFound
+	}
+	mockRepo.CreateStationFn = func(ctx context.Context, name string, lID uuid.UUID, status string, additionalLineIDs []uuid.UUID, coords *StationCoordinates) (*StationWithLine, error) {
+		t.Fatal("station must not be created")
+		return nil, nil
+	}
+
+	tests := []struct {
+		name     string
+		req      *pb.CreateStationRequest
+		wantCode codes.Code
+	}{
+		{"missing line", &pb.CreateStationRequest{Name: "Bishan", LineName: "Unknown Line"}, codes.NotFound},
+		{"both line fields", &pb.CreateStationRequest{Name: "Bishan", LineId: uuid.New().String(), LineName: "Circle Line"}, codes.InvalidArgument},
+		{"flag without line name", &pb.CreateStationRequest{Name: "Bishan", LineId: uuid.New().String(), CreateLineIfMissing: true}, codes.InvalidArgument},
+		{"line name too long", &pb.CreateStationRequest{Name: "Bishan", LineName: strings.Repeat("a", maxNameLength+1), CreateLineIfMissing: true}, codes.InvalidArgument},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := service.CreateStation(ctx, tt.req)
+
+			require.Error(t, err)
+			assert.Nil(t, resp)
+			assert.Equal(t, tt.wantCode, status.Code(err))
+		})
+	}
+}
+
+func TestCreateStation_OtherErrorsAreInternal(t *testing.T) {
+	service, mockRepo := setupServiceWithMock()
+	ctx := context.Background()
+
+	// Only ErrLineNotFound means the line is missing; anything else from the
+	// repository is an internal failure.
+	for _, repoErr := range []error{ErrNotFound, ErrDatabaseError} {
+		mockRepo.CreateStationFn = func(ctx context.Context, name string, lID uuid.UUID, status string, additionalLineIDs []uuid.UUID, coords *StationCoordinates) (*StationWithLine, error) {
+			return nil, repoErr
+		}
+
+		resp, err := service.CreateStation(ctx, &pb.CreateStationRequest{Name: "Test Station", LineId: uuid.New().String()})
+
+		require.Error(t, err)
+		assert.Nil(t, resp)
+		st, ok := status.FromError(err)
+		require.True(t, ok)
+		assert.Equal(t, codes.Internal, st.Code())
+	}
+}
+
 func TestCreateStation_RepositoryError(t *testing.T) {
 	service, mockRepo := setupServiceWithMock()
 	ctx := context.Background()
 
 	lineID := uuid.New()
 
-	mockRepo.CreateStationFn = func(ctx context.Context, name string, lID uuid.UUID, status string) (*StationWithLine, error) {
+	mockRepo.CreateStationFn = func(ctx context.Context, name string, lID uuid.UUID, status string, additionalLineIDs []uuid.UUID, coords *StationCoordinates) (*StationWithLine, error) {
 		return nil, errors.New("database error")
 	}
 
@@ -755,7 +1540,7 @@ func TestListStations_Success(t *testing.T) {
 		{ID: uuid.New(), Name: "Station 3", LineID: lineID, LineName: "Test Line", Status: "maintenance", CreatedAt: now},
 	}
 
-	mockRepo.ListStationsFn = func(ctx context.Context, lID *uuid.UUID) ([]StationWithLine, error) {
+	mockRepo.ListStationsFn = func(ctx context.Context, filter StationFilter, includeIncidentCount bool) ([]StationWithLine, error) {
 		return mockStations, nil
 	}
 
@@ -778,9 +1563,9 @@ func TestListStations_FilterByLineID(t *testing.T) {
 		{ID: uuid.New(), Name: "Station 1", LineID: lineID, LineName: "Test Line", Status: "active", CreatedAt: now},
 	}
 
-	mockRepo.ListStationsFn = func(ctx context.Context, lID *uuid.UUID) ([]StationWithLine, error) {
-		assert.NotNil(t, lID)
-		assert.Equal(t, lineID, *lID)
+	mockRepo.ListStationsFn = func(ctx context.Context, filter StationFilter, includeIncidentCount bool) ([]StationWithLine, error) {
+		require.NotNil(t, filter.LineID)
+		assert.Equal(t, lineID, *filter.LineID)
 		return mockStations, nil
 	}
 
@@ -791,6 +1576,74 @@ func TestListStations_FilterByLineID(t *testing.T) {
 	assert.Len(t, resp.Stations, 1)
 }
 
+func TestListStations_IncludeIncidentCount(t *testing.T) {
+	service, mockRepo := setupServiceWithMock()
+	ctx := context.Background()
+
+	lineID := uuid.New()
+	now := time.Now()
+	mockStations := []StationWithLine{
+		{ID: uuid.New(), Name: "Station 1", LineID: lineID, LineName: "Test Line", Status: "active", CreatedAt: now, IncidentCount: 4},
+		{ID: uuid.New(), Name: "Station 2", LineID: lineID, LineName: "Test Line", Status: "active", CreatedAt: now},
+	}
+
+	mockRepo.ListStationsFn = func(ctx context.Context, filter StationFilter, includeIncidentCount bool) ([]StationWithLine, error) {
+		assert.True(t, includeIncidentCount)
+		return mockStations, nil
+	}
+
+	resp, err := service.ListStations(ctx, &pb.ListStationsRequest{IncludeIncidentCount: true})
+
+	require.NoError(t, err)
+	require.Len(t, resp.Stations, 2)
+	assert.Equal(t, int32(4), resp.Stations[0].IncidentCount)
+	assert.Equal(t, int32(0), resp.Stations[1].IncidentCount)
+}
+
+func TestListStations_CreatedRange(t *testing.T) {
+	service, mockRepo := setupServiceWithMock()
+	ctx := context.Background()
+
+	lineID := uuid.New()
+	from := time.Now().AddDate(0, 0, -7).UTC().Truncate(time.Second)
+	to := time.Now().UTC().Truncate(time.Second)
+
+	mockRepo.ListStationsFn = func(ctx context.Context, filter StationFilter, includeIncidentCount bool) ([]StationWithLine, error) {
+		require.NotNil(t, filter.LineID)
+		require.NotNil(t, filter.CreatedFrom)
+		require.NotNil(t, filter.CreatedTo)
+		assert.Equal(t, lineID, *filter.LineID)
+		assert.Equal(t, from, *filter.CreatedFrom)
+		assert.Equal(t, to, *filter.CreatedTo)
+		return []StationWithLine{}, nil
+	}
+
+	_, err := service.ListStations(ctx, &pb.ListStationsRequest{
+		LineId:      lineID.String(),
+		CreatedFrom: timestamppb.New(from),
+		CreatedTo:   timestamppb.New(to),
+	})
+
+	require.NoError(t, err)
+}
+
+func TestListStations_InvalidCreatedRange(t *testing.T) {
+	service, _ := setupServiceWithMock()
+	ctx := context.Background()
+
+	now := time.Now()
+	resp, err := service.ListStations(ctx, &pb.ListStationsRequest{
+		CreatedFrom: timestamppb.New(now),
+		CreatedTo:   timestamppb.New(now.Add(-time.Hour)),
+	})
+
+	require.Error(t, err)
+	assert.Nil(t, resp)
+	st, ok := status.FromError(err)
+	require.True(t, ok)
+	assert.Equal(t, codes.InvalidArgument, st.Code())
+}
+
 func TestListStations_InvalidLineID(t *testing.T) {
 	service, _ := setupServiceWithMock()
 	ctx := context.Background()
@@ -809,7 +1662,7 @@ func TestListStations_EmptyResult(t *testing.T) {
 	service, mockRepo := setupServiceWithMock()
 	ctx := context.Background()
 
-	mockRepo.ListStationsFn = func(ctx context.Context, lID *uuid.UUID) ([]StationWithLine, error) {
+	mockRepo.ListStationsFn = func(ctx context.Context, filter StationFilter, includeIncidentCount bool) ([]StationWithLine, error) {
 		return []StationWithLine{}, nil
 	}
 
@@ -823,7 +1676,7 @@ func TestListStations_RepositoryError(t *testing.T) {
 	service, mockRepo := setupServiceWithMock()
 	ctx := context.Background()
 
-	mockRepo.ListStationsFn = func(ctx context.Context, lID *uuid.UUID) ([]StationWithLine, error) {
+	mockRepo.ListStationsFn = func(ctx context.Context, filter StationFilter, includeIncidentCount bool) ([]StationWithLine, error) {
 		return nil, errors.New("database error")
 	}
 
@@ -903,86 +1756,238 @@ func TestGetStation_NotFound(t *testing.T) {
 	assert.Contains(t, st.Message(), "station not found")
 }
 
-func TestUpdateStation_Success_NameAndStatus(t *testing.T) {
+func TestGetStationWithIncidents_Success(t *testing.T) {
 	service, mockRepo := setupServiceWithMock()
 	ctx := context.Background()
 
 	stationID := uuid.New()
 	lineID := uuid.New()
-	now := time.Now()
 
-	mockRepo.UpdateStationFn = func(ctx context.Context, id uuid.UUID, name, status *string) (*StationWithLine, error) {
-		assert.Equal(t, stationID, id)
-		assert.NotNil(t, name)
-		assert.Equal(t, "Updated Station", *name)
-		assert.NotNil(t, status)
-		assert.Equal(t, "maintenance", *status)
-		return &StationWithLine{
-			ID:        stationID,
-			Name:      *name,
-			LineID:    lineID,
-			LineName:  "Test Line",
-			Status:    *status,
-			CreatedAt: now,
+	mockRepo.GetStationFn = func(ctx context.Context, id uuid.UUID) (*StationWithLine, error) {
+		return &StationWithLine{ID: id, Name: "Bishan", LineID: lineID, LineName: "Circle Line", Status: "active"}, nil
+	}
+	mockRepo.GetRecentDisruptionsFn = func(ctx context.Context, filter IncidentFilter, limit int32) ([]IncidentWithDetails, error) {
+		require.NotNil(t, filter.StationID)
+		assert.Equal(t, stationID, *filter.StationID)
+		assert.Equal(t, int32(10), limit)
+		return []IncidentWithDetails{
+			{ID: uuid.New(), StationID: stationID, LineID: lineID, DurationMinutes: 30, IncidentType: "signal", LineName: "Circle Line", StationName: "Bishan"},
+			{ID: uuid.New(), StationID: stationID, LineID: lineID, DurationMinutes: 5, IncidentType: "power", LineName: "Circle Line", StationName: "Bishan"},
 		}, nil
 	}
 
-	req := &pb.UpdateStationRequest{
-		Id:     stationID.String(),
-		Name:   "Updated Station",
-		Status: "maintenance",
-	}
-	resp, err := service.UpdateStation(ctx, req)
+	resp, err := service.GetStationWithIncidents(ctx, &pb.GetStationWithIncidentsRequest{Id: stationID.String()})
 
 	require.NoError(t, err)
-	assert.Equal(t, stationID.String(), resp.Id)
-	assert.Equal(t, "Updated Station", resp.Name)
-	assert.Equal(t, "maintenance", resp.Status)
+	assert.Equal(t, stationID.String(), resp.Station.Id)
+	assert.Equal(t, "Bishan", resp.Station.Name)
+	require.Len(t, resp.Incidents, 2)
+	assert.Equal(t, "signal", resp.Incidents[0].IncidentType)
+	assert.Equal(t, "Bishan", resp.Incidents[0].Station)
 }
 
-func TestUpdateStation_OnlyName(t *testing.T) {
+func TestGetStationWithIncidents_LimitCapped(t *testing.T) {
 	service, mockRepo := setupServiceWithMock()
-	ctx := context.Background()
-
-	stationID := uuid.New()
-	lineID := uuid.New()
-	now := time.Now()
 
-	mockRepo.UpdateStationFn = func(ctx context.Context, id uuid.UUID, name, status *string) (*StationWithLine, error) {
-		assert.NotNil(t, name)
-		assert.Nil(t, status)
-		return &StationWithLine{
-			ID:        stationID,
-			Name:      *name,
-			LineID:    lineID,
-			LineName:  "Test Line",
-			Status:    "active",
-			CreatedAt: now,
-		}, nil
+	mockRepo.GetStationFn = func(ctx context.Context, id uuid.UUID) (*StationWithLine, error) {
+		return &StationWithLine{ID: id, Name: "Bishan"}, nil
 	}
-
-	req := &pb.UpdateStationRequest{
-		Id:   stationID.String(),
-		Name: "Updated Station",
+	mockRepo.GetRecentDisruptionsFn = func(ctx context.Context, filter IncidentFilter, limit int32) ([]IncidentWithDetails, error) {
+		assert.Equal(t, int32(100), limit)
+		return nil, nil
 	}
-	resp, err := service.UpdateStation(ctx, req)
+
+	resp, err := service.GetStationWithIncidents(context.Background(), &pb.GetStationWithIncidentsRequest{Id: uuid.New().String(), Limit: 1000})
 
 	require.NoError(t, err)
-	assert.Equal(t, "Updated Station", resp.Name)
+	assert.Empty(t, resp.Incidents)
 }
 
-func TestUpdateStation_OnlyStatus(t *testing.T) {
+func TestGetStationWithIncidents_Errors(t *testing.T) {
+	tests := []struct {
+		name     string
+		id       string
+		repoErr  error
+		wantCode codes.Code
+	}{
+		{"invalid station ID", "not-a-uuid", nil, codes.InvalidArgument},
+		{"station not found", uuid.New().String(), ErrNotFound, codes.NotFound},
+		{"database error", uuid.New().String(), ErrDatabaseError, codes.Internal},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, mockRepo := setupServiceWithMock()
+			mockRepo.GetStationFn = func(ctx context.Context, id uuid.UUID) (*StationWithLine, error) {
+				return nil, tt.repoErr
+			}
+
+			resp, err := service.GetStationWithIncidents(context.Background(), &pb.GetStationWithIncidentsRequest{Id: tt.id})
+
+			require.Error(t, err)
+			assert.Nil(t, resp)
+			assert.Equal(t, tt.wantCode, status.Code(err))
+		})
+	}
+}
+
+func TestGetStationDetails_Success(t *testing.T) {
 	service, mockRepo := setupServiceWithMock()
 	ctx := context.Background()
 
 	stationID := uuid.New()
 	lineID := uuid.New()
-	now := time.Now()
+	mtbf := 720.5
 
-	mockRepo.UpdateStationFn = func(ctx context.Context, id uuid.UUID, name, status *string) (*StationWithLine, error) {
-		assert.Nil(t, name)
-		assert.NotNil(t, status)
-		return &StationWithLine{
+	mockRepo.GetStationFn = func(ctx context.Context, id uuid.UUID) (*StationWithLine, error) {
+		return &StationWithLine{ID: id, Name: "Bishan", LineID: lineID, LineName: "Circle Line", Status: "active"}, nil
+	}
+	mockRepo.GetLineFn = func(ctx context.Context, id uuid.UUID) (*Line, error) {
+		assert.Equal(t, lineID, id)
+		return &Line{ID: id, Name: "Circle Line"}, nil
+	}
+	mockRepo.GetStationMTBFFn = func(ctx context.Context, id uuid.UUID) (*StationMTBF, error) {
+		assert.Equal(t, stationID, id)
+		return &StationMTBF{StationName: "Bishan", LineName: "Circle Line", IncidentCount: 3, DowntimeMinutes: 95, MTBFMinutes: &mtbf}, nil
+	}
+	mockRepo.GetRecentDisruptionsFn = func(ctx context.Context, filter IncidentFilter, limit int32) ([]IncidentWithDetails, error) {
+		if assert.NotNil(t, filter.StationID) {
+			assert.Equal(t, stationID, *filter.StationID)
+		}
+		assert.Equal(t, int32(5), limit)
+		return []IncidentWithDetails{
+			{ID: uuid.New(), StationID: stationID, LineID: lineID, DurationMinutes: 30, IncidentType: "signal", LineName: "Circle Line", StationName: "Bishan"},
+		}, nil
+	}
+
+	resp, err := service.GetStationDetails(ctx, &pb.GetStationDetailsRequest{Id: stationID.String(), Limit: 5})
+
+	require.NoError(t, err)
+	assert.Equal(t, "Bishan", resp.Station.Name)
+	assert.Equal(t, lineID.String(), resp.Line.Id)
+	assert.Equal(t, "Circle Line", resp.Line.Name)
+	assert.Equal(t, int32(3), resp.IncidentCount)
+	assert.Equal(t, int32(95), resp.DowntimeMinutes)
+	require.NotNil(t, resp.MtbfMinutes)
+	assert.Equal(t, mtbf, *resp.MtbfMinutes)
+	require.Len(t, resp.RecentIncidents, 1)
+	assert.Equal(t, "signal", resp.RecentIncidents[0].IncidentType)
+}
+
+func TestGetStationDetails_Errors(t *testing.T) {
+	tests := []struct {
+		name     string
+		id       string
+		repoErr  error
+		wantCode codes.Code
+	}{
+		{"invalid station ID", "not-a-uuid", nil, codes.InvalidArgument},
+		{"station not found", uuid.New().String(), ErrNotFound, codes.NotFound},
+		{"database error", uuid.New().String(), ErrDatabaseError, codes.Internal},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, mockRepo := setupServiceWithMock()
+			mockRepo.GetStationFn = func(ctx context.Context, id uuid.UUID) (*StationWithLine, error) {
+				return nil, tt.repoErr
+			}
+			mockRepo.GetStationMTBFFn = func(ctx context.Context, id uuid.UUID) (*StationMTBF, error) {
+				return &StationMTBF{}, nil
+			}
+			mockRepo.GetRecentDisruptionsFn = func(ctx context.Context, filter IncidentFilter, limit int32) ([]IncidentWithDetails, error) {
+				return nil, nil
+			}
+
+			resp, err := service.GetStationDetails(context.Background(), &pb.GetStationDetailsRequest{Id: tt.id})
+
+			require.Error(t, err)
+			assert.Nil(t, resp)
+			assert.Equal(t, tt.wantCode, status.Code(err))
+		})
+	}
+}
+
+func TestUpdateStation_Success_NameAndStatus(t *testing.T) {
+	service, mockRepo := setupServiceWithMock()
+	ctx := context.Background()
+
+	stationID := uuid.New()
+	lineID := uuid.New()
+	now := time.Now()
+
+	mockRepo.UpdateStationFn = func(ctx context.Context, id uuid.UUID, name, status *string, coords *StationCoordinates) (*StationWithLine, error) {
+		assert.Equal(t, stationID, id)
+		assert.NotNil(t, name)
+		assert.Equal(t, "Updated Station", *name)
+		assert.NotNil(t, status)
+		assert.Equal(t, "maintenance", *status)
+		return &StationWithLine{
+			ID:        stationID,
+			Name:      *name,
+			LineID:    lineID,
+			LineName:  "Test Line",
+			Status:    *status,
+			CreatedAt: now,
+		}, nil
+	}
+
+	req := &pb.UpdateStationRequest{
+		Id:     stationID.String(),
+		Name:   "Updated Station",
+		Status: "maintenance",
+	}
+	resp, err := service.UpdateStation(ctx, req)
+
+	require.NoError(t, err)
+	assert.Equal(t, stationID.String(), resp.Id)
+	assert.Equal(t, "Updated Station", resp.Name)
+	assert.Equal(t, "maintenance", resp.Status)
+}
+
+func TestUpdateStation_OnlyName(t *testing.T) {
+	service, mockRepo := setupServiceWithMock()
+	ctx := context.Background()
+
+	stationID := uuid.New()
+	lineID := uuid.New()
+	now := time.Now()
+
+	mockRepo.UpdateStationFn = func(ctx context.Context, id uuid.UUID, name, status *string, coords *StationCoordinates) (*StationWithLine, error) {
+		assert.NotNil(t, name)
+		assert.Nil(t, status)
+		return &StationWithLine{
+			ID:        stationID,
+			Name:      *name,
+			LineID:    lineID,
+			LineName:  "Test Line",
+			Status:    "active",
+			CreatedAt: now,
+		}, nil
+	}
+
+	req := &pb.UpdateStationRequest{
+		Id:   stationID.String(),
+		Name: "Updated Station",
+	}
+	resp, err := service.UpdateStation(ctx, req)
+
+	require.NoError(t, err)
+	assert.Equal(t, "Updated Station", resp.Name)
+}
+
+func TestUpdateStation_OnlyStatus(t *testing.T) {
+	service, mockRepo := setupServiceWithMock()
+	ctx := context.Background()
+
+	stationID := uuid.New()
+	lineID := uuid.New()
+	now := time.Now()
+
+	mockRepo.UpdateStationFn = func(ctx context.Context, id uuid.UUID, name, status *string, coords *StationCoordinates) (*StationWithLine, error) {
+		assert.Nil(t, name)
+		assert.NotNil(t, status)
+		return &StationWithLine{
 			ID:        stationID,
 			Name:      "Test Station",
 			LineID:    lineID,
@@ -1077,7 +2082,7 @@ func TestUpdateStation_NotFound(t *testing.T) {
 
 	stationID := uuid.New()
 
-	mockRepo.UpdateStationFn = func(ctx context.Context, id uuid.UUID, name, status *string) (*StationWithLine, error) {
+	mockRepo.UpdateStationFn = func(ctx context.Context, id uuid.UUID, name, status *string, coords *StationCoordinates) (*StationWithLine, error) {
 		return nil, ErrNotFound
 	}
 
@@ -1100,7 +2105,7 @@ func TestUpdateStation_RepositoryError(t *testing.T) {
 
 	stationID := uuid.New()
 
-	mockRepo.UpdateStationFn = func(ctx context.Context, id uuid.UUID, name, status *string) (*StationWithLine, error) {
+	mockRepo.UpdateStationFn = func(ctx context.Context, id uuid.UUID, name, status *string, coords *StationCoordinates) (*StationWithLine, error) {
 		return nil, errors.New("database error")
 	}
 
@@ -1123,8 +2128,9 @@ func TestDeleteStation_Success(t *testing.T) {
 
 	stationID := uuid.New()
 
-	mockRepo.DeleteStationFn = func(ctx context.Context, id uuid.UUID) error {
+	mockRepo.DeleteStationFn = func(ctx context.Context, id uuid.UUID, force bool) error {
 		assert.Equal(t, stationID, id)
+		assert.False(t, force)
 		return nil
 	}
 
@@ -1135,6 +2141,193 @@ func TestDeleteStation_Success(t *testing.T) {
 	assert.NotNil(t, resp)
 }
 
+func TestDeleteStation_Force(t *testing.T) {
+	service, mockRepo := setupServiceWithMock()
+	ctx := context.Background()
+
+	var gotForce bool
+	mockRepo.DeleteStationFn = func(ctx context.Context, id uuid.UUID, force bool) error {
+		gotForce = force
+		return nil
+	}
+
+	_, err := service.DeleteStation(ctx, &pb.DeleteStationRequest{Id: uuid.New().String(), Force: true})
+
+	require.NoError(t, err)
+	assert.True(t, gotForce)
+}
+
+func TestReorderStations_Success(t *testing.T) {
+	service, mockRepo := setupServiceWithMock()
+	ctx := context.Background()
+
+	lineID := uuid.New()
+	first, second := uuid.New(), uuid.New()
+	pos0, pos1 := int32(0), int32(1)
+
+	mockRepo.ReorderStationsFn = func(ctx context.Context, lID uuid.UUID, stationIDs []uuid.UUID) error {
+		assert.Equal(t, lineID, lID)
+		assert.Equal(t, []uuid.UUID{first, second}, stationIDs)
+		return nil
+	}
+	mockRepo.ListStationsFn = func(ctx context.Context, filter StationFilter, includeIncidentCount bool) ([]StationWithLine, error) {
+		require.NotNil(t, filter.LineID)
+		assert.Equal(t, lineID, *filter.LineID)
+		return []StationWithLine{
+			{ID: first, Name: "Dhoby Ghaut", LineID: lineID, Position: &pos0},
+			{ID: second, Name: "Bras Basah", LineID: lineID, Position: &pos1},
+			{ID: uuid.New(), Name: "Unplaced", LineID: lineID},
+		}, nil
+	}
+
+	resp, err := service.ReorderStations(ctx, &pb.ReorderStationsRequest{
+		LineId:     lineID.String(),
+		StationIds: []string{first.String(), second.String()},
+	})
+
+	require.NoError(t, err)
+	require.Len(t, resp.Stations, 3)
+	assert.Equal(t, "Dhoby Ghaut", resp.Stations[0].Name)
+	require.NotNil(t, resp.Stations[1].Position)
+	assert.Equal(t, int32(1), *resp.Stations[1].Position)
+	assert.Nil(t, resp.Stations[2].Position)
+}
+
+func TestReorderStations_Errors(t *testing.T) {
+	lineID := uuid.New()
+	stationID := uuid.New()
+
+	tests := []struct {
+		name     string
+		req      *pb.ReorderStationsRequest
+		repoErr  error
+		wantCode codes.Code
+	}{
+		{"invalid line id", &pb.ReorderStationsRequest{LineId: "nope"}, nil, codes.InvalidArgument},
+		{"invalid station id", &pb.ReorderStationsRequest{LineId: lineID.String(), StationIds: []string{"nope"}}, nil, codes.InvalidArgument},
+		{"duplicate station", &pb.ReorderStationsRequest{LineId: lineID.String(), StationIds: []string{stationID.String(), stationID.String()}}, nil, codes.InvalidArgument},
+		{"line not found", &pb.ReorderStationsRequest{LineId: lineID.String()}, ErrLineNotFound, codes.NotFound},
+		{"station not on line", &pb.ReorderStationsRequest{LineId: lineID.String(), StationIds: []string{stationID.String()}}, ErrStationLineMismatch, codes.FailedPrecondition},
+		{"database error", &pb.ReorderStationsRequest{LineId: lineID.String()}, ErrDatabaseError, codes.Internal},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, mockRepo := setupServiceWithMock()
+			mockRepo.ReorderStationsFn = func(ctx context.Context, lID uuid.UUID, stationIDs []uuid.UUID) error {
+				if tt.repoErr == nil {
+					t.Fatal("repository must not be called")
+				}
+				return tt.repoErr
+			}
+
+			resp, err := service.ReorderStations(context.Background(), tt.req)
+
+			require.Error(t, err)
+			assert.Nil(t, resp)
+			assert.Equal(t, tt.wantCode, status.Code(err))
+		})
+	}
+}
+
+func TestReorderStations_DuplicateStation(t *testing.T) {
+	service, mockRepo := setupServiceWithMock()
+	mockRepo.ReorderStationsFn = func(ctx context.Context, lID uuid.UUID, stationIDs []uuid.UUID) error {
+		t.Fatal("repository must not be called")
+		return nil
+	}
+
+	a, b := uuid.New().String(), uuid.New().String()
+	_, err := service.ReorderStations(context.Background(), &pb.ReorderStationsRequest{
+		LineId:     uuid.New().String(),
+		StationIds: []string{a, b, a},
+	})
+
+	st, ok := status.FromError(err)
+	require.True(t, ok)
+	assert.Equal(t, codes.InvalidArgument, st.Code())
+	require.Len(t, st.Details(), 1)
+	badRequest, ok := st.Details()[0].(*errdetails.BadRequest)
+	require.True(t, ok)
+	require.Len(t, badRequest.FieldViolations, 1)
+	assert.Equal(t, "station_ids[2]", badRequest.FieldViolations[0].Field)
+}
+
+func TestMergeStations_Success(t *testing.T) {
+	service, mockRepo := setupServiceWithMock()
+	ctx := context.Background()
+
+	sourceID, targetID := uuid.New(), uuid.New()
+
+	mockRepo.MergeStationsFn = func(ctx context.Context, src, dst uuid.UUID, force bool) (int64, error) {
+		assert.Equal(t, sourceID, src)
+		assert.Equal(t, targetID, dst)
+		assert.True(t, force)
+		return 4, nil
+	}
+
+	resp, err := service.MergeStations(ctx, &pb.MergeStationsRequest{
+		SourceStationId: sourceID.String(),
+		TargetStationId: targetID.String(),
+		Force:           true,
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, targetID.String(), resp.TargetStationId)
+	assert.Equal(t, int32(4), resp.IncidentsMoved)
+}
+
+func TestMergeStations_FailedPrecondition(t *testing.T) {
+	for _, repoErr := range []error{ErrNotFound, ErrStationLineMismatch, ErrAlreadyExists} {
+		t.Run(repoErr.Error(), func(t *testing.T) {
+			service, mockRepo := setupServiceWithMock()
+			ctx := context.Background()
+
+			mockRepo.MergeStationsFn = func(ctx context.Context, src, dst uuid.UUID, force bool) (int64, error) {
+				return 0, repoErr
+			}
+
+			resp, err := service.MergeStations(ctx, &pb.MergeStationsRequest{
+				SourceStationId: uuid.New().String(),
+				TargetStationId: uuid.New().String(),
+			})
+
+			require.Error(t, err)
+			assert.Nil(t, resp)
+			st, ok := status.FromError(err)
+			require.True(t, ok)
+			assert.Equal(t, codes.FailedPrecondition, st.Code())
+		})
+	}
+}
+
+func TestMergeStations_InvalidRequest(t *testing.T) {
+	service, _ := setupServiceWithMock()
+	ctx := context.Background()
+
+	id := uuid.New().String()
+	tests := []struct {
+		name string
+		req  *pb.MergeStationsRequest
+	}{
+		{"invalid source", &pb.MergeStationsRequest{SourceStationId: "bad", TargetStationId: id}},
+		{"invalid target", &pb.MergeStationsRequest{SourceStationId: id, TargetStationId: "bad"}},
+		{"same station", &pb.MergeStationsRequest{SourceStationId: id, TargetStationId: id}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := service.MergeStations(ctx, tt.req)
+
+			require.Error(t, err)
+			assert.Nil(t, resp)
+			st, ok := status.FromError(err)
+			require.True(t, ok)
+			assert.Equal(t, codes.InvalidArgument, st.Code())
+		})
+	}
+}
+
 func TestDeleteStation_InvalidUUID(t *testing.T) {
 	service, _ := setupServiceWithMock()
 	ctx := context.Background()
@@ -1155,7 +2348,7 @@ func TestDeleteStation_NotFound(t *testing.T) {
 
 	stationID := uuid.New()
 
-	mockRepo.DeleteStationFn = func(ctx context.Context, id uuid.UUID) error {
+	mockRepo.DeleteStationFn = func(ctx context.Context, id uuid.UUID, force bool) error {
 		return ErrNotFound
 	}
 
@@ -1169,13 +2362,26 @@ func TestDeleteStation_NotFound(t *testing.T) {
 	assert.Equal(t, codes.NotFound, st.Code())
 }
 
+func TestDeleteStation_IdempotentAlreadyDeleted(t *testing.T) {
+	service, mockRepo := setupServiceWithMock()
+
+	mockRepo.DeleteStationFn = func(ctx context.Context, id uuid.UUID, force bool) error {
+		return ErrNotFound
+	}
+
+	resp, err := service.DeleteStation(context.Background(), &pb.DeleteStationRequest{Id: uuid.New().String(), Idempotent: true})
+
+	require.NoError(t, err)
+	assert.NotNil(t, resp)
+}
+
 func TestDeleteStation_RepositoryError(t *testing.T) {
 	service, mockRepo := setupServiceWithMock()
 	ctx := context.Background()
 
 	stationID := uuid.New()
 
-	mockRepo.DeleteStationFn = func(ctx context.Context, id uuid.UUID) error {
+	mockRepo.DeleteStationFn = func(ctx context.Context, id uuid.UUID, force bool) error {
 		return errors.New("database error")
 	}
 
@@ -1201,7 +2407,7 @@ func TestCreateStation_AllValidStatuses(t *testing.T) {
 			lineID := uuid.New()
 			now := time.Now()
 
-			mockRepo.CreateStationFn = func(ctx context.Context, name string, lID uuid.UUID, status string) (*StationWithLine, error) {
+			mockRepo.CreateStationFn = func(ctx context.Context, name string, lID uuid.UUID, status string, additionalLineIDs []uuid.UUID, coords *StationCoordinates) (*StationWithLine, error) {
 				assert.Equal(t, validStatus, status)
 				return &StationWithLine{
 					ID:        stationID,
@@ -1238,7 +2444,7 @@ func TestUpdateStation_AllValidStatuses(t *testing.T) {
 			lineID := uuid.New()
 			now := time.Now()
 
-			mockRepo.UpdateStationFn = func(ctx context.Context, id uuid.UUID, name, status *string) (*StationWithLine, error) {
+			mockRepo.UpdateStationFn = func(ctx context.Context, id uuid.UUID, name, status *string, coords *StationCoordinates) (*StationWithLine, error) {
 				assert.NotNil(t, status)
 				assert.Equal(t, validStatus, *status)
 				return &StationWithLine{
@@ -1270,7 +2476,7 @@ func TestCreateLine_Idempotent(t *testing.T) {
 	now := time.Now()
 	lineName := "Test Line"
 
-	mockRepo.CreateLineFn = func(ctx context.Context, name string) (*Line, error) {
+	mockRepo.CreateLineFn = func(ctx context.Context, name string, attrs LineAttributes) (*Line, error) {
 		return &Line{
 			ID:        lineID,
 			Name:      lineName,
@@ -1305,7 +2511,7 @@ func TestCreateStation_Idempotent(t *testing.T) {
 	now := time.Now()
 	stationName := "Test Station"
 
-	mockRepo.CreateStationFn = func(ctx context.Context, name string, lID uuid.UUID, status string) (*StationWithLine, error) {
+	mockRepo.CreateStationFn = func(ctx context.Context, name string, lID uuid.UUID, status string, additionalLineIDs []uuid.UUID, coords *StationCoordinates) (*StationWithLine, error) {
 		return &StationWithLine{
 			ID:        stationID,
 			Name:      stationName,
@@ -1345,7 +2551,7 @@ func TestUpdateLine_Idempotent(t *testing.T) {
 	now := time.Now()
 	newName := "Updated Line"
 
-	mockRepo.UpdateLineFn = func(ctx context.Context, id uuid.UUID, name string) (*Line, error) {
+	mockRepo.UpdateLineFn = func(ctx context.Context, id uuid.UUID, name string, attrs LineAttributes) (*Line, error) {
 		return &Line{
 			ID:        lineID,
 			Name:      newName,
@@ -1382,7 +2588,7 @@ func TestUpdateStation_Idempotent(t *testing.T) {
 	newName := "Updated Station"
 	newStatus := "maintenance"
 
-	mockRepo.UpdateStationFn = func(ctx context.Context, id uuid.UUID, name, status *string) (*StationWithLine, error) {
+	mockRepo.UpdateStationFn = func(ctx context.Context, id uuid.UUID, name, status *string, coords *StationCoordinates) (*StationWithLine, error) {
 		return &StationWithLine{
 			ID:        stationID,
 			Name:      newName,
@@ -1415,3 +2621,3842 @@ func TestUpdateStation_Idempotent(t *testing.T) {
 	assert.Equal(t, resp1.Name, resp2.Name)
 	assert.Equal(t, resp1.Status, resp2.Status)
 }
+
+type mockIncidentStream struct {
+	grpc.ServerStream
+	ctx  context.Context
+	sent []*pb.IncidentResponse
+}
+
+func (m *mockIncidentStream) Context() context.Context {
+	return m.ctx
+}
+
+func (m *mockIncidentStream) Send(resp *pb.IncidentResponse) error {
+	m.sent = append(m.sent, resp)
+	return nil
+}
+
+func TestStreamIncidentsExport_Success(t *testing.T) {
+	service, mockRepo := setupServiceWithMock()
+	stream := &mockIncidentStream{ctx: context.Background()}
+
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC)
+
+	mockRepo.StreamIncidentsFn = func(ctx context.Context, s, e *time.Time, timeField TimeField, fn func(*IncidentWithDetails) error) error {
+		require.NotNil(t, s)
+		require.NotNil(t, e)
+		assert.Equal(t, start, *s)
+		assert.Equal(t, end, *e)
+		assert.Equal(t, TimeFieldEvent, timeField)
+		for i := 0; i < 3; i++ {
+			err := fn(&IncidentWithDetails{
+				ID:              uuid.New(),
+				StationID:       uuid.New(),
+				LineID:          uuid.New(),
+				Timestamp:       start.Add(time.Duration(i) * time.Hour),
+				DurationMinutes: 10,
+				IncidentType:    "power",
+				Status:          "open",
+				LineName:        "Test Line",
+				StationName:     "Test Station",
+			})
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	req := &pb.StreamIncidentsExportRequest{
+		StartTime: timestamppb.New(start),
+		EndTime:   timestamppb.New(end),
+	}
+	err := service.StreamIncidentsExport(req, stream)
+
+	require.NoError(t, err)
+	require.Len(t, stream.sent, 3)
+	assert.Equal(t, "Test Line", stream.sent[0].Line)
+	assert.Equal(t, "Test Station", stream.sent[0].Station)
+	assert.Equal(t, "power", stream.sent[0].IncidentType)
+}
+
+func TestStreamIncidentsExport_InvalidRange(t *testing.T) {
+	service, _ := setupServiceWithMock()
+	stream := &mockIncidentStream{ctx: context.Background()}
+
+	now := time.Now()
+	req := &pb.StreamIncidentsExportRequest{
+		StartTime: timestamppb.New(now),
+		EndTime:   timestamppb.New(now.Add(-time.Hour)),
+	}
+	err := service.StreamIncidentsExport(req, stream)
+
+	require.Error(t, err)
+	st, ok := status.FromError(err)
+	require.True(t, ok)
+	assert.Equal(t, codes.InvalidArgument, st.Code())
+}
+
+func TestStreamIncidentsExport_Cancelled(t *testing.T) {
+	service, mockRepo := setupServiceWithMock()
+	ctx, cancel := context.WithCancel(context.Background())
+	stream := &mockIncidentStream{ctx: ctx}
+
+	mockRepo.StreamIncidentsFn = func(ctx context.Context, start, end *time.Time, timeField TimeField, fn func(*IncidentWithDetails) error) error {
+		cancel()
+		return ctx.Err()
+	}
+
+	err := service.StreamIncidentsExport(&pb.StreamIncidentsExportRequest{}, stream)
+
+	require.Error(t, err)
+	st, ok := status.FromError(err)
+	require.True(t, ok)
+	assert.Equal(t, codes.Canceled, st.Code())
+}
+
+func TestStreamIncidentsExport_RepositoryError(t *testing.T) {
+	service, mockRepo := setupServiceWithMock()
+	stream := &mockIncidentStream{ctx: context.Background()}
+
+	mockRepo.StreamIncidentsFn = func(ctx context.Context, start, end *time.Time, timeField TimeField, fn func(*IncidentWithDetails) error) error {
+		return ErrDatabaseError
+	}
+
+	err := service.StreamIncidentsExport(&pb.StreamIncidentsExportRequest{}, stream)
+
+	require.Error(t, err)
+	st, ok := status.FromError(err)
+	require.True(t, ok)
+	assert.Equal(t, codes.Internal, st.Code())
+}
+
+func TestCreateIncident_DefaultStatus(t *testing.T) {
+	service, mockRepo := setupServiceWithMock()
+	service.cfg.IncidentStatuses = []string{"reported", "resolved"}
+	ctx := context.Background()
+
+	lineID := uuid.New()
+	stationID := uuid.New()
+	ts := time.Now().Add(-time.Hour)
+
+	mockRepo.GetOrCreateLineFn = func(ctx context.Context, name string) (*Line, bool, error) {
+		return &Line{ID: lineID, Name: name}, false, nil
+	}
+	mockRepo.GetOrCreateStationFn = func(ctx context.Context, name string, lID uuid.UUID) (*Station, bool, error) {
+		return &Station{ID: stationID, Name: name, LineID: lID}, false, nil
+	}
+	mockRepo.CreateIncidentFn = func(ctx context.Context, sID, lID uuid.UUID, ts time.Time, durationMinutes int32, incidentType, status, source string) (*Incident, error) {
+		assert.Equal(t, "reported", status)
+		return &Incident{
+			ID:              uuid.New(),
+			StationID:       sID,
+			LineID:          lID,
+			Timestamp:       ts,
+			DurationMinutes: durationMinutes,
+			IncidentType:    incidentType,
+			Status:          status,
+		}, nil
+	}
+
+	req := &pb.CreateIncidentRequest{
+		Line:            "Test Line",
+		Station:         "Test Station",
+		Timestamp:       timestamppb.New(ts),
+		DurationMinutes: 15,
+		IncidentType:    "signal",
+	}
+	resp, err := service.CreateIncident(ctx, req)
+
+	require.NoError(t, err)
+	assert.Equal(t, "reported", resp.Status)
+}
+
+func TestCreateIncident_AutoUpdateStationStatus(t *testing.T) {
+	lineID := uuid.New()
+	stationID := uuid.New()
+	ts := time.Now().Add(-time.Hour)
+
+	tests := []struct {
+		name         string
+		auto         bool
+		incidentType string
+		duration     int32
+		wantStatus   string
+	}{
+		{"power over threshold", true, "power", 60, "maintenance"},
+		{"mechanical over threshold", true, "Mechanical", 90, "maintenance"},
+		{"below threshold", true, "power", 59, ""},
+		{"other type", true, "signal", 120, ""},
+		{"flag not set", false, "power", 120, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, mockRepo := setupServiceWithMock()
+			service.cfg.AutoMaintenanceMinDuration = 60
+
+			mockRepo.GetOrCreateLineFn = func(ctx context.Context, name string) (*Line, bool, error) {
+				return &Line{ID: lineID, Name: name}, false, nil
+			}
+			mockRepo.GetOrCreateStationFn = func(ctx context.Context, name string, lID uuid.UUID) (*Station, bool, error) {
+				return &Station{ID: stationID, Name: name, LineID: lID}, false, nil
+			}
+			var gotStatus string
+			mockRepo.CreateIncidentWithOptionsFn = func(ctx context.Context, sID, lID uuid.UUID, ts time.Time, durationMinutes int32, incidentType, status, source string, opts IncidentCreateOptions) (*IncidentCreateResult, error) {
+				assert.Equal(t, stationID, sID)
+				gotStatus = opts.StationStatus
+				incident := &Incident{ID: uuid.New(), StationID: sID, LineID: lID, Timestamp: ts, DurationMinutes: durationMinutes, IncidentType: incidentType, Status: status}
+				return &IncidentCreateResult{Incident: incident, StationStatusUpdated: opts.StationStatus != ""}, nil
+			}
+			mockRepo.CreateIncidentFn = func(ctx context.Context, sID, lID uuid.UUID, ts time.Time, durationMinutes int32, incidentType, status, source string) (*Incident, error) {
+				return &Incident{ID: uuid.New(), StationID: sID, LineID: lID, Timestamp: ts, DurationMinutes: durationMinutes, IncidentType: incidentType, Status: status}, nil
+			}
+
+			resp, err := service.CreateIncident(context.Background(), &pb.CreateIncidentRequest{
+				Line:                    "Test Line",
+				Station:                 "Test Station",
+				Timestamp:               timestamppb.New(ts),
+				DurationMinutes:         tt.duration,
+				IncidentType:            tt.incidentType,
+				AutoUpdateStationStatus: tt.auto,
+			})
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantStatus, gotStatus)
+			assert.Equal(t, tt.wantStatus != "", resp.StationStatusUpdated)
+		})
+	}
+}
+
+func TestCreateIncident_StationStatusNotUpdated(t *testing.T) {
+	service, mockRepo := setupServiceWithMock()
+	service.cfg.AutoMaintenanceMinDuration = 60
+
+	mockRepo.GetOrCreateLineFn = func(ctx context.Context, name string) (*Line, bool, error) {
+		return &Line{ID: uuid.New(), Name: name}, false, nil
+	}
+	mockRepo.GetOrCreateStationFn = func(ctx context.Context, name string, lID uuid.UUID) (*Station, bool, error) {
+		return &Station{ID: uuid.New(), Name: name, LineID: lID}, false, nil
+	}
+	// The station was deleted between the lookup and the update.
+	mockRepo.CreateIncidentWithOptionsFn = func(ctx context.Context, sID, lID uuid.UUID, ts time.Time, durationMinutes int32, incidentType, status, source string, opts IncidentCreateOptions) (*IncidentCreateResult, error) {
+		assert.Equal(t, "maintenance", opts.StationStatus)
+		return &IncidentCreateResult{Incident: &Incident{ID: uuid.New(), Timestamp: ts}}, nil
+	}
+
+	resp, err := service.CreateIncident(context.Background(), &pb.CreateIncidentRequest{
+		Line:                    "Test Line",
+		Station:                 "Test Station",
+		Timestamp:               timestamppb.New(time.Now().Add(-time.Hour)),
+		DurationMinutes:         90,
+		IncidentType:            "power",
+		AutoUpdateStationStatus: true,
+	})
+
+	require.NoError(t, err)
+	assert.False(t, resp.StationStatusUpdated)
+}
+
+func TestCreateIncident_OffsetTimestamp(t *testing.T) {
+	service, mockRepo := setupServiceWithMock()
+	ctx := context.Background()
+
+	want := time.Date(2024, 1, 15, 8, 30, 0, 0, time.UTC)
+
+	mockRepo.GetOrCreateLineFn = func(ctx context.Context, name string) (*Line, bool, error) {
+		return &Line{ID: uuid.New(), Name: name}, false, nil
+	}
+	mockRepo.GetOrCreateStationFn = func(ctx context.Context, name string, lID uuid.UUID) (*Station, bool, error) {
+		return &Station{ID: uuid.New(), Name: name, LineID: lID}, false, nil
+	}
+	mockRepo.CreateIncidentFn = func(ctx context.Context, sID, lID uuid.UUID, ts time.Time, durationMinutes int32, incidentType, status, source string) (*Incident, error) {
+		assert.Equal(t, want, ts)
+		return &Incident{ID: uuid.New(), StationID: sID, LineID: lID, Timestamp: ts, Status: status}, nil
+	}
+
+	var req pb.CreateIncidentRequest
+	require.NoError(t, protojson.Unmarshal([]byte(`{
+		"line": "Circle Line",
+		"station": "Bishan",
+		"timestamp": "2024-01-15T16:30:00+08:00",
+		"duration_minutes": 15,
+		"incident_type": "signal"
+	}`), &req))
+
+	resp, err := service.CreateIncident(ctx, &req)
+
+	require.NoError(t, err)
+	assert.Equal(t, want, resp.Timestamp.AsTime())
+}
+
+func TestCreateIncident_AlreadyExists(t *testing.T) {
+	service, mockRepo := setupServiceWithMock()
+	ctx := context.Background()
+
+	mockRepo.GetOrCreateLineFn = func(ctx context.Context, name string) (*Line, bool, error) {
+		return &Line{ID: uuid.New(), Name: name}, false, nil
+	}
+	mockRepo.GetOrCreateStationFn = func(ctx context.Context, name string, lID uuid.UUID) (*Station, bool, error) {
+		return &Station{ID: uuid.New(), Name: name, LineID: lID}, false, nil
+	}
+	mockRepo.CreateIncidentFn = func(ctx context.Context, sID, lID uuid.UUID, ts time.Time, durationMinutes int32, incidentType, status, source string) (*Incident, error) {
+		return nil, ErrAlreadyExists
+	}
+
+	req := &pb.CreateIncidentRequest{
+		Line:            "Test Line",
+		Station:         "Test Station",
+		Timestamp:       timestamppb.New(time.Now().Add(-time.Hour)),
+		DurationMinutes: 15,
+		IncidentType:    "signal",
+	}
+	resp, err := service.CreateIncident(ctx, req)
+
+	require.Error(t, err)
+	assert.Nil(t, resp)
+	assert.Equal(t, codes.AlreadyExists, status.Code(err))
+}
+
+func TestCreateIncident_Source(t *testing.T) {
+	service, mockRepo := setupServiceWithMock()
+	ctx := context.Background()
+
+	ts := time.Now().Add(-time.Hour)
+
+	mockRepo.GetOrCreateLineFn = func(ctx context.Context, name string) (*Line, bool, error) {
+		return &Line{ID: uuid.New(), Name: name}, false, nil
+	}
+	mockRepo.GetOrCreateStationFn = func(ctx context.Context, name string, lID uuid.UUID) (*Station, bool, error) {
+		return &Station{ID: uuid.New(), Name: name, LineID: lID}, false, nil
+	}
+	mockRepo.CreateIncidentFn = func(ctx context.Context, sID, lID uuid.UUID, ts time.Time, durationMinutes int32, incidentType, status, source string) (*Incident, error) {
+		assert.Equal(t, "scada", source)
+		return &Incident{
+			ID:           uuid.New(),
+			Timestamp:    ts,
+			IncidentType: incidentType,
+			Status:       status,
+			Source:       &source,
+		}, nil
+	}
+
+	req := &pb.CreateIncidentRequest{
+		Line:            "Test Line",
+		Station:         "Test Station",
+		Timestamp:       timestamppb.New(ts),
+		DurationMinutes: 15,
+		IncidentType:    "signal",
+		Source:          " scada ",
+	}
+	resp, err := service.CreateIncident(ctx, req)
+
+	require.NoError(t, err)
+	assert.Equal(t, "scada", resp.Source)
+}
+
+func TestCreateIncident_NormalizesIncidentType(t *testing.T) {
+	service, mockRepo := setupServiceWithMock()
+	ctx := context.Background()
+
+	ts := time.Now().Add(-time.Hour)
+
+	mockRepo.GetOrCreateLineFn = func(ctx context.Context, name string) (*Line, bool, error) {
+		return &Line{ID: uuid.New(), Name: name}, false, nil
+	}
+	mockRepo.GetOrCreateStationFn = func(ctx context.Context, name string, lID uuid.UUID) (*Station, bool, error) {
+		return &Station{ID: uuid.New(), Name: name, LineID: lID}, false, nil
+	}
+	mockRepo.CreateIncidentFn = func(ctx context.Context, sID, lID uuid.UUID, ts time.Time, durationMinutes int32, incidentType, status, source string) (*Incident, error) {
+		assert.Equal(t, "mechanical", incidentType)
+		return &Incident{
+			ID:           uuid.New(),
+			Timestamp:    ts,
+			IncidentType: incidentType,
+			Status:       status,
+		}, nil
+	}
+
+	req := &pb.CreateIncidentRequest{
+		Line:            "Test Line",
+		Station:         "Test Station",
+		Timestamp:       timestamppb.New(ts),
+		DurationMinutes: 15,
+		IncidentType:    " Mechanical ",
+	}
+	resp, err := service.CreateIncident(ctx, req)
+
+	require.NoError(t, err)
+	assert.Equal(t, "mechanical", resp.IncidentType)
+}
+
+func TestCreateIncident_FutureTimestampTolerance(t *testing.T) {
+	tests := []struct {
+		name      string
+		tolerance time.Duration
+		ahead     time.Duration
+		wantErr   bool
+	}{
+		{"no tolerance", 0, 10 * time.Second, true},
+		{"within tolerance", 30 * time.Second, 10 * time.Second, false},
+		{"beyond tolerance", 30 * time.Second, time.Minute, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, mockRepo := setupServiceWithMock()
+			service.cfg.IncidentFutureTolerance = tt.tolerance
+			ctx := context.Background()
+
+			mockRepo.FindLineByNameFn = func(ctx context.Context, name string) (*Line, error) {
+				return nil, ErrNotFound
+			}
+
+			resp, err := service.CreateIncident(ctx, &pb.CreateIncidentRequest{
+				Line:         "Test Line",
+				Station:      "Test Station",
+				Timestamp:    timestamppb.New(time.Now().UTC().Add(tt.ahead)),
+				IncidentType: "signal",
+				DryRun:       true,
+			})
+
+			if !tt.wantErr {
+				require.NoError(t, err)
+				assert.True(t, resp.DryRun)
+				return
+			}
+			require.Error(t, err)
+			st, ok := status.FromError(err)
+			require.True(t, ok)
+			assert.Equal(t, codes.InvalidArgument, st.Code())
+		})
+	}
+}
+
+func TestCreateIncident_Tags(t *testing.T) {
+	service, mockRepo := setupServiceWithMock()
+	ctx := context.Background()
+
+	ts := time.Now().Add(-time.Hour)
+	incidentID := uuid.New()
+
+	mockRepo.GetOrCreateLineFn = func(ctx context.Context, name string) (*Line, bool, error) {
+		return &Line{ID: uuid.New(), Name: name}, false, nil
+	}
+	mockRepo.GetOrCreateStationFn = func(ctx context.Context, name string, lID uuid.UUID) (*Station, bool, error) {
+		return &Station{ID: uuid.New(), Name: name, LineID: lID}, false, nil
+	}
+	mockRepo.CreateIncidentWithOptionsFn = func(ctx context.Context, sID, lID uuid.UUID, ts time.Time, durationMinutes int32, incidentType, status, source string, opts IncidentCreateOptions) (*IncidentCreateResult, error) {
+		assert.Empty(t, opts.StationStatus)
+		assert.Equal(t, []string{"rain-related", "rolling-stock-x"}, opts.Tags)
+		incident := &Incident{ID: incidentID, Timestamp: ts, IncidentType: incidentType, Status: status}
+		return &IncidentCreateResult{Incident: incident, Tags: []string{"rain-related", "rolling-stock-x"}}, nil
+	}
+
+	req := &pb.CreateIncidentRequest{
+		Line:            "Test Line",
+		Station:         "Test Station",
+		Timestamp:       timestamppb.New(ts),
+		DurationMinutes: 15,
+		IncidentType:    "weather",
+		Tags:            []string{" Rain-Related ", "rolling-stock-X", "rain-related"},
+	}
+	resp, err := service.CreateIncident(ctx, req)
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"rain-related", "rolling-stock-x"}, resp.Tags)
+}
+
+func TestCreateIncident_TagFailureNotStored(t *testing.T) {
+	service, mockRepo := setupServiceWithMock()
+	ctx := context.Background()
+
+	mockRepo.GetOrCreateLineFn = func(ctx context.Context, name string) (*Line, bool, error) {
+		return &Line{ID: uuid.New(), Name: name}, false, nil
+	}
+	mockRepo.GetOrCreateStationFn = func(ctx context.Context, name string, lID uuid.UUID) (*Station, bool, error) {
+		return &Station{ID: uuid.New(), Name: name, LineID: lID}, false, nil
+	}
+	mockRepo.CreateIncidentWithOptionsFn = func(ctx context.Context, sID, lID uuid.UUID, ts time.Time, durationMinutes int32, incidentType, status, source string, opts IncidentCreateOptions) (*IncidentCreateResult, error) {
+		return nil, ErrDatabaseError
+	}
+
+	resp, err := service.CreateIncident(ctx, &pb.CreateIncidentRequest{
+		Line:            "Test Line",
+		Station:         "Test Station",
+		Timestamp:       timestamppb.New(time.Now().Add(-time.Hour)),
+		DurationMinutes: 15,
+		IncidentType:    "weather",
+		Tags:            []string{"rain-related"},
+	})
+
+	require.Error(t, err)
+	assert.Nil(t, resp)
+	st, ok := status.FromError(err)
+	require.True(t, ok)
+	assert.Equal(t, codes.Internal, st.Code())
+}
+
+func TestCreateIncident_InvalidTag(t *testing.T) {
+	service, _ := setupServiceWithMock()
+	ctx := context.Background()
+
+	req := &pb.CreateIncidentRequest{
+		Line:            "Test Line",
+		Station:         "Test Station",
+		Timestamp:       timestamppb.New(time.Now().Add(-time.Hour)),
+		DurationMinutes: 15,
+		IncidentType:    "weather",
+		Tags:            []string{"ok", "  "},
+	}
+	resp, err := service.CreateIncident(ctx, req)
+
+	require.Error(t, err)
+	assert.Nil(t, resp)
+	st, ok := status.FromError(err)
+	require.True(t, ok)
+	assert.Equal(t, codes.InvalidArgument, st.Code())
+}
+
+func TestCreateIncident_ReportsEveryFieldViolation(t *testing.T) {
+	service, _ := setupServiceWithMock()
+
+	resp, err := service.CreateIncident(context.Background(), &pb.CreateIncidentRequest{
+		Line:            "Circle Line",
+		DurationMinutes: 2000,
+		IncidentType:    "signal",
+	})
+
+	require.Error(t, err)
+	assert.Nil(t, resp)
+	st, ok := status.FromError(err)
+	require.True(t, ok)
+	assert.Equal(t, codes.InvalidArgument, st.Code())
+	assert.Equal(t, "station must not be empty", st.Message())
+
+	require.Len(t, st.Details(), 1)
+	badRequest, ok := st.Details()[0].(*errdetails.BadRequest)
+	require.True(t, ok)
+	var fields []string
+	for _, v := range badRequest.FieldViolations {
+		fields = append(fields, v.Field)
+	}
+	assert.Equal(t, []string{"station", "timestamp", "duration_minutes"}, fields)
+}
+
+func TestValidateIncident_Valid(t *testing.T) {
+	service, _ := setupServiceWithMock()
+
+	req := &pb.CreateIncidentRequest{
+		Line:            "Circle Line",
+		Station:         "Bishan",
+		Timestamp:       timestamppb.New(time.Now().Add(-time.Hour)),
+		DurationMinutes: 15,
+		IncidentType:    " Signal ",
+		Tags:            []string{"Rain", "rain"},
+	}
+	resp, err := service.ValidateIncident(context.Background(), req)
+
+	require.NoError(t, err)
+	assert.True(t, resp.Valid)
+	assert.Empty(t, resp.Problems)
+	// Validation must not rewrite the caller's request.
+	assert.Equal(t, " Signal ", req.IncidentType)
+	assert.Equal(t, []string{"Rain", "rain"}, req.Tags)
+}
+
+func TestValidateIncident_ReportsEveryProblem(t *testing.T) {
+	service, _ := setupServiceWithMock()
+
+	resp, err := service.ValidateIncident(context.Background(), &pb.CreateIncidentRequest{
+		Line:            "Circle Line",
+		Timestamp:       timestamppb.New(time.Now().Add(time.Hour)),
+		DurationMinutes: 2000,
+		IncidentType:    "flood",
+	})
+
+	require.NoError(t, err)
+	assert.False(t, resp.Valid)
+	require.Len(t, resp.Problems, 4)
+	assert.Contains(t, resp.Problems[0], "station")
+	assert.Equal(t, "timestamp cannot be in the future", resp.Problems[1])
+	assert.Equal(t, "duration_minutes must be between 0 and 1440", resp.Problems[2])
+	assert.Contains(t, resp.Problems[3], "incident_type")
+}
+
+func TestValidateIncident_MatchesCreateIncident(t *testing.T) {
+	service, _ := setupServiceWithMock()
+	lineID := uuid.New().String()
+
+	validation, err := service.ValidateIncident(context.Background(), &pb.CreateIncidentRequest{LineId: lineID, StationId: "bad"})
+	require.NoError(t, err)
+	require.NotEmpty(t, validation.Problems)
+
+	_, err = service.CreateIncident(context.Background(), &pb.CreateIncidentRequest{LineId: lineID, StationId: "bad"})
+	st, ok := status.FromError(err)
+	require.True(t, ok)
+	assert.Equal(t, codes.InvalidArgument, st.Code())
+	assert.Equal(t, validation.Problems[0], st.Message())
+}
+
+func TestCreateIncident_SourceTooLong(t *testing.T) {
+	service, _ := setupServiceWithMock()
+	ctx := context.Background()
+
+	req := &pb.CreateIncidentRequest{
+		Line:            "Test Line",
+		Station:         "Test Station",
+		Timestamp:       timestamppb.New(time.Now().Add(-time.Hour)),
+		DurationMinutes: 15,
+		IncidentType:    "signal",
+		Source:          strings.Repeat("a", 51),
+	}
+	resp, err := service.CreateIncident(ctx, req)
+
+	require.Error(t, err)
+	assert.Nil(t, resp)
+	st, ok := status.FromError(err)
+	require.True(t, ok)
+	assert.Equal(t, codes.InvalidArgument, st.Code())
+}
+
+func TestGetRecentDisruptions_FilterBySource(t *testing.T) {
+	service, mockRepo := setupServiceWithMock()
+	ctx := context.Background()
+
+	mockRepo.GetRecentDisruptionsFn = func(ctx context.Context, filter IncidentFilter, limit int32) ([]IncidentWithDetails, error) {
+		assert.Equal(t, "manual", filter.Source)
+		return []IncidentWithDetails{
+			{
+				ID:          uuid.New(),
+				LineName:    "Test Line",
+				StationName: "Test Station",
+				Timestamp:   time.Now(),
+				Source:      ptr("manual"),
+			},
+		}, nil
+	}
+
+	resp, err := service.GetRecentDisruptions(ctx, &pb.RecentDisruptionsRequest{Source: "manual"})
+
+	require.NoError(t, err)
+	require.Len(t, resp.Items, 1)
+	assert.Equal(t, "manual", resp.Items[0].Source)
+}
+
+func TestGetRecentDisruptions_MultipleLines(t *testing.T) {
+	service, mockRepo := setupServiceWithMock()
+	ctx := context.Background()
+
+	mockRepo.GetRecentDisruptionsFn = func(ctx context.Context, filter IncidentFilter, limit int32) ([]IncidentWithDetails, error) {
+		assert.Equal(t, []string{"Circle Line", "Downtown Line"}, filter.LineNames)
+		return nil, nil
+	}
+
+	_, err := service.GetRecentDisruptions(ctx, &pb.RecentDisruptionsRequest{
+		Line:  "North South Line",
+		Lines: []string{" Circle Line", "Downtown Line "},
+	})
+
+	require.NoError(t, err)
+}
+
+func TestGetRecentDisruptions_BlankLineInList(t *testing.T) {
+	service, _ := setupServiceWithMock()
+
+	_, err := service.GetRecentDisruptions(context.Background(), &pb.RecentDisruptionsRequest{
+		Lines: []string{"Circle Line", "  "},
+	})
+
+	require.Error(t, err)
+	st, ok := status.FromError(err)
+	require.True(t, ok)
+	assert.Equal(t, codes.InvalidArgument, st.Code())
+	require.Len(t, st.Details(), 1)
+	badRequest, ok := st.Details()[0].(*errdetails.BadRequest)
+	require.True(t, ok)
+	require.Len(t, badRequest.FieldViolations, 1)
+	assert.Equal(t, "lines[1]", badRequest.FieldViolations[0].Field)
+}
+
+func TestGetRecentDisruptions_Sort(t *testing.T) {
+	service, mockRepo := setupServiceWithMock()
+	ctx := context.Background()
+
+	var got DisruptionSort
+	mockRepo.GetRecentDisruptionsFn = func(ctx context.Context, filter IncidentFilter, limit int32) ([]IncidentWithDetails, error) {
+		got = filter.Sort
+		return nil, nil
+	}
+
+	_, err := service.GetRecentDisruptions(ctx, &pb.RecentDisruptionsRequest{SortBy: " Duration", SortOrder: "ASC"})
+	require.NoError(t, err)
+	assert.Equal(t, DisruptionSort{By: DisruptionSortDuration, Ascending: true}, got)
+
+	_, err = service.GetRecentDisruptions(ctx, &pb.RecentDisruptionsRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, DisruptionSort{}, got)
+}
+
+func TestGetRecentDisruptions_InvalidSort(t *testing.T) {
+	service, _ := setupServiceWithMock()
+
+	tests := []struct {
+		name  string
+		req   *pb.RecentDisruptionsRequest
+		field string
+	}{
+		{"unknown sort_by", &pb.RecentDisruptionsRequest{SortBy: "station"}, "sort_by"},
+		{"unknown sort_order", &pb.RecentDisruptionsRequest{SortOrder: "newest"}, "sort_order"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := service.GetRecentDisruptions(context.Background(), tt.req)
+
+			require.Error(t, err)
+			assert.Nil(t, resp)
+			st, ok := status.FromError(err)
+			require.True(t, ok)
+			assert.Equal(t, codes.InvalidArgument, st.Code())
+			require.Len(t, st.Details(), 1)
+			badRequest, ok := st.Details()[0].(*errdetails.BadRequest)
+			require.True(t, ok)
+			assert.Equal(t, tt.field, badRequest.FieldViolations[0].Field)
+		})
+	}
+}
+
+func TestReassignIncident_Success(t *testing.T) {
+	service, mockRepo := setupServiceWithMock()
+	ctx := context.Background()
+
+	incidentID := uuid.New()
+	lineID := uuid.New()
+
+	mockRepo.ReassignIncidentFn = func(ctx context.Context, id, lID uuid.UUID) (*IncidentWithDetails, error) {
+		assert.Equal(t, incidentID, id)
+		assert.Equal(t, lineID, lID)
+		return &IncidentWithDetails{ID: id, LineID: lID, LineName: "Circle Line", StationName: "Bishan"}, nil
+	}
+
+	resp, err := service.ReassignIncident(ctx, &pb.ReassignIncidentRequest{Id: incidentID.String(), LineId: lineID.String()})
+
+	require.NoError(t, err)
+	assert.Equal(t, lineID.String(), resp.LineId)
+	assert.Equal(t, "Circle Line", resp.Line)
+}
+
+func TestReassignIncident_Errors(t *testing.T) {
+	tests := []struct {
+		name     string
+		id       string
+		lineID   string
+		repoErr  error
+		wantCode codes.Code
+	}{
+		{"invalid incident ID", "bad", uuid.New().String(), nil, codes.InvalidArgument},
+		{"invalid line ID", uuid.New().String(), "bad", nil, codes.InvalidArgument},
+		{"incident not found", uuid.New().String(), uuid.New().String(), ErrNotFound, codes.NotFound},
+		{"line not found", uuid.New().String(), uuid.New().String(), ErrLineNotFound, codes.NotFound},
+		{"station not on line", uuid.New().String(), uuid.New().String(), ErrStationLineMismatch, codes.FailedPrecondition},
+		{"duplicate", uuid.New().String(), uuid.New().String(), ErrAlreadyExists, codes.AlreadyExists},
+		{"database error", uuid.New().String(), uuid.New().String(), ErrDatabaseError, codes.Internal},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, mockRepo := setupServiceWithMock()
+			mockRepo.ReassignIncidentFn = func(ctx context.Context, id, lineID uuid.UUID) (*IncidentWithDetails, error) {
+				return nil, tt.repoErr
+			}
+
+			resp, err := service.ReassignIncident(context.Background(), &pb.ReassignIncidentRequest{Id: tt.id, LineId: tt.lineID})
+
+			require.Error(t, err)
+			assert.Nil(t, resp)
+			assert.Equal(t, tt.wantCode, status.Code(err))
+		})
+	}
+}
+
+func TestUpdateIncidentStatus_Success(t *testing.T) {
+	service, mockRepo := setupServiceWithMock()
+	ctx := context.Background()
+
+	incidentID := uuid.New()
+
+	mockRepo.UpdateIncidentStatusFn = func(ctx context.Context, id uuid.UUID, status, action string) (*IncidentWithDetails, error) {
+		assert.Equal(t, incidentID, id)
+		assert.Equal(t, "resolved", status)
+		return &IncidentWithDetails{
+			ID:           incidentID,
+			Timestamp:    time.Now(),
+			IncidentType: "power",
+			Status:       status,
+			LineName:     "Test Line",
+			StationName:  "Test Station",
+		}, nil
+	}
+
+	req := &pb.UpdateIncidentStatusRequest{Id: incidentID.String(), Status: " resolved "}
+	resp, err := service.UpdateIncidentStatus(ctx, req)
+
+	require.NoError(t, err)
+	assert.Equal(t, incidentID.String(), resp.Id)
+	assert.Equal(t, "resolved", resp.Status)
+	assert.Equal(t, "Test Line", resp.Line)
+}
+
+func TestUpdateIncidentStatus_InvalidStatus(t *testing.T) {
+	service, _ := setupServiceWithMock()
+	ctx := context.Background()
+
+	for _, statusVal := range []string{"", "closed", "bogus"} {
+		req := &pb.UpdateIncidentStatusRequest{Id: uuid.New().String(), Status: statusVal}
+		resp, err := service.UpdateIncidentStatus(ctx, req)
+
+		require.Error(t, err, "status %q", statusVal)
+		assert.Nil(t, resp)
+		st, ok := status.FromError(err)
+		require.True(t, ok)
+		assert.Equal(t, codes.InvalidArgument, st.Code())
+	}
+}
+
+func TestUpdateIncidentStatus_ConfiguredStatuses(t *testing.T) {
+	service, mockRepo := setupServiceWithMock()
+	service.cfg.IncidentStatuses = []string{"open", "closed"}
+	ctx := context.Background()
+
+	mockRepo.UpdateIncidentStatusFn = func(ctx context.Context, id uuid.UUID, status, action string) (*IncidentWithDetails, error) {
+		return &IncidentWithDetails{ID: id, Status: status}, nil
+	}
+
+	resp, err := service.UpdateIncidentStatus(ctx, &pb.UpdateIncidentStatusRequest{Id: uuid.New().String(), Status: "closed"})
+	require.NoError(t, err)
+	assert.Equal(t, "closed", resp.Status)
+
+	_, err = service.UpdateIncidentStatus(ctx, &pb.UpdateIncidentStatusRequest{Id: uuid.New().String(), Status: "resolved"})
+	require.Error(t, err)
+	st, ok := status.FromError(err)
+	require.True(t, ok)
+	assert.Equal(t, codes.InvalidArgument, st.Code())
+}
+
+func TestUpdateIncidentStatus_NotFound(t *testing.T) {
+	service, mockRepo := setupServiceWithMock()
+	ctx := context.Background()
+
+	mockRepo.UpdateIncidentStatusFn = func(ctx context.Context, id uuid.UUID, status, action string) (*IncidentWithDetails, error) {
+		return nil, ErrNotFound
+	}
+
+	resp, err := service.UpdateIncidentStatus(ctx, &pb.UpdateIncidentStatusRequest{Id: uuid.New().String(), Status: "resolved"})
+
+	require.Error(t, err)
+	assert.Nil(t, resp)
+	st, ok := status.FromError(err)
+	require.True(t, ok)
+	assert.Equal(t, codes.NotFound, st.Code())
+}
+
+func TestGetSegmentIncidents_Success(t *testing.T) {
+	service, mockRepo := setupServiceWithMock()
+	ctx := context.Background()
+
+	mockRepo.GetSegmentIncidentCountsFn = func(ctx context.Context, lineName string, stationNames []string, start, end *time.Time, includeDeleted bool) ([]BreakdownCount, error) {
+		assert.Equal(t, "Circle Line", lineName)
+		assert.Equal(t, []string{"Bishan", "Marymount", "Caldecott"}, stationNames)
+		return []BreakdownCount{
+			{Name: "Caldecott", Count: 1},
+			{Name: "Bishan", Count: 4},
+			{Name: "Marymount", Count: 0},
+		}, nil
+	}
+
+	req := &pb.SegmentIncidentsRequest{
+		Line:     "Circle Line",
+		Stations: []string{"Bishan", " Marymount ", "Caldecott", "Bishan"},
+	}
+	resp, err := service.GetSegmentIncidents(ctx, req)
+
+	require.NoError(t, err)
+	assert.Equal(t, "Circle Line", resp.Line)
+	require.Len(t, resp.Stations, 3)
+	assert.Equal(t, "Bishan", resp.Stations[0].Station)
+	assert.Equal(t, int32(4), resp.Stations[0].IncidentCount)
+	assert.Equal(t, "Caldecott", resp.Stations[2].Station)
+	assert.Equal(t, int32(5), resp.TotalIncidents)
+}
+
+func TestGetSegmentIncidents_ValidationErrors(t *testing.T) {
+	service, _ := setupServiceWithMock()
+	ctx := context.Background()
+
+	now := time.Now()
+	tests := []struct {
+		name string
+		req  *pb.SegmentIncidentsRequest
+	}{
+		{"empty line", &pb.SegmentIncidentsRequest{Stations: []string{"Bishan"}}},
+		{"no stations", &pb.SegmentIncidentsRequest{Line: "Circle Line"}},
+		{"blank station", &pb.SegmentIncidentsRequest{Line: "Circle Line", Stations: []string{" "}}},
+		{"inverted range", &pb.SegmentIncidentsRequest{
+			Line:      "Circle Line",
+			Stations:  []string{"Bishan"},
+			StartTime: timestamppb.New(now),
+			EndTime:   timestamppb.New(now.Add(-time.Hour)),
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := service.GetSegmentIncidents(ctx, tt.req)
+
+			require.Error(t, err)
+			assert.Nil(t, resp)
+			st, ok := status.FromError(err)
+			require.True(t, ok)
+			assert.Equal(t, codes.InvalidArgument, st.Code())
+		})
+	}
+}
+
+func TestGetSegmentIncidents_UnknownStation(t *testing.T) {
+	service, mockRepo := setupServiceWithMock()
+	ctx := context.Background()
+
+	mockRepo.GetSegmentIncidentCountsFn = func(ctx context.Context, lineName string, stationNames []string, start, end *time.Time, includeDeleted bool) ([]BreakdownCount, error) {
+		return []BreakdownCount{{Name: "Bishan", Count: 2}}, nil
+	}
+
+	req := &pb.SegmentIncidentsRequest{Line: "Circle Line", Stations: []string{"Bishan", "Atlantis"}}
+	resp, err := service.GetSegmentIncidents(ctx, req)
+
+	require.Error(t, err)
+	assert.Nil(t, resp)
+	st, ok := status.FromError(err)
+	require.True(t, ok)
+	assert.Equal(t, codes.NotFound, st.Code())
+}
+
+func TestGetRollingIncidentAverage_Success(t *testing.T) {
+	service, mockRepo := setupServiceWithMock()
+	ctx := context.Background()
+
+	start := time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2025, 3, 2, 0, 0, 0, 0, time.UTC)
+
+	mockRepo.GetRollingIncidentAverageFn = func(ctx context.Context, lineName string, s, e time.Time, includeDeleted bool) ([]DailyRollingAverage, error) {
+		assert.Equal(t, "Circle Line", lineName)
+		assert.Equal(t, start, s)
+		assert.Equal(t, end, e)
+		return []DailyRollingAverage{
+			{LineName: "Circle Line", Day: start, Count: 3, RollingAverage: 1.43},
+			{LineName: "Circle Line", Day: end, Count: 0, RollingAverage: 1.29},
+		}, nil
+	}
+
+	req := &pb.RollingAverageRequest{
+		Line:      " Circle Line ",
+		StartTime: timestamppb.New(start),
+		EndTime:   timestamppb.New(end),
+	}
+	resp, err := service.GetRollingIncidentAverage(ctx, req)
+
+	require.NoError(t, err)
+	require.Len(t, resp.Items, 2)
+	assert.Equal(t, int32(3), resp.Items[0].Count)
+	assert.Equal(t, 1.43, resp.Items[0].RollingAverage)
+	assert.Equal(t, int32(0), resp.Items[1].Count)
+}
+
+func TestGetRollingIncidentAverage_DefaultRange(t *testing.T) {
+	service, mockRepo := setupServiceWithMock()
+	ctx := context.Background()
+
+	mockRepo.GetRollingIncidentAverageFn = func(ctx context.Context, lineName string, start, end time.Time, includeDeleted bool) ([]DailyRollingAverage, error) {
+		assert.Equal(t, "", lineName)
+		assert.WithinDuration(t, time.Now(), end, time.Minute)
+		assert.Equal(t, end.AddDate(0, 0, -30), start)
+		return nil, nil
+	}
+
+	resp, err := service.GetRollingIncidentAverage(ctx, &pb.RollingAverageRequest{})
+
+	require.NoError(t, err)
+	assert.Len(t, resp.Items, 0)
+}
+
+func TestGetRollingIncidentAverage_RangeTooLarge(t *testing.T) {
+	service, _ := setupServiceWithMock()
+	ctx := context.Background()
+
+	end := time.Now()
+	req := &pb.RollingAverageRequest{
+		StartTime: timestamppb.New(end.AddDate(-2, 0, 0)),
+		EndTime:   timestamppb.New(end),
+	}
+	resp, err := service.GetRollingIncidentAverage(ctx, req)
+
+	require.Error(t, err)
+	assert.Nil(t, resp)
+	st, ok := status.FromError(err)
+	require.True(t, ok)
+	assert.Equal(t, codes.InvalidArgument, st.Code())
+}
+
+func TestGetCumulativeIncidents_Success(t *testing.T) {
+	service, mockRepo := setupServiceWithMock()
+	ctx := context.Background()
+
+	start := time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2025, 3, 3, 0, 0, 0, 0, time.UTC)
+	lineID := uuid.New()
+
+	mockRepo.FindLineByNameFn = func(ctx context.Context, name string) (*Line, error) {
+		assert.Equal(t, "Circle Line", name)
+		return &Line{ID: lineID, Name: name}, nil
+	}
+	mockRepo.GetCumulativeIncidentsFn = func(ctx context.Context, gotLineID *uuid.UUID, s, e time.Time, includeDeleted bool) ([]DailyCumulativeCount, error) {
+		require.NotNil(t, gotLineID)
+		assert.Equal(t, lineID, *gotLineID)
+		assert.Equal(t, start, s)
+		assert.Equal(t, end, e)
+		return []DailyCumulativeCount{
+			{Day: start, Count: 2, CumulativeCount: 2},
+			{Day: start.AddDate(0, 0, 1), Count: 0, CumulativeCount: 2},
+			{Day: end, Count: 3, CumulativeCount: 5},
+		}, nil
+	}
+
+	req := &pb.CumulativeIncidentsRequest{
+		Line:      " Circle Line ",
+		StartTime: timestamppb.New(start),
+		EndTime:   timestamppb.New(end),
+	}
+	resp, err := service.GetCumulativeIncidents(ctx, req)
+
+	require.NoError(t, err)
+	assert.Equal(t, "Circle Line", resp.Line)
+	require.Len(t, resp.Items, 3)
+	assert.Equal(t, int32(0), resp.Items[1].Count)
+	assert.Equal(t, int32(2), resp.Items[1].CumulativeCount)
+	assert.Equal(t, int32(5), resp.Total)
+}
+
+func TestGetCumulativeIncidents_AllLines(t *testing.T) {
+	service, mockRepo := setupServiceWithMock()
+	ctx := context.Background()
+
+	mockRepo.GetCumulativeIncidentsFn = func(ctx context.Context, lineID *uuid.UUID, start, end time.Time, includeDeleted bool) ([]DailyCumulativeCount, error) {
+		assert.Nil(t, lineID)
+		assert.Equal(t, end.AddDate(0, 0, -30), start)
+		return nil, nil
+	}
+
+	resp, err := service.GetCumulativeIncidents(ctx, &pb.CumulativeIncidentsRequest{})
+
+	require.NoError(t, err)
+	assert.Len(t, resp.Items, 0)
+	assert.Equal(t, int32(0), resp.Total)
+}
+
+func TestGetCumulativeIncidents_Errors(t *testing.T) {
+	service, mockRepo := setupServiceWithMock()
+	ctx := context.Background()
+
+	mockRepo.FindLineByNameFn = func(ctx context.Context, name string) (*Line, error) {
+		return nil, ErrNotFound
+	}
+
+	_, err := service.GetCumulativeIncidents(ctx, &pb.CumulativeIncidentsRequest{Line: "Unknown Line"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	end := time.Now()
+	_, err = service.GetCumulativeIncidents(ctx, &pb.CumulativeIncidentsRequest{
+		StartTime: timestamppb.New(end.AddDate(-2, 0, 0)),
+		EndTime:   timestamppb.New(end),
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestUpdateIncident_Success(t *testing.T) {
+	service, mockRepo := setupServiceWithMock()
+	ctx := context.Background()
+
+	incidentID := uuid.New()
+	duration := int32(45)
+
+	mockRepo.UpdateIncidentFn = func(ctx context.Context, id uuid.UUID, durationMinutes *int32, incidentType *string) (*IncidentWithDetails, error) {
+		assert.Equal(t, incidentID, id)
+		require.NotNil(t, durationMinutes)
+		assert.Equal(t, int32(45), *durationMinutes)
+		assert.Nil(t, incidentType)
+		return &IncidentWithDetails{
+			ID:              incidentID,
+			DurationMinutes: *durationMinutes,
+			IncidentType:    "power",
+			Status:          "open",
+		}, nil
+	}
+
+	resp, err := service.UpdateIncident(ctx, &pb.UpdateIncidentRequest{Id: incidentID.String(), DurationMinutes: &duration})
+
+	require.NoError(t, err)
+	assert.Equal(t, int32(45), resp.DurationMinutes)
+	assert.Equal(t, "power", resp.IncidentType)
+}
+
+func TestUpdateIncident_Validation(t *testing.T) {
+	service, _ := setupServiceWithMock()
+	ctx := context.Background()
+
+	tooLong := int32(2000)
+	badType := "meteor"
+
+	tests := []struct {
+		name string
+		req  *pb.UpdateIncidentRequest
+	}{
+		{"invalid id", &pb.UpdateIncidentRequest{Id: "not-a-uuid", IncidentType: &badType}},
+		{"no fields", &pb.UpdateIncidentRequest{Id: uuid.New().String()}},
+		{"duration out of range", &pb.UpdateIncidentRequest{Id: uuid.New().String(), DurationMinutes: &tooLong}},
+		{"invalid type", &pb.UpdateIncidentRequest{Id: uuid.New().String(), IncidentType: &badType}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := service.UpdateIncident(ctx, tt.req)
+
+			require.Error(t, err)
+			assert.Nil(t, resp)
+			st, ok := status.FromError(err)
+			require.True(t, ok)
+			assert.Equal(t, codes.InvalidArgument, st.Code())
+		})
+	}
+}
+
+func TestResolveIncident_Success(t *testing.T) {
+	service, mockRepo := setupServiceWithMock()
+	ctx := context.Background()
+
+	incidentID := uuid.New()
+
+	mockRepo.UpdateIncidentStatusFn = func(ctx context.Context, id uuid.UUID, status, action string) (*IncidentWithDetails, error) {
+		assert.Equal(t, incidentID, id)
+		assert.Equal(t, "resolved", status)
+		assert.Equal(t, "resolve", action)
+		return &IncidentWithDetails{ID: id, Status: status}, nil
+	}
+
+	resp, err := service.ResolveIncident(ctx, &pb.ResolveIncidentRequest{Id: incidentID.String()})
+
+	require.NoError(t, err)
+	assert.Equal(t, "resolved", resp.Status)
+}
+
+func TestResolveIncident_StatusNotConfigured(t *testing.T) {
+	service, _ := setupServiceWithMock()
+	service.cfg.IncidentStatuses = []string{"open", "closed"}
+	ctx := context.Background()
+
+	resp, err := service.ResolveIncident(ctx, &pb.ResolveIncidentRequest{Id: uuid.New().String()})
+
+	require.Error(t, err)
+	assert.Nil(t, resp)
+	st, ok := status.FromError(err)
+	require.True(t, ok)
+	assert.Equal(t, codes.FailedPrecondition, st.Code())
+}
+
+func TestResolveIncident_ConfiguredResolvedStatus(t *testing.T) {
+	service, mockRepo := setupServiceWithMock()
+	service.cfg.IncidentStatuses = []string{"open", "closed"}
+	service.cfg.ResolvedStatus = "closed"
+	ctx := context.Background()
+
+	var gotStatus string
+	mockRepo.UpdateIncidentStatusFn = func(ctx context.Context, id uuid.UUID, statusVal, action string) (*IncidentWithDetails, error) {
+		gotStatus = statusVal
+		return &IncidentWithDetails{ID: id, Status: statusVal}, nil
+	}
+
+	resp, err := service.ResolveIncident(ctx, &pb.ResolveIncidentRequest{Id: uuid.New().String()})
+
+	require.NoError(t, err)
+	assert.Equal(t, "closed", gotStatus)
+	assert.Equal(t, "closed", resp.Status)
+}
+
+func TestDeleteIncident_NotFound(t *testing.T) {
+	service, mockRepo := setupServiceWithMock()
+	ctx := context.Background()
+
+	mockRepo.DeleteIncidentFn = func(ctx context.Context, id uuid.UUID) error {
+		return ErrNotFound
+	}
+
+	resp, err := service.DeleteIncident(ctx, &pb.DeleteIncidentRequest{Id: uuid.New().String()})
+
+	require.Error(t, err)
+	assert.Nil(t, resp)
+	st, ok := status.FromError(err)
+	require.True(t, ok)
+	assert.Equal(t, codes.NotFound, st.Code())
+}
+
+func TestGetIncidentHistory_Success(t *testing.T) {
+	service, mockRepo := setupServiceWithMock()
+	ctx := context.Background()
+
+	incidentID := uuid.New()
+	now := time.Now()
+
+	mockRepo.GetIncidentHistoryFn = func(ctx context.Context, id uuid.UUID) ([]IncidentAuditEntry, error) {
+		assert.Equal(t, incidentID, id)
+		return []IncidentAuditEntry{
+			{
+				ID:         uuid.New(),
+				IncidentID: incidentID,
+				Action:     "resolve",
+				OldValues:  ptr(`{"status":"open"}`),
+				NewValues:  ptr(`{"status":"resolved"}`),
+				CreatedAt:  now,
+			},
+			{
+				ID:         uuid.New(),
+				IncidentID: incidentID,
+				Action:     "delete",
+				OldValues:  ptr(`{"status":"resolved"}`),
+				CreatedAt:  now.Add(time.Minute),
+			},
+		}, nil
+	}
+
+	resp, err := service.GetIncidentHistory(ctx, &pb.GetIncidentHistoryRequest{Id: incidentID.String()})
+
+	require.NoError(t, err)
+	assert.Equal(t, incidentID.String(), resp.IncidentId)
+	require.Len(t, resp.Entries, 2)
+	assert.Equal(t, "resolve", resp.Entries[0].Action)
+	assert.Equal(t, `{"status":"resolved"}`, resp.Entries[0].NewValues)
+	assert.Equal(t, "delete", resp.Entries[1].Action)
+	assert.Empty(t, resp.Entries[1].NewValues)
+}
+
+func TestGetIncidentHistory_NotFound(t *testing.T) {
+	service, mockRepo := setupServiceWithMock()
+	ctx := context.Background()
+
+	mockRepo.GetIncidentHistoryFn = func(ctx context.Context, id uuid.UUID) ([]IncidentAuditEntry, error) {
+		return nil, nil
+	}
+	mockRepo.GetIncidentWithDetailsFn = func(ctx context.Context, incidentID uuid.UUID) (*IncidentWithDetails, error) {
+		return nil, ErrNotFound
+	}
+
+	resp, err := service.GetIncidentHistory(ctx, &pb.GetIncidentHistoryRequest{Id: uuid.New().String()})
+
+	require.Error(t, err)
+	assert.Nil(t, resp)
+	st, ok := status.FromError(err)
+	require.True(t, ok)
+	assert.Equal(t, codes.NotFound, st.Code())
+}
+
+func TestGetBusiestPeriod_Success(t *testing.T) {
+	service, mockRepo := setupServiceWithMock()
+	ctx := context.Background()
+
+	windowStart := time.Date(2025, 10, 1, 8, 0, 0, 0, time.UTC)
+
+	mockRepo.GetBusiestPeriodFn = func(ctx context.Context, lineName string, start, end time.Time, window time.Duration, includeDeleted bool) (*BusiestPeriod, error) {
+		assert.Equal(t, "East West Line", lineName)
+		assert.Equal(t, time.Hour, window)
+		return &BusiestPeriod{
+			WindowStart:          windowStart,
+			IncidentCount:        4,
+			TotalDowntimeMinutes: 95,
+		}, nil
+	}
+
+	resp, err := service.GetBusiestPeriod(ctx, &pb.BusiestPeriodRequest{Line: " East West Line "})
+
+	require.NoError(t, err)
+	assert.Equal(t, "East West Line", resp.Line)
+	assert.Equal(t, windowStart, resp.WindowStart.AsTime())
+	assert.Equal(t, windowStart.Add(time.Hour), resp.WindowEnd.AsTime())
+	assert.Equal(t, int32(4), resp.IncidentCount)
+	assert.Equal(t, int32(95), resp.TotalDowntimeMinutes)
+	assert.Equal(t, "2025-10-01T08:00:00Z", resp.WindowStartLabel)
+	assert.Equal(t, "2025-10-01T09:00:00Z", resp.WindowEndLabel)
+}
+
+func TestGetBusiestPeriod_CustomWindow(t *testing.T) {
+	service, mockRepo := setupServiceWithMock()
+	ctx := context.Background()
+
+	mockRepo.GetBusiestPeriodFn = func(ctx context.Context, lineName string, start, end time.Time, window time.Duration, includeDeleted bool) (*BusiestPeriod, error) {
+		assert.Equal(t, 15*time.Minute, window)
+		return &BusiestPeriod{WindowStart: start, IncidentCount: 1}, nil
+	}
+
+	resp, err := service.GetBusiestPeriod(ctx, &pb.BusiestPeriodRequest{Line: "Circle Line", WindowMinutes: 15})
+
+	require.NoError(t, err)
+	assert.Equal(t, 15*time.Minute, resp.WindowEnd.AsTime().Sub(resp.WindowStart.AsTime()))
+}
+
+func TestGetBusiestPeriod_NoIncidents(t *testing.T) {
+	service, mockRepo := setupServiceWithMock()
+	ctx := context.Background()
+
+	mockRepo.GetBusiestPeriodFn = func(ctx context.Context, lineName string, start, end time.Time, window time.Duration, includeDeleted bool) (*BusiestPeriod, error) {
+		return nil, ErrNotFound
+	}
+
+	resp, err := service.GetBusiestPeriod(ctx, &pb.BusiestPeriodRequest{Line: "Circle Line"})
+
+	require.Error(t, err)
+	assert.Nil(t, resp)
+	st, ok := status.FromError(err)
+	require.True(t, ok)
+	assert.Equal(t, codes.NotFound, st.Code())
+	assert.Contains(t, st.Message(), "no incidents found")
+}
+
+func TestGetBusiestPeriod_InvalidArguments(t *testing.T) {
+	service, _ := setupServiceWithMock()
+	ctx := context.Background()
+
+	now := time.Now()
+
+	tests := []struct {
+		name string
+		req  *pb.BusiestPeriodRequest
+	}{
+		{"missing line", &pb.BusiestPeriodRequest{}},
+		{"negative window", &pb.BusiestPeriodRequest{Line: "Circle Line", WindowMinutes: -5}},
+		{"window too large", &pb.BusiestPeriodRequest{Line: "Circle Line", WindowMinutes: 1441}},
+		{"end before start", &pb.BusiestPeriodRequest{
+			Line:      "Circle Line",
+			StartTime: timestamppb.New(now),
+			EndTime:   timestamppb.New(now.Add(-time.Hour)),
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := service.GetBusiestPeriod(ctx, tt.req)
+
+			require.Error(t, err)
+			assert.Nil(t, resp)
+			st, ok := status.FromError(err)
+			require.True(t, ok)
+			assert.Equal(t, codes.InvalidArgument, st.Code())
+		})
+	}
+}
+
+func TestHealthCheck_IgnoresDatabase(t *testing.T) {
+	service, mockRepo := setupServiceWithMock()
+	mockRepo.PingFn = func(ctx context.Context) error {
+		t.Error("liveness must not check the database")
+		return ErrDatabaseError
+	}
+
+	_, err := service.HealthCheck(context.Background(), nil)
+
+	require.NoError(t, err)
+}
+
+func TestReadyCheck(t *testing.T) {
+	tests := []struct {
+		name     string
+		pingErr  error
+		version  *SchemaVersion
+		wantCode codes.Code
+	}{
+		{"ready", nil, &SchemaVersion{Version: 13}, codes.OK},
+		{"ahead of binary", nil, &SchemaVersion{Version: 14}, codes.OK},
+		{"unmanaged schema", nil, nil, codes.OK},
+		{"database down", ErrDatabaseError, nil, codes.Unavailable},
+		{"migrations pending", nil, &SchemaVersion{Version: 12}, codes.Unavailable},
+		{"dirty migration", nil, &SchemaVersion{Version: 13, Dirty: true}, codes.Unavailable},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, mockRepo := setupServiceWithMock()
+			service.cfg.SchemaVersion = 13
+			mockRepo.PingFn = func(ctx context.Context) error {
+				return tt.pingErr
+			}
+			mockRepo.GetSchemaVersionFn = func(ctx context.Context) (*SchemaVersion, error) {
+				return tt.version, nil
+			}
+
+			resp, err := service.ReadyCheck(context.Background(), nil)
+
+			assert.Equal(t, tt.wantCode, status.Code(err))
+			if tt.wantCode == codes.OK {
+				assert.Contains(t, string(resp.Data), `"status":"ready"`)
+			}
+		})
+	}
+}
+
+func TestProbes_FailWhileShuttingDown(t *testing.T) {
+	service, mockRepo := setupServiceWithMock()
+	ctx := context.Background()
+	mockRepo.PingFn = func(ctx context.Context) error {
+		return nil
+	}
+
+	service.SetFailing(true)
+
+	_, err := service.HealthCheck(ctx, nil)
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+	_, err = service.ReadyCheck(ctx, nil)
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+
+	service.SetFailing(false)
+
+	_, err = service.HealthCheck(ctx, nil)
+	assert.NoError(t, err)
+	_, err = service.ReadyCheck(ctx, nil)
+	assert.NoError(t, err)
+}
+
+func TestHealthCheck_AppName(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name    string
+		appName string
+		want    string
+	}{
+		{"default", "", DefaultAppName},
+		{"configured", "transport-analytics", "transport-analytics"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service := &Service{repo: &MockRepository{}, cfg: ServiceConfig{AppName: tt.appName}}
+
+			resp, err := service.HealthCheck(ctx, nil)
+			require.NoError(t, err)
+
+			var body map[string]interface{}
+			require.NoError(t, json.Unmarshal(resp.Data, &body))
+			assert.Equal(t, tt.want, body["assessment"])
+		})
+	}
+}
+
+func TestGetStationGrades_Success(t *testing.T) {
+	service, mockRepo := setupServiceWithMock()
+	ctx := context.Background()
+
+	mockRepo.GetStationIncidentAggregatesFn = func(ctx context.Context, lineName string, start, end time.Time, includeDeleted bool) ([]StationIncidentAggregate, error) {
+		assert.Equal(t, "Circle Line", lineName)
+		assert.True(t, end.After(start))
+		return []StationIncidentAggregate{
+			{StationName: "Bishan", LineName: "Circle Line", IncidentCount: 12, TotalDowntimeMinutes: 300},
+			{StationName: "Botanic Gardens", LineName: "Circle Line", IncidentCount: 1, TotalDowntimeMinutes: 20},
+			{StationName: "Caldecott", LineName: "Circle Line"},
+		}, nil
+	}
+
+	resp, err := service.GetStationGrades(ctx, &pb.StationGradesRequest{Line: " Circle Line "})
+
+	require.NoError(t, err)
+	require.Len(t, resp.Items, 3)
+	assert.Equal(t, "F", resp.Items[0].Grade)
+	assert.Equal(t, int32(12), resp.Items[0].IncidentCount)
+	assert.Equal(t, int32(300), resp.Items[0].TotalDowntimeMinutes)
+	assert.Equal(t, "B", resp.Items[1].Grade)
+	assert.Equal(t, "A", resp.Items[2].Grade)
+}
+
+func TestGetStationGrades_ConfiguredThresholds(t *testing.T) {
+	service, mockRepo := setupServiceWithMock()
+	service.cfg.GradeThresholds = []GradeThreshold{{Grade: "A", MaxIncidents: 20, MaxDowntimeMinutes: 1000}}
+	ctx := context.Background()
+
+	mockRepo.GetStationIncidentAggregatesFn = func(ctx context.Context, lineName string, start, end time.Time, includeDeleted bool) ([]StationIncidentAggregate, error) {
+		return []StationIncidentAggregate{{StationName: "Bishan", IncidentCount: 12, TotalDowntimeMinutes: 300}}, nil
+	}
+
+	resp, err := service.GetStationGrades(ctx, &pb.StationGradesRequest{})
+
+	require.NoError(t, err)
+	require.Len(t, resp.Items, 1)
+	assert.Equal(t, "A", resp.Items[0].Grade)
+}
+
+func TestGetStationGrades_RepositoryError(t *testing.T) {
+	service, mockRepo := setupServiceWithMock()
+	ctx := context.Background()
+
+	mockRepo.GetStationIncidentAggregatesFn = func(ctx context.Context, lineName string, start, end time.Time, includeDeleted bool) ([]StationIncidentAggregate, error) {
+		return nil, ErrDatabaseError
+	}
+
+	resp, err := service.GetStationGrades(ctx, &pb.StationGradesRequest{})
+
+	require.Error(t, err)
+	assert.Nil(t, resp)
+	st, ok := status.FromError(err)
+	require.True(t, ok)
+	assert.Equal(t, codes.Internal, st.Code())
+}
+
+func TestGetRecentDisruptions_DurationRange(t *testing.T) {
+	service, mockRepo := setupServiceWithMock()
+	ctx := context.Background()
+
+	minDuration := int32(30)
+	maxDuration := int32(120)
+
+	mockRepo.GetRecentDisruptionsFn = func(ctx context.Context, filter IncidentFilter, limit int32) ([]IncidentWithDetails, error) {
+		require.NotNil(t, filter.MinDuration)
+		require.NotNil(t, filter.MaxDuration)
+		assert.Equal(t, int32(30), *filter.MinDuration)
+		assert.Equal(t, int32(120), *filter.MaxDuration)
+		return []IncidentWithDetails{}, nil
+	}
+
+	resp, err := service.GetRecentDisruptions(ctx, &pb.RecentDisruptionsRequest{MinDuration: &minDuration, MaxDuration: &maxDuration})
+
+	require.NoError(t, err)
+	assert.Empty(t, resp.Items)
+}
+
+func TestGetRecentDisruptions_InvalidDurationRange(t *testing.T) {
+	service, _ := setupServiceWithMock()
+	ctx := context.Background()
+
+	tests := []struct {
+		name string
+		min  *int32
+		max  *int32
+	}{
+		{"negative min", ptr(int32(-1)), nil},
+		{"max too large", nil, ptr(int32(1441))},
+		{"min above max", ptr(int32(60)), ptr(int32(30))},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := service.GetRecentDisruptions(ctx, &pb.RecentDisruptionsRequest{MinDuration: tt.min, MaxDuration: tt.max})
+
+			require.Error(t, err)
+			assert.Nil(t, resp)
+			st, ok := status.FromError(err)
+			require.True(t, ok)
+			assert.Equal(t, codes.InvalidArgument, st.Code())
+		})
+	}
+}
+
+func TestGetRecentDisruptions_IngestedTimeField(t *testing.T) {
+	service, mockRepo := setupServiceWithMock()
+	ctx := context.Background()
+
+	start := time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+	end := start.AddDate(0, 0, 1)
+	occurred := start.AddDate(0, 0, -5)
+	ingested := start.Add(9 * time.Hour)
+
+	mockRepo.GetRecentDisruptionsFn = func(ctx context.Context, filter IncidentFilter, limit int32) ([]IncidentWithDetails, error) {
+		assert.Equal(t, TimeFieldIngested, filter.TimeField)
+		require.NotNil(t, filter.Start)
+		require.NotNil(t, filter.End)
+		assert.Equal(t, start, *filter.Start)
+		assert.Equal(t, end, *filter.End)
+		return []IncidentWithDetails{
+			{ID: uuid.New(), LineName: "Circle Line", StationName: "Bishan", Timestamp: occurred, CreatedAt: ingested},
+		}, nil
+	}
+
+	resp, err := service.GetRecentDisruptions(ctx, &pb.RecentDisruptionsRequest{
+		StartTime: timestamppb.New(start),
+		EndTime:   timestamppb.New(end),
+		TimeField: " Ingested ",
+	})
+
+	require.NoError(t, err)
+	require.Len(t, resp.Items, 1)
+	assert.Equal(t, occurred, resp.Items[0].Timestamp.AsTime())
+	assert.Equal(t, ingested, resp.Items[0].CreatedAt.AsTime())
+}
+
+func TestGetRecentDisruptions_InvalidTimeFilters(t *testing.T) {
+	service, _ := setupServiceWithMock()
+	ctx := context.Background()
+
+	now := time.Now()
+	tests := []struct {
+		name string
+		req  *pb.RecentDisruptionsRequest
+	}{
+		{"unknown time field", &pb.RecentDisruptionsRequest{TimeField: "updated"}},
+		{"end before start", &pb.RecentDisruptionsRequest{StartTime: timestamppb.New(now), EndTime: timestamppb.New(now.Add(-time.Hour))}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := service.GetRecentDisruptions(ctx, tt.req)
+
+			require.Error(t, err)
+			assert.Nil(t, resp)
+			assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		})
+	}
+}
+
+func TestGetIncidentMatrix_Success(t *testing.T) {
+	service, mockRepo := setupServiceWithMock()
+	ctx := context.Background()
+
+	mockRepo.GetIncidentTypeCountsByLineFn = func(ctx context.Context, start, end *time.Time, includeDeleted bool) ([]LineTypeCount, error) {
+		return []LineTypeCount{
+			{LineName: "Circle Line", IncidentType: ptr("power"), Count: 3},
+			{LineName: "Circle Line", IncidentType: ptr("signal"), Count: 2},
+			{LineName: "Downtown Line"},
+		}, nil
+	}
+
+	resp, err := service.GetIncidentMatrix(ctx, &pb.IncidentMatrixRequest{})
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"mechanical", "power", "signal", "weather", "other"}, resp.IncidentTypes)
+	require.Len(t, resp.Rows, 2)
+
+	circle := resp.Rows[0]
+	assert.Equal(t, "Circle Line", circle.Line)
+	assert.Equal(t, map[string]int32{"mechanical": 0, "power": 3, "signal": 2, "weather": 0, "other": 0}, circle.CountsByType)
+	assert.Equal(t, int32(5), circle.Total)
+
+	downtown := resp.Rows[1]
+	assert.Equal(t, "Downtown Line", downtown.Line)
+	assert.Len(t, downtown.CountsByType, 5)
+	assert.Equal(t, int32(0), downtown.Total)
+}
+
+func TestGetIncidentMatrix_InvalidRange(t *testing.T) {
+	service, _ := setupServiceWithMock()
+	ctx := context.Background()
+
+	now := time.Now()
+	resp, err := service.GetIncidentMatrix(ctx, &pb.IncidentMatrixRequest{
+		StartTime: timestamppb.New(now),
+		EndTime:   timestamppb.New(now.Add(-time.Hour)),
+	})
+
+	require.Error(t, err)
+	assert.Nil(t, resp)
+	st, ok := status.FromError(err)
+	require.True(t, ok)
+	assert.Equal(t, codes.InvalidArgument, st.Code())
+}
+
+func TestCreateIncident_DryRun(t *testing.T) {
+	service, mockRepo := setupServiceWithMock()
+	ctx := context.Background()
+
+	lineID := uuid.New()
+	stationID := uuid.New()
+
+	mockRepo.FindLineByNameFn = func(ctx context.Context, name string) (*Line, error) {
+		assert.Equal(t, "north south line", name)
+		return &Line{ID: lineID, Name: "North South Line"}, nil
+	}
+	mockRepo.FindStationByNameFn = func(ctx context.Context, name string, lID uuid.UUID) (*Station, error) {
+		assert.Equal(t, lineID, lID)
+		return &Station{ID: stationID, Name: name, LineID: lID}, nil
+	}
+
+	req := &pb.CreateIncidentRequest{
+		Line:            "north south line",
+		Station:         "Orchard",
+		Timestamp:       timestamppb.New(time.Now().Add(-time.Hour)),
+		DurationMinutes: 20,
+		IncidentType:    "signal",
+		DryRun:          true,
+	}
+	resp, err := service.CreateIncident(ctx, req)
+
+	require.NoError(t, err)
+	assert.True(t, resp.DryRun)
+	assert.Empty(t, resp.Id)
+	assert.Equal(t, "North South Line", resp.Line)
+	assert.Equal(t, lineID.String(), resp.LineId)
+	assert.Equal(t, stationID.String(), resp.StationId)
+	assert.Equal(t, "open", resp.Status)
+}
+
+func TestCreateIncident_DryRunNewLine(t *testing.T) {
+	service, mockRepo := setupServiceWithMock()
+	ctx := context.Background()
+
+	mockRepo.FindLineByNameFn = func(ctx context.Context, name string) (*Line, error) {
+		return nil, ErrNotFound
+	}
+
+	req := &pb.CreateIncidentRequest{
+		Line:            "Cross Island Line",
+		Station:         "Loyang",
+		Timestamp:       timestamppb.New(time.Now().Add(-time.Hour)),
+		DurationMinutes: 20,
+		IncidentType:    "power",
+		DryRun:          true,
+	}
+	resp, err := service.CreateIncident(ctx, req)
+
+	require.NoError(t, err)
+	assert.True(t, resp.DryRun)
+	assert.Empty(t, resp.LineId)
+	assert.Empty(t, resp.StationId)
+}
+
+func TestCreateIncident_DryRunValidates(t *testing.T) {
+	service, _ := setupServiceWithMock()
+	ctx := context.Background()
+
+	req := &pb.CreateIncidentRequest{
+		Line:            "North South Line",
+		Station:         "Orchard",
+		Timestamp:       timestamppb.New(time.Now().Add(-time.Hour)),
+		DurationMinutes: 20,
+		IncidentType:    "meteor",
+		DryRun:          true,
+	}
+	resp, err := service.CreateIncident(ctx, req)
+
+	require.Error(t, err)
+	assert.Nil(t, resp)
+	st, ok := status.FromError(err)
+	require.True(t, ok)
+	assert.Equal(t, codes.InvalidArgument, st.Code())
+}
+
+func TestGetTopBreakdowns_PreservesTieOrder(t *testing.T) {
+	service, mockRepo := setupServiceWithMock()
+	ctx := context.Background()
+
+	mockRepo.GetTopBreakdownsByLineFn = func(ctx context.Context, limit int32, includeDeleted bool) ([]BreakdownCount, *time.Time, error) {
+		return []BreakdownCount{
+			{Name: "North South Line", Count: 7},
+			{Name: "Circle Line", Count: 3},
+			{Name: "Downtown Line", Count: 3},
+			{Name: "East West Line", Count: 3},
+		}, nil, nil
+	}
+
+	resp, err := service.GetTopBreakdowns(ctx, &pb.TopBreakdownsRequest{Scope: "line"})
+
+	require.NoError(t, err)
+	require.Len(t, resp.Items, 4)
+	names := make([]string, len(resp.Items))
+	for i, item := range resp.Items {
+		names[i] = item.Name
+	}
+	assert.Equal(t, []string{"North South Line", "Circle Line", "Downtown Line", "East West Line"}, names)
+}
+
+func TestAnalytics_PassIncludeDeleted(t *testing.T) {
+	service, mockRepo := setupServiceWithMock()
+	ctx := context.Background()
+
+	var got []bool
+	mockRepo.GetTopBreakdownsByLineFn = func(ctx context.Context, limit int32, includeDeleted bool) ([]BreakdownCount, *time.Time, error) {
+		got = append(got, includeDeleted)
+		return nil, nil, nil
+	}
+	mockRepo.GetTopBreakdownsByStationFn = func(ctx context.Context, limit int32, lineID *uuid.UUID, includeDeleted bool) ([]BreakdownCount, *time.Time, error) {
+		got = append(got, includeDeleted)
+		return nil, nil, nil
+	}
+	mockRepo.CalculateMTBFFn = func(ctx context.Context, includeDeleted bool) ([]MTBFResult, *time.Time, error) {
+		got = append(got, includeDeleted)
+		return nil, nil, nil
+	}
+	mockRepo.GetRecentDisruptionsFn = func(ctx context.Context, filter IncidentFilter, limit int32) ([]IncidentWithDetails, error) {
+		got = append(got, filter.IncludeDeleted)
+		return nil, nil
+	}
+	for _, includeDeleted := range []bool{false, true} {
+		got = nil
+		_, err := service.GetTopBreakdowns(ctx, &pb.TopBreakdownsRequest{Scope: "line", IncludeDeleted: includeDeleted})
+		require.NoError(t, err)
+		_, err = service.GetTopBreakdowns(ctx, &pb.TopBreakdownsRequest{Scope: "station", IncludeDeleted: includeDeleted})
+		require.NoError(t, err)
+		_, err = service.GetMTBF(ctx, &pb.MTBFRequest{IncludeDeleted: includeDeleted})
+		require.NoError(t, err)
+		_, err = service.GetRecentDisruptions(ctx, &pb.RecentDisruptionsRequest{IncludeDeleted: includeDeleted})
+		require.NoError(t, err)
+
+		assert.Equal(t, []bool{includeDeleted, includeDeleted, includeDeleted, includeDeleted}, got)
+	}
+}
+
+func TestAnalytics_PassIncludeDeletedToEveryQuery(t *testing.T) {
+	service, mockRepo := setupServiceWithMock()
+	ctx := context.Background()
+
+	var got []bool
+	mockRepo.FindLineByNameFn = func(ctx context.Context, name string) (*Line, error) {
+		return &Line{ID: uuid.New(), Name: name}, nil
+	}
+	mockRepo.GetIncidentIntervalsFn = func(ctx context.Context, lineName string, start, end time.Time, includeDeleted bool) ([]IncidentInterval, error) {
+		got = append(got, includeDeleted)
+		return nil, nil
+	}
+	mockRepo.GetSegmentIncidentCountsFn = func(ctx context.Context, lineName string, stationNames []string, start, end *time.Time, includeDeleted bool) ([]BreakdownCount, error) {
+		got = append(got, includeDeleted)
+		return nil, nil
+	}
+	mockRepo.GetIncidentTypeCountsByLineFn = func(ctx context.Context, start, end *time.Time, includeDeleted bool) ([]LineTypeCount, error) {
+		got = append(got, includeDeleted)
+		return nil, nil
+	}
+	mockRepo.GetLineIncidentTypeCountsFn = func(ctx context.Context, lineID uuid.UUID, start, end time.Time, includeDeleted bool) ([]TypeCount, error) {
+		got = append(got, includeDeleted)
+		return nil, nil
+	}
+	mockRepo.GetLastIncidentTimesFn = func(ctx context.Context, lineName string, includeDeleted bool) ([]LineLastIncident, error) {
+		got = append(got, includeDeleted)
+		return nil, nil
+	}
+	mockRepo.GetTopIncidentTypeByLineFn = func(ctx context.Context, start, end time.Time, includeDeleted bool) ([]LineTypeCount, error) {
+		got = append(got, includeDeleted)
+		return nil, nil
+	}
+	mockRepo.GetIncidentStatusCountsFn = func(ctx context.Context, lineName string, start, end *time.Time, includeDeleted bool) ([]StatusCount, error) {
+		got = append(got, includeDeleted)
+		return nil, nil
+	}
+	mockRepo.GetLongestIncidentsFn = func(ctx context.Context, start, end time.Time, includeDeleted bool) ([]IncidentWithDetails, error) {
+		got = append(got, includeDeleted)
+		return nil, nil
+	}
+	mockRepo.GetConcurrentIncidentsFn = func(ctx context.Context, lineName string, start, end time.Time, limit int32, includeDeleted bool) ([]ConcurrentIncidentPair, error) {
+		got = append(got, includeDeleted)
+		return nil, nil
+	}
+	mockRepo.GetNetworkOverviewFn = func(ctx context.Context, start, end time.Time, includeDeleted bool) (*NetworkOverview, error) {
+		got = append(got, includeDeleted)
+		return &NetworkOverview{}, nil
+	}
+	mockRepo.GetDurationPercentilesFn = func(ctx context.Context, start, end time.Time, includeDeleted bool) ([]LineDurationPercentiles, error) {
+		got = append(got, includeDeleted)
+		return nil, nil
+	}
+	mockRepo.GetLongestQuietPeriodsFn = func(ctx context.Context, start, end time.Time, includeDeleted bool) ([]LineQuietPeriod, error) {
+		got = append(got, includeDeleted)
+		return nil, nil
+	}
+	mockRepo.GetSLABreachesFn = func(ctx context.Context, thresholdMinutes int32, start, end time.Time, includeDeleted bool) ([]LineSLABreaches, error) {
+		got = append(got, includeDeleted)
+		return nil, nil
+	}
+	mockRepo.GetIncidentTypeCountsByHourFn = func(ctx context.Context, lineName string, start, end time.Time, includeDeleted bool) ([]HourTypeCount, error) {
+		got = append(got, includeDeleted)
+		return nil, nil
+	}
+	mockRepo.GetRollingIncidentAverageFn = func(ctx context.Context, lineName string, start, end time.Time, includeDeleted bool) ([]DailyRollingAverage, error) {
+		got = append(got, includeDeleted)
+		return nil, nil
+	}
+	mockRepo.GetCumulativeIncidentsFn = func(ctx context.Context, lineID *uuid.UUID, start, end time.Time, includeDeleted bool) ([]DailyCumulativeCount, error) {
+		got = append(got, includeDeleted)
+		return nil, nil
+	}
+	mockRepo.GetBusiestPeriodFn = func(ctx context.Context, lineName string, start, end time.Time, window time.Duration, includeDeleted bool) (*BusiestPeriod, error) {
+		got = append(got, includeDeleted)
+		return nil, ErrNotFound
+	}
+	mockRepo.GetStationIncidentAggregatesFn = func(ctx context.Context, lineName string, start, end time.Time, includeDeleted bool) ([]StationIncidentAggregate, error) {
+		got = append(got, includeDeleted)
+		return nil, nil
+	}
+	mockRepo.GetIncidentsDuringMaintenanceFn = func(ctx context.Context, lineName string, start, end time.Time, includeDeleted bool) ([]StationIncidentAggregate, error) {
+		got = append(got, includeDeleted)
+		return nil, nil
+	}
+	mockRepo.GetIncidentsForMapFn = func(ctx context.Context, lineName string, start, end time.Time, includeMissingGeo bool, includeDeleted bool) ([]MapIncident, error) {
+		got = append(got, includeDeleted)
+		return nil, nil
+	}
+
+	calls := map[string]func(includeDeleted bool){
+		"weighted mtbf": func(d bool) {
+			service.GetWeightedMTBF(ctx, &pb.WeightedMTBFRequest{IncludeDeleted: d})
+		},
+		"segment incidents": func(d bool) {
+			service.GetSegmentIncidents(ctx, &pb.SegmentIncidentsRequest{Line: "Circle Line", Stations: []string{"Bishan"}, IncludeDeleted: d})
+		},
+		"incident matrix": func(d bool) {
+			service.GetIncidentMatrix(ctx, &pb.IncidentMatrixRequest{IncludeDeleted: d})
+		},
+		"line type distribution": func(d bool) {
+			service.GetLineTypeDistribution(ctx, &pb.LineTypeDistributionRequest{Line: "Circle Line", IncludeDeleted: d})
+		},
+		"time since last incident": func(d bool) {
+			service.GetTimeSinceLastIncident(ctx, &pb.TimeSinceLastIncidentRequest{IncludeDeleted: d})
+		},
+		"line top incident type": func(d bool) {
+			service.GetLineTopIncidentType(ctx, &pb.LineTopIncidentTypeRequest{IncludeDeleted: d})
+		},
+		"status counts": func(d bool) {
+			service.GetIncidentStatusCounts(ctx, &pb.IncidentStatusCountsRequest{IncludeDeleted: d})
+		},
+		"longest incidents": func(d bool) {
+			service.GetLongestIncidents(ctx, &pb.LongestIncidentsRequest{IncludeDeleted: d})
+		},
+		"concurrent incidents": func(d bool) {
+			service.GetConcurrentIncidents(ctx, &pb.ConcurrentIncidentsRequest{IncludeDeleted: d})
+		},
+		"network overview": func(d bool) {
+			service.GetNetworkOverview(ctx, &pb.NetworkOverviewRequest{IncludeDeleted: d})
+		},
+		"duration percentiles": func(d bool) {
+			service.GetDurationPercentiles(ctx, &pb.DurationPercentilesRequest{IncludeDeleted: d})
+		},
+		"longest quiet period": func(d bool) {
+			service.GetLongestQuietPeriod(ctx, &pb.LongestQuietPeriodRequest{IncludeDeleted: d})
+		},
+		"sla breaches": func(d bool) {
+			service.GetSLABreaches(ctx, &pb.SLABreachesRequest{ThresholdMinutes: 30, IncludeDeleted: d})
+		},
+		"type by hour": func(d bool) {
+			service.GetIncidentTypeByHour(ctx, &pb.IncidentTypeByHourRequest{IncludeDeleted: d})
+		},
+		"rolling average": func(d bool) {
+			service.GetRollingIncidentAverage(ctx, &pb.RollingAverageRequest{IncludeDeleted: d})
+		},
+		"cumulative incidents": func(d bool) {
+			service.GetCumulativeIncidents(ctx, &pb.CumulativeIncidentsRequest{IncludeDeleted: d})
+		},
+		"busiest period": func(d bool) {
+			service.GetBusiestPeriod(ctx, &pb.BusiestPeriodRequest{Line: "Circle Line", IncludeDeleted: d})
+		},
+		"station grades": func(d bool) {
+			service.GetStationGrades(ctx, &pb.StationGradesRequest{IncludeDeleted: d})
+		},
+		"during maintenance": func(d bool) {
+			service.GetIncidentsDuringMaintenance(ctx, &pb.IncidentsDuringMaintenanceRequest{IncludeDeleted: d})
+		},
+		"incident map": func(d bool) {
+			service.GetIncidentsForMap(ctx, &pb.IncidentMapRequest{IncludeDeleted: d})
+		},
+	}
+
+	for name, call := range calls {
+		for _, includeDeleted := range []bool{false, true} {
+			t.Run(fmt.Sprintf("%s/%v", name, includeDeleted), func(t *testing.T) {
+				got = nil
+				call(includeDeleted)
+				require.NotEmpty(t, got, "repository was not queried")
+				for _, d := range got {
+					assert.Equal(t, includeDeleted, d)
+				}
+			})
+		}
+	}
+}
+
+func TestGetTopBreakdowns_DataAsOf(t *testing.T) {
+	service, mockRepo := setupServiceWithMock()
+	ctx := context.Background()
+
+	latest := time.Date(2024, 3, 1, 8, 30, 0, 0, time.UTC)
+	mockRepo.GetTopBreakdownsByStationFn = func(ctx context.Context, limit int32, lineID *uuid.UUID, includeDeleted bool) ([]BreakdownCount, *time.Time, error) {
+		return []BreakdownCount{{Name: "Tampines", Count: 4}}, &latest, nil
+	}
+
+	resp, err := service.GetTopBreakdowns(ctx, &pb.TopBreakdownsRequest{Scope: "station"})
+
+	require.NoError(t, err)
+	require.NotNil(t, resp.DataAsOf)
+	assert.Equal(t, latest, resp.DataAsOf.AsTime())
+}
+
+func TestGetTopBreakdowns_LineFilter(t *testing.T) {
+	service, mockRepo := setupServiceWithMock()
+	ctx := context.Background()
+
+	lineID := uuid.New()
+	mockRepo.FindLineByNameFn = func(ctx context.Context, name string) (*Line, error) {
+		if name == "Circle Line" {
+			return &Line{ID: lineID, Name: name}, nil
+		}
+		return nil, ErrNotFound
+	}
+	mockRepo.GetTopBreakdownsByStationFn = func(ctx context.Context, limit int32, gotLineID *uuid.UUID, includeDeleted bool) ([]BreakdownCount, *time.Time, error) {
+		require.NotNil(t, gotLineID)
+		assert.Equal(t, lineID, *gotLineID)
+		return []BreakdownCount{{Name: "Bishan", Count: 4}}, nil, nil
+	}
+
+	resp, err := service.GetTopBreakdowns(ctx, &pb.TopBreakdownsRequest{Scope: "station", Line: " Circle Line "})
+	require.NoError(t, err)
+	require.Len(t, resp.Items, 1)
+	assert.Equal(t, "Bishan", resp.Items[0].Name)
+
+	_, err = service.GetTopBreakdowns(ctx, &pb.TopBreakdownsRequest{Scope: "station", Line: "Unknown Line"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	_, err = service.GetTopBreakdowns(ctx, &pb.TopBreakdownsRequest{Scope: "line", Line: "Circle Line"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestGetMTBF_DataAsOf(t *testing.T) {
+	service, mockRepo := setupServiceWithMock()
+	ctx := context.Background()
+
+	latest := time.Date(2024, 3, 1, 8, 30, 0, 0, time.UTC)
+	mockRepo.CalculateMTBFFn = func(ctx context.Context, includeDeleted bool) ([]MTBFResult, *time.Time, error) {
+		return []MTBFResult{{LineName: "Circle Line", MTBFMinutes: 120}}, &latest, nil
+	}
+
+	resp, err := service.GetMTBF(ctx, &pb.MTBFRequest{})
+
+	require.NoError(t, err)
+	require.NotNil(t, resp.DataAsOf)
+	assert.Equal(t, latest, resp.DataAsOf.AsTime())
+
+	mockRepo.CalculateMTBFFn = func(ctx context.Context, includeDeleted bool) ([]MTBFResult, *time.Time, error) {
+		return nil, nil, nil
+	}
+
+	resp, err = service.GetMTBF(ctx, &pb.MTBFRequest{})
+
+	require.NoError(t, err)
+	assert.Nil(t, resp.DataAsOf)
+}
+
+func TestCreateIncident_ReportsCreatedEntities(t *testing.T) {
+	service, mockRepo := setupServiceWithMock()
+	ctx := context.Background()
+
+	mockRepo.GetOrCreateLineFn = func(ctx context.Context, name string) (*Line, bool, error) {
+		return &Line{ID: uuid.New(), Name: name}, false, nil
+	}
+	mockRepo.GetOrCreateStationFn = func(ctx context.Context, name string, lID uuid.UUID) (*Station, bool, error) {
+		return &Station{ID: uuid.New(), Name: name, LineID: lID}, true, nil
+	}
+	mockRepo.CreateIncidentFn = func(ctx context.Context, sID, lID uuid.UUID, ts time.Time, durationMinutes int32, incidentType, status, source string) (*Incident, error) {
+		return &Incident{ID: uuid.New(), StationID: sID, LineID: lID, Timestamp: ts, Status: status}, nil
+	}
+
+	req := &pb.CreateIncidentRequest{
+		Line:            "North South Line",
+		Station:         "Orchrad",
+		Timestamp:       timestamppb.New(time.Now().Add(-time.Hour)),
+		DurationMinutes: 10,
+		IncidentType:    "signal",
+	}
+	resp, err := service.CreateIncident(ctx, req)
+
+	require.NoError(t, err)
+	assert.False(t, resp.LineCreated)
+	assert.True(t, resp.StationCreated)
+}
+
+func TestGetRecentDisruptions_OnlyActive(t *testing.T) {
+	service, mockRepo := setupServiceWithMock()
+	ctx := context.Background()
+
+	var got IncidentFilter
+	mockRepo.GetRecentDisruptionsFn = func(ctx context.Context, filter IncidentFilter, limit int32) ([]IncidentWithDetails, error) {
+		got = filter
+		return []IncidentWithDetails{}, nil
+	}
+
+	_, err := service.GetRecentDisruptions(ctx, &pb.RecentDisruptionsRequest{})
+	require.NoError(t, err)
+	assert.False(t, got.OnlyActive)
+
+	_, err = service.GetRecentDisruptions(ctx, &pb.RecentDisruptionsRequest{OnlyActive: true})
+	require.NoError(t, err)
+	assert.True(t, got.OnlyActive)
+	assert.Equal(t, "resolved", got.ResolvedStatus)
+
+	service.cfg.IncidentStatuses = []string{"open", "closed"}
+	service.cfg.ResolvedStatus = "closed"
+	_, err = service.GetRecentDisruptions(ctx, &pb.RecentDisruptionsRequest{OnlyActive: true})
+	require.NoError(t, err)
+	assert.Equal(t, "closed", got.ResolvedStatus)
+}
+
+func TestCreateLine_ColorAndDisplayOrder(t *testing.T) {
+	service, mockRepo := setupServiceWithMock()
+	ctx := context.Background()
+
+	color := "#D42E12"
+	order := int32(1)
+
+	mockRepo.CreateLineFn = func(ctx context.Context, name string, attrs LineAttributes) (*Line, error) {
+		require.NotNil(t, attrs.Color)
+		require.NotNil(t, attrs.DisplayOrder)
+		return &Line{
+			ID:           uuid.New(),
+			Name:         name,
+			Color:        attrs.Color,
+			DisplayOrder: attrs.DisplayOrder,
+			CreatedAt:    time.Now(),
+		}, nil
+	}
+
+	resp, err := service.CreateLine(ctx, &pb.CreateLineRequest{Name: "North South Line", Color: &color, DisplayOrder: &order})
+
+	require.NoError(t, err)
+	assert.Equal(t, "#D42E12", resp.Color)
+	require.NotNil(t, resp.DisplayOrder)
+	assert.Equal(t, int32(1), *resp.DisplayOrder)
+}
+
+func TestCreateLine_InvalidAttributes(t *testing.T) {
+	service, _ := setupServiceWithMock()
+	ctx := context.Background()
+
+	str := func(v string) *string { return &v }
+	negative := int32(-1)
+
+	tests := []struct {
+		name string
+		req  *pb.CreateLineRequest
+	}{
+		{"named color", &pb.CreateLineRequest{Name: "Circle Line", Color: str("orange")}},
+		{"short hex", &pb.CreateLineRequest{Name: "Circle Line", Color: str("#FA0")}},
+		{"missing hash", &pb.CreateLineRequest{Name: "Circle Line", Color: str("FA9E0D")}},
+		{"negative display order", &pb.CreateLineRequest{Name: "Circle Line", DisplayOrder: &negative}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := service.CreateLine(ctx, tt.req)
+
+			require.Error(t, err)
+			assert.Nil(t, resp)
+			st, ok := status.FromError(err)
+			require.True(t, ok)
+			assert.Equal(t, codes.InvalidArgument, st.Code())
+		})
+	}
+}
+
+func TestUpdateLine_ClearColor(t *testing.T) {
+	service, mockRepo := setupServiceWithMock()
+	ctx := context.Background()
+
+	empty := ""
+
+	mockRepo.UpdateLineFn = func(ctx context.Context, id uuid.UUID, name string, attrs LineAttributes) (*Line, error) {
+		require.NotNil(t, attrs.Color)
+		assert.Empty(t, *attrs.Color)
+		assert.Nil(t, attrs.DisplayOrder)
+		return &Line{ID: id, Name: name, CreatedAt: time.Now()}, nil
+	}
+
+	resp, err := service.UpdateLine(ctx, &pb.UpdateLineRequest{Id: uuid.New().String(), Name: "Circle Line", Color: &empty})
+
+	require.NoError(t, err)
+	assert.Empty(t, resp.Color)
+	assert.Nil(t, resp.DisplayOrder)
+}
+
+func TestCreateStation_Coordinates(t *testing.T) {
+	service, mockRepo := setupServiceWithMock()
+	ctx := context.Background()
+
+	lineID := uuid.New()
+	lat, lng := 1.3329, 103.7422
+
+	mockRepo.CreateStationFn = func(ctx context.Context, name string, lID uuid.UUID, status string, additionalLineIDs []uuid.UUID, coords *StationCoordinates) (*StationWithLine, error) {
+		require.NotNil(t, coords)
+		return &StationWithLine{
+			ID:        uuid.New(),
+			Name:      name,
+			LineID:    lID,
+			LineName:  "East West Line",
+			Status:    status,
+			Latitude:  &coords.Latitude,
+			Longitude: &coords.Longitude,
+			CreatedAt: time.Now(),
+		}, nil
+	}
+
+	resp, err := service.CreateStation(ctx, &pb.CreateStationRequest{
+		Name:      "Jurong East",
+		LineId:    lineID.String(),
+		Latitude:  &lat,
+		Longitude: &lng,
+	})
+
+	require.NoError(t, err)
+	require.NotNil(t, resp.Latitude)
+	require.NotNil(t, resp.Longitude)
+	assert.Equal(t, lat, *resp.Latitude)
+	assert.Equal(t, lng, *resp.Longitude)
+}
+
+func TestCreateStation_InvalidCoordinates(t *testing.T) {
+	service, _ := setupServiceWithMock()
+	ctx := context.Background()
+
+	f := func(v float64) *float64 { return &v }
+
+	tests := []struct {
+		name      string
+		latitude  *float64
+		longitude *float64
+	}{
+		{"latitude only", f(1.3), nil},
+		{"longitude only", nil, f(103.8)},
+		{"latitude out of range", f(90.5), f(103.8)},
+		{"longitude out of range", f(1.3), f(-180.1)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := service.CreateStation(ctx, &pb.CreateStationRequest{
+				Name:      "Jurong East",
+				LineId:    uuid.New().String(),
+				Latitude:  tt.latitude,
+				Longitude: tt.longitude,
+			})
+
+			require.Error(t, err)
+			assert.Nil(t, resp)
+			st, ok := status.FromError(err)
+			require.True(t, ok)
+			assert.Equal(t, codes.InvalidArgument, st.Code())
+		})
+	}
+}
+
+func TestUpdateStation_CoordinatesOnly(t *testing.T) {
+	service, mockRepo := setupServiceWithMock()
+	ctx := context.Background()
+
+	lat, lng := 1.3521, 103.8198
+
+	mockRepo.UpdateStationFn = func(ctx context.Context, id uuid.UUID, name, status *string, coords *StationCoordinates) (*StationWithLine, error) {
+		assert.Nil(t, name)
+		assert.Nil(t, status)
+		require.NotNil(t, coords)
+		assert.Equal(t, lat, coords.Latitude)
+		return &StationWithLine{ID: id, Name: "Tampines", Status: "active", CreatedAt: time.Now()}, nil
+	}
+
+	_, err := service.UpdateStation(ctx, &pb.UpdateStationRequest{Id: uuid.New().String(), Latitude: &lat, Longitude: &lng})
+
+	require.NoError(t, err)
+}
+
+func TestGetIncidentsForMap_Success(t *testing.T) {
+	service, mockRepo := setupServiceWithMock()
+	ctx := context.Background()
+
+	mockRepo.GetIncidentsForMapFn = func(ctx context.Context, lineName string, start, end time.Time, includeMissingGeo bool, includeDeleted bool) ([]MapIncident, error) {
+		assert.Equal(t, "East West Line", lineName)
+		assert.True(t, includeMissingGeo)
+		assert.WithinDuration(t, end.AddDate(0, 0, -30), start, time.Second)
+		return []MapIncident{
+			{
+				ID:              uuid.New(),
+				LineName:        "East West Line",
+				StationName:     "Tampines",
+				Timestamp:       time.Now(),
+				DurationMinutes: 15,
+				IncidentType:    "signal",
+				Status:          "resolved",
+				Latitude:        ptr(1.3533),
+				Longitude:       ptr(103.9452),
+			},
+			{
+				ID:              uuid.New(),
+				LineName:        "East West Line",
+				StationName:     "Pasir Ris",
+				Timestamp:       time.Now(),
+				DurationMinutes: 5,
+				IncidentType:    "power",
+				Status:          "reported",
+			},
+		}, nil
+	}
+
+	resp, err := service.GetIncidentsForMap(ctx, &pb.IncidentMapRequest{Line: "East West Line", IncludeMissingGeo: true})
+
+	require.NoError(t, err)
+	require.Len(t, resp.Items, 2)
+	assert.True(t, resp.Items[0].HasLocation)
+	assert.Equal(t, 1.3533, resp.Items[0].Latitude)
+	assert.False(t, resp.Items[1].HasLocation)
+	assert.Zero(t, resp.Items[1].Latitude)
+}
+
+func TestGetIncidentsForMap_RangeTooLarge(t *testing.T) {
+	service, _ := setupServiceWithMock()
+	ctx := context.Background()
+
+	now := time.Now()
+	resp, err := service.GetIncidentsForMap(ctx, &pb.IncidentMapRequest{
+		StartTime: timestamppb.New(now.AddDate(-2, 0, 0)),
+		EndTime:   timestamppb.New(now),
+	})
+
+	require.Error(t, err)
+	assert.Nil(t, resp)
+	st, ok := status.FromError(err)
+	require.True(t, ok)
+	assert.Equal(t, codes.InvalidArgument, st.Code())
+}
+
+func TestCreateIncident_ReturnsCreatedAt(t *testing.T) {
+	service, mockRepo := setupServiceWithMock()
+	ctx := context.Background()
+
+	ts := time.Now().Add(-2 * time.Hour)
+	firstSeen := time.Now().Add(-time.Hour).UTC().Truncate(time.Second)
+
+	mockRepo.GetOrCreateLineFn = func(ctx context.Context, name string) (*Line, bool, error) {
+		return &Line{ID: uuid.New(), Name: name}, false, nil
+	}
+	mockRepo.GetOrCreateStationFn = func(ctx context.Context, name string, lID uuid.UUID) (*Station, bool, error) {
+		return &Station{ID: uuid.New(), Name: name, LineID: lID}, false, nil
+	}
+	mockRepo.CreateIncidentFn = func(ctx context.Context, sID, lID uuid.UUID, ts time.Time, durationMinutes int32, incidentType, status, source string) (*Incident, error) {
+		return &Incident{
+			ID:           uuid.New(),
+			Timestamp:    ts,
+			IncidentType: incidentType,
+			Status:       status,
+			CreatedAt:    firstSeen,
+		}, nil
+	}
+
+	resp, err := service.CreateIncident(ctx, &pb.CreateIncidentRequest{
+		Line:            "Test Line",
+		Station:         "Test Station",
+		Timestamp:       timestamppb.New(ts),
+		DurationMinutes: 15,
+		IncidentType:    "signal",
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, firstSeen, resp.CreatedAt.AsTime())
+	assert.Equal(t, ts.UTC(), resp.Timestamp.AsTime())
+}
+
+func TestUpdateIncidentStatus_ReturnsCreatedAt(t *testing.T) {
+	service, mockRepo := setupServiceWithMock()
+	ctx := context.Background()
+
+	createdAt := time.Now().Add(-24 * time.Hour).UTC().Truncate(time.Second)
+
+	mockRepo.UpdateIncidentStatusFn = func(ctx context.Context, id uuid.UUID, status, action string) (*IncidentWithDetails, error) {
+		return &IncidentWithDetails{
+			ID:        id,
+			Timestamp: createdAt.Add(-time.Hour),
+			Status:    status,
+			CreatedAt: createdAt,
+		}, nil
+	}
+
+	resp, err := service.UpdateIncidentStatus(ctx, &pb.UpdateIncidentStatusRequest{Id: uuid.New().String(), Status: "resolved"})
+
+	require.NoError(t, err)
+	assert.Equal(t, createdAt, resp.CreatedAt.AsTime())
+}
+
+func TestFindOrphanedIncidents_Success(t *testing.T) {
+	service, mockRepo := setupServiceWithMock()
+	ctx := context.Background()
+
+	orphanID := uuid.New()
+
+	mockRepo.FindOrphanedIncidentsFn = func(ctx context.Context, limit int32) ([]OrphanedIncident, error) {
+		assert.Equal(t, int32(100), limit)
+		return []OrphanedIncident{
+			{
+				ID:             orphanID,
+				StationID:      uuid.New(),
+				LineID:         uuid.New(),
+				Timestamp:      time.Now(),
+				IncidentType:   "power",
+				Status:         "open",
+				MissingStation: true,
+			},
+		}, nil
+	}
+
+	resp, err := service.FindOrphanedIncidents(ctx, &pb.OrphanedIncidentsRequest{})
+
+	require.NoError(t, err)
+	require.Len(t, resp.Items, 1)
+	assert.Equal(t, orphanID.String(), resp.Items[0].Id)
+	assert.True(t, resp.Items[0].MissingStation)
+	assert.False(t, resp.Items[0].MissingLine)
+}
+
+func TestFindOrphanedIncidents_LimitCapped(t *testing.T) {
+	service, mockRepo := setupServiceWithMock()
+	ctx := context.Background()
+
+	mockRepo.FindOrphanedIncidentsFn = func(ctx context.Context, limit int32) ([]OrphanedIncident, error) {
+		assert.Equal(t, int32(1000), limit)
+		return nil, nil
+	}
+
+	resp, err := service.FindOrphanedIncidents(ctx, &pb.OrphanedIncidentsRequest{Limit: 5000})
+
+	require.NoError(t, err)
+	assert.Empty(t, resp.Items)
+}
+
+func TestFindOrphanedIncidents_StrictLimit(t *testing.T) {
+	service, _ := setupServiceWithMock()
+
+	resp, err := service.FindOrphanedIncidents(context.Background(), &pb.OrphanedIncidentsRequest{Limit: 5000, StrictLimit: true})
+
+	require.Error(t, err)
+	assert.Nil(t, resp)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	assert.Contains(t, status.Convert(err).Message(), "limit must not exceed 1000")
+}
+
+func TestListLimit(t *testing.T) {
+	tests := []struct {
+		name      string
+		maxList   int32
+		limit     int32
+		strict    bool
+		want      int32
+		wantError bool
+	}{
+		{"default", 0, 0, false, 20, false},
+		{"within max", 0, 50, true, 50, false},
+		{"clamped to endpoint max", 0, 10_000_000, false, 100, false},
+		{"strict over endpoint max", 0, 101, true, 0, true},
+		{"configured max below endpoint max", 30, 50, false, 30, false},
+		{"configured max below default", 10, 0, true, 10, false},
+		{"strict over configured max", 30, 31, true, 0, true},
+		{"configured max above endpoint max", 500, 200, false, 100, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service := &Service{cfg: ServiceConfig{MaxListLimit: tt.maxList}}
+
+			got, err := service.listLimit(tt.limit, 20, 100, tt.strict)
+
+			if tt.wantError {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestFindOrphanedIncidents_DatabaseError(t *testing.T) {
+	service, mockRepo := setupServiceWithMock()
+	ctx := context.Background()
+
+	mockRepo.FindOrphanedIncidentsFn = func(ctx context.Context, limit int32) ([]OrphanedIncident, error) {
+		return nil, ErrDatabaseError
+	}
+
+	resp, err := service.FindOrphanedIncidents(ctx, &pb.OrphanedIncidentsRequest{})
+
+	require.Error(t, err)
+	assert.Nil(t, resp)
+	st, ok := status.FromError(err)
+	require.True(t, ok)
+	assert.Equal(t, codes.Internal, st.Code())
+}
+
+func TestGetWeightedMTBF_Success(t *testing.T) {
+	service, mockRepo := setupServiceWithMock()
+	ctx := context.Background()
+
+	mockRepo.GetIncidentIntervalsFn = func(ctx context.Context, lineName string, start, end time.Time, includeDeleted bool) ([]IncidentInterval, error) {
+		assert.Equal(t, "Circle Line", lineName)
+		assert.WithinDuration(t, end.AddDate(0, 0, -30), start, time.Second)
+		return []IncidentInterval{
+			{LineName: "Circle Line", MinutesBetween: 100, DurationMinutes: 10},
+			{LineName: "Circle Line", MinutesBetween: 20, DurationMinutes: 240},
+		}, nil
+	}
+
+	resp, err := service.GetWeightedMTBF(ctx, &pb.WeightedMTBFRequest{Line: " Circle Line "})
+
+	require.NoError(t, err)
+	require.Len(t, resp.Lines, 1)
+	assert.Equal(t, "Circle Line", resp.Lines[0].Name)
+	assert.Equal(t, 60.0, resp.Lines[0].MtbfMinutes)
+	assert.Equal(t, 23.2, resp.Lines[0].WeightedMtbfMinutes)
+	assert.Equal(t, int32(2), resp.Lines[0].IntervalCount)
+}
+
+func TestGetWeightedMTBF_InvalidRange(t *testing.T) {
+	service, _ := setupServiceWithMock()
+	ctx := context.Background()
+
+	now := time.Now()
+	resp, err := service.GetWeightedMTBF(ctx, &pb.WeightedMTBFRequest{
+		StartTime: timestamppb.New(now),
+		EndTime:   timestamppb.New(now.Add(-time.Hour)),
+	})
+
+	require.Error(t, err)
+	assert.Nil(t, resp)
+	st, ok := status.FromError(err)
+	require.True(t, ok)
+	assert.Equal(t, codes.InvalidArgument, st.Code())
+}
+
+func TestGetWeightedMTBF_DatabaseError(t *testing.T) {
+	service, mockRepo := setupServiceWithMock()
+	ctx := context.Background()
+
+	mockRepo.GetIncidentIntervalsFn = func(ctx context.Context, lineName string, start, end time.Time, includeDeleted bool) ([]IncidentInterval, error) {
+		return nil, ErrDatabaseError
+	}
+
+	resp, err := service.GetWeightedMTBF(ctx, &pb.WeightedMTBFRequest{})
+
+	require.Error(t, err)
+	assert.Nil(t, resp)
+	st, ok := status.FromError(err)
+	require.True(t, ok)
+	assert.Equal(t, codes.Internal, st.Code())
+}
+
+func TestNormalizeStationStatuses_Success(t *testing.T) {
+	service, mockRepo := setupServiceWithMock()
+	service.cfg.AdminEnabled = true
+	ctx := context.Background()
+
+	mockRepo.NormalizeStationStatusesFn = func(ctx context.Context) (int64, error) {
+		return 3, nil
+	}
+
+	resp, err := service.NormalizeStationStatuses(ctx, &emptypb.Empty{})
+
+	require.NoError(t, err)
+	assert.Equal(t, int32(3), resp.UpdatedCount)
+}
+
+func TestNormalizeStationStatuses_Disabled(t *testing.T) {
+	service, mockRepo := setupServiceWithMock()
+	ctx := context.Background()
+
+	mockRepo.NormalizeStationStatusesFn = func(ctx context.Context) (int64, error) {
+		t.Fatal("repository must not be called when admin RPCs are disabled")
+		return 0, nil
+	}
+
+	resp, err := service.NormalizeStationStatuses(ctx, &emptypb.Empty{})
+
+	require.Error(t, err)
+	assert.Nil(t, resp)
+	st, ok := status.FromError(err)
+	require.True(t, ok)
+	assert.Equal(t, codes.PermissionDenied, st.Code())
+}
+
+func TestNormalizeStationStatuses_DatabaseError(t *testing.T) {
+	service, mockRepo := setupServiceWithMock()
+	service.cfg.AdminEnabled = true
+	ctx := context.Background()
+
+	mockRepo.NormalizeStationStatusesFn = func(ctx context.Context) (int64, error) {
+		return 0, ErrDatabaseError
+	}
+
+	resp, err := service.NormalizeStationStatuses(ctx, &emptypb.Empty{})
+
+	require.Error(t, err)
+	assert.Nil(t, resp)
+	st, ok := status.FromError(err)
+	require.True(t, ok)
+	assert.Equal(t, codes.Internal, st.Code())
+}
+
+func TestPurgeOldIncidents_Success(t *testing.T) {
+	service, mockRepo := setupServiceWithMock()
+	service.cfg.AdminEnabled = true
+	service.cfg.IncidentRetentionDays = 90
+	ctx := context.Background()
+
+	mockRepo.PurgeIncidentsBeforeFn = func(ctx context.Context, cutoff time.Time, batchSize int) (int64, error) {
+		assert.WithinDuration(t, time.Now().AddDate(0, 0, -90), cutoff, time.Minute)
+		assert.Equal(t, purgeBatchSize, batchSize)
+		return 2500, nil
+	}
+
+	resp, err := service.PurgeOldIncidents(ctx, &pb.PurgeOldIncidentsRequest{Confirm: true})
+
+	require.NoError(t, err)
+	assert.Equal(t, int64(2500), resp.DeletedCount)
+	assert.WithinDuration(t, time.Now().AddDate(0, 0, -90), resp.Cutoff.AsTime(), time.Minute)
+}
+
+func TestPurgeOldIncidents_Rejected(t *testing.T) {
+	tests := []struct {
+		name          string
+		adminEnabled  bool
+		retentionDays int
+		confirm       bool
+		wantCode      codes.Code
+	}{
+		{"admin disabled", false, 90, true, codes.PermissionDenied},
+		{"retention not configured", true, 0, true, codes.FailedPrecondition},
+		{"not confirmed", true, 90, false, codes.InvalidArgument},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, mockRepo := setupServiceWithMock()
+			service.cfg.AdminEnabled = tt.adminEnabled
+			service.cfg.IncidentRetentionDays = tt.retentionDays
+
+			mockRepo.PurgeIncidentsBeforeFn = func(ctx context.Context, cutoff time.Time, batchSize int) (int64, error) {
+				t.Fatal("repository must not be called")
+				return 0, nil
+			}
+
+			resp, err := service.PurgeOldIncidents(context.Background(), &pb.PurgeOldIncidentsRequest{Confirm: tt.confirm})
+
+			require.Error(t, err)
+			assert.Nil(t, resp)
+			assert.Equal(t, tt.wantCode, status.Code(err))
+		})
+	}
+}
+
+func TestPurgeOldIncidents_DatabaseError(t *testing.T) {
+	service, mockRepo := setupServiceWithMock()
+	service.cfg.AdminEnabled = true
+	service.cfg.IncidentRetentionDays = 90
+	ctx := context.Background()
+
+	mockRepo.PurgeIncidentsBeforeFn = func(ctx context.Context, cutoff time.Time, batchSize int) (int64, error) {
+		return 1000, ErrDatabaseError
+	}
+
+	resp, err := service.PurgeOldIncidents(ctx, &pb.PurgeOldIncidentsRequest{Confirm: true})
+
+	require.Error(t, err)
+	assert.Nil(t, resp)
+	assert.Equal(t, codes.Internal, status.Code(err))
+}
+
+func TestGetStationStatusSummary_Success(t *testing.T) {
+	service, mockRepo := setupServiceWithMock()
+	ctx := context.Background()
+
+	circle, thomson := uuid.New(), uuid.New()
+	active, maintenance, closed := "active", "maintenance", "closed"
+	mockRepo.GetStationStatusCountsFn = func(ctx context.Context) ([]LineStationStatusCount, error) {
+		return []LineStationStatusCount{
+			{LineID: circle, LineName: "Circle Line", Status: &active, Count: 28},
+			{LineID: circle, LineName: "Circle Line", Status: &maintenance, Count: 2},
+			{LineID: circle, LineName: "Circle Line", Status: &closed, Count: 1},
+			{LineID: thomson, LineName: "Thomson Line"},
+		}, nil
+	}
+
+	resp, err := service.GetStationStatusSummary(ctx, &emptypb.Empty{})
+
+	require.NoError(t, err)
+	require.Len(t, resp.Items, 2)
+	assert.Equal(t, &pb.StationStatusSummaryItem{
+		LineId: circle.String(), Line: "Circle Line",
+		Active: 28, Inactive: 0, Maintenance: 2, Closed: 1, Total: 31,
+	}, resp.Items[0])
+	assert.Equal(t, &pb.StationStatusSummaryItem{LineId: thomson.String(), Line: "Thomson Line"}, resp.Items[1])
+}
+
+func TestGetStationStatusSummary_RepositoryError(t *testing.T) {
+	service, mockRepo := setupServiceWithMock()
+
+	mockRepo.GetStationStatusCountsFn = func(ctx context.Context) ([]LineStationStatusCount, error) {
+		return nil, ErrDatabaseError
+	}
+
+	resp, err := service.GetStationStatusSummary(context.Background(), &emptypb.Empty{})
+
+	require.Error(t, err)
+	assert.Nil(t, resp)
+	assert.Equal(t, codes.Internal, status.Code(err))
+}
+
+func TestGetStationVsLineAverage_Success(t *testing.T) {
+	service, mockRepo := setupServiceWithMock()
+	ctx := context.Background()
+
+	stationID := uuid.New()
+
+	mockRepo.GetStationLineComparisonFn = func(ctx context.Context, id uuid.UUID, start, end time.Time) (*StationLineComparison, error) {
+		assert.Equal(t, stationID, id)
+		assert.WithinDuration(t, end.AddDate(0, 0, -30), start, time.Second)
+		return &StationLineComparison{
+			StationName:            "Tampines",
+			LineName:               "East West Line",
+			IncidentCount:          6,
+			TotalDowntimeMinutes:   120,
+			LineStationCount:       3,
+			LineAvgIncidentCount:   10.0 / 3,
+			LineAvgDowntimeMinutes: 80,
+		}, nil
+	}
+
+	resp, err := service.GetStationVsLineAverage(ctx, &pb.StationVsLineAverageRequest{StationId: stationID.String()})
+
+	require.NoError(t, err)
+	assert.Equal(t, "Tampines", resp.Station)
+	assert.Equal(t, "East West Line", resp.Line)
+	assert.Equal(t, int32(6), resp.IncidentCount)
+	assert.Equal(t, 3.33, resp.LineAvgIncidentCount)
+	assert.Equal(t, 2.67, resp.IncidentCountDelta)
+	assert.Equal(t, 40.0, resp.DowntimeDeltaMinutes)
+}
+
+func TestGetStationVsLineAverage_NotFound(t *testing.T) {
+	service, mockRepo := setupServiceWithMock()
+	ctx := context.Background()
+
+	mockRepo.GetStationLineComparisonFn = func(ctx context.Context, id uuid.UUID, start, end time.Time) (*StationLineComparison, error) {
+		return nil, ErrNotFound
+	}
+
+	resp, err := service.GetStationVsLineAverage(ctx, &pb.StationVsLineAverageRequest{StationId: uuid.New().String()})
+
+	require.Error(t, err)
+	assert.Nil(t, resp)
+	st, ok := status.FromError(err)
+	require.True(t, ok)
+	assert.Equal(t, codes.NotFound, st.Code())
+}
+
+func TestGetStationVsLineAverage_InvalidStationID(t *testing.T) {
+	service, _ := setupServiceWithMock()
+	ctx := context.Background()
+
+	resp, err := service.GetStationVsLineAverage(ctx, &pb.StationVsLineAverageRequest{StationId: "not-a-uuid"})
+
+	require.Error(t, err)
+	assert.Nil(t, resp)
+	st, ok := status.FromError(err)
+	require.True(t, ok)
+	assert.Equal(t, codes.InvalidArgument, st.Code())
+}
+
+func TestGetStationMTBF_Success(t *testing.T) {
+	service, mockRepo := setupServiceWithMock()
+	ctx := context.Background()
+
+	stationID := uuid.New()
+	mtbf := 1440.5
+
+	mockRepo.GetStationMTBFFn = func(ctx context.Context, id uuid.UUID) (*StationMTBF, error) {
+		assert.Equal(t, stationID, id)
+		return &StationMTBF{
+			StationName:   "Tampines",
+			LineName:      "East West Line",
+			IncidentCount: 3,
+			MTBFMinutes:   &mtbf,
+		}, nil
+	}
+
+	resp, err := service.GetStationMTBF(ctx, &pb.StationMTBFRequest{StationId: stationID.String()})
+
+	require.NoError(t, err)
+	assert.Equal(t, stationID.String(), resp.StationId)
+	assert.Equal(t, "Tampines", resp.Station)
+	assert.Equal(t, "East West Line", resp.Line)
+	assert.Equal(t, int32(3), resp.IncidentCount)
+	require.NotNil(t, resp.MtbfMinutes)
+	assert.Equal(t, 1440.5, *resp.MtbfMinutes)
+	assert.False(t, resp.InsufficientData)
+}
+
+func TestGetStationMTBF_InsufficientData(t *testing.T) {
+	service, mockRepo := setupServiceWithMock()
+	ctx := context.Background()
+
+	mockRepo.GetStationMTBFFn = func(ctx context.Context, id uuid.UUID) (*StationMTBF, error) {
+		return &StationMTBF{StationName: "Tampines", LineName: "East West Line", IncidentCount: 1}, nil
+	}
+
+	resp, err := service.GetStationMTBF(ctx, &pb.StationMTBFRequest{StationId: uuid.New().String()})
+
+	require.NoError(t, err)
+	assert.Equal(t, int32(1), resp.IncidentCount)
+	assert.Nil(t, resp.MtbfMinutes)
+	assert.True(t, resp.InsufficientData)
+}
+
+func TestGetStationMTBF_Errors(t *testing.T) {
+	tests := []struct {
+		name      string
+		stationID string
+		repoErr   error
+		wantCode  codes.Code
+	}{
+		{"invalid station ID", "not-a-uuid", nil, codes.InvalidArgument},
+		{"station not found", uuid.New().String(), ErrNotFound, codes.NotFound},
+		{"database error", uuid.New().String(), ErrDatabaseError, codes.Internal},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, mockRepo := setupServiceWithMock()
+			mockRepo.GetStationMTBFFn = func(ctx context.Context, id uuid.UUID) (*StationMTBF, error) {
+				return nil, tt.repoErr
+			}
+
+			resp, err := service.GetStationMTBF(context.Background(), &pb.StationMTBFRequest{StationId: tt.stationID})
+
+			require.Error(t, err)
+			assert.Nil(t, resp)
+			assert.Equal(t, tt.wantCode, status.Code(err))
+		})
+	}
+}
+
+func TestCreateIncident_ByID(t *testing.T) {
+	service, mockRepo := setupServiceWithMock()
+	ctx := context.Background()
+
+	lineID := uuid.New()
+	stationID := uuid.New()
+	ts := time.Now().Add(-time.Hour)
+
+	mockRepo.GetLineFn = func(ctx context.Context, id uuid.UUID) (*Line, error) {
+		return &Line{ID: id, Name: "Circle Line"}, nil
+	}
+	mockRepo.GetStationFn = func(ctx context.Context, id uuid.UUID) (*StationWithLine, error) {
+		return &StationWithLine{
+			ID:     id,
+			Name:   "Dhoby Ghaut",
+			LineID: uuid.New(),
+			Lines:  []StationLineRef{{StationID: id, LineID: lineID, LineName: "Circle Line"}},
+		}, nil
+	}
+	mockRepo.GetOrCreateLineFn = func(ctx context.Context, name string) (*Line, bool, error) {
+		t.Fatal("name resolution must be skipped when IDs are given")
+		return nil, false, nil
+	}
+	mockRepo.CreateIncidentFn = func(ctx context.Context, sID, lID uuid.UUID, ts time.Time, durationMinutes int32, incidentType, status, source string) (*Incident, error) {
+		assert.Equal(t, stationID, sID)
+		assert.Equal(t, lineID, lID)
+		return &Incident{ID: uuid.New(), StationID: sID, LineID: lID, Timestamp: ts, DurationMinutes: durationMinutes, IncidentType: incidentType, Status: status}, nil
+	}
+
+	resp, err := service.CreateIncident(ctx, &pb.CreateIncidentRequest{
+		LineId:          lineID.String(),
+		StationId:       stationID.String(),
+		Timestamp:       timestamppb.New(ts),
+		DurationMinutes: 10,
+		IncidentType:    "signal",
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "Circle Line", resp.Line)
+	assert.Equal(t, "Dhoby Ghaut", resp.Station)
+	assert.Equal(t, lineID.String(), resp.LineId)
+	assert.Equal(t, stationID.String(), resp.StationId)
+	assert.False(t, resp.LineCreated)
+	assert.False(t, resp.StationCreated)
+}
+
+func TestCreateIncident_ByIDStationNotOnLine(t *testing.T) {
+	service, mockRepo := setupServiceWithMock()
+	ctx := context.Background()
+
+	mockRepo.GetLineFn = func(ctx context.Context, id uuid.UUID) (*Line, error) {
+		return &Line{ID: id, Name: "Circle Line"}, nil
+	}
+	mockRepo.GetStationFn = func(ctx context.Context, id uuid.UUID) (*StationWithLine, error) {
+		return &StationWithLine{ID: id, Name: "Jurong East", LineID: uuid.New()}, nil
+	}
+
+	resp, err := service.CreateIncident(ctx, &pb.CreateIncidentRequest{
+		LineId:          uuid.New().String(),
+		StationId:       uuid.New().String(),
+		Timestamp:       timestamppb.New(time.Now().Add(-time.Hour)),
+		DurationMinutes: 10,
+		IncidentType:    "signal",
+	})
+
+	require.Error(t, err)
+	assert.Nil(t, resp)
+	st, ok := status.FromError(err)
+	require.True(t, ok)
+	assert.Equal(t, codes.InvalidArgument, st.Code())
+}
+
+func TestCreateIncident_ByIDNotFound(t *testing.T) {
+	service, mockRepo := setupServiceWithMock()
+	ctx := context.Background()
+
+	mockRepo.GetLineFn = func(ctx context.Context, id uuid.UUID) (*Line, error) {
+		return &Line{ID: id, Name: "Circle Line"}, nil
+	}
+	mockRepo.GetStationFn = func(ctx context.Context, id uuid.UUID) (*StationWithLine, error) {
+		return nil, ErrNotFound
+	}
+
+	resp, err := service.CreateIncident(ctx, &pb.CreateIncidentRequest{
+		LineId:          uuid.New().String(),
+		StationId:       uuid.New().String(),
+		Timestamp:       timestamppb.New(time.Now().Add(-time.Hour)),
+		DurationMinutes: 10,
+		IncidentType:    "signal",
+	})
+
+	require.Error(t, err)
+	assert.Nil(t, resp)
+	st, ok := status.FromError(err)
+	require.True(t, ok)
+	assert.Equal(t, codes.NotFound, st.Code())
+	assert.Contains(t, st.Message(), "station not found")
+}
+
+func TestCreateIncident_ByIDValidation(t *testing.T) {
+	service, _ := setupServiceWithMock()
+	ctx := context.Background()
+
+	tests := []struct {
+		name      string
+		lineID    string
+		stationID string
+	}{
+		{"line_id only", uuid.New().String(), ""},
+		{"station_id only", "", uuid.New().String()},
+		{"invalid line_id", "not-a-uuid", uuid.New().String()},
+		{"invalid station_id", uuid.New().String(), "not-a-uuid"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := service.CreateIncident(ctx, &pb.CreateIncidentRequest{
+				LineId:          tt.lineID,
+				StationId:       tt.stationID,
+				Timestamp:       timestamppb.New(time.Now().Add(-time.Hour)),
+				DurationMinutes: 10,
+				IncidentType:    "signal",
+			})
+
+			require.Error(t, err)
+			assert.Nil(t, resp)
+			st, ok := status.FromError(err)
+			require.True(t, ok)
+			assert.Equal(t, codes.InvalidArgument, st.Code())
+		})
+	}
+}
+
+// createIncidentByID must not panic on malformed IDs if it is ever reached
+// without going through validateIncidentRequest first.
+func TestCreateIncidentByID_InvalidIDs(t *testing.T) {
+	service, _ := setupServiceWithMock()
+	ctx := context.Background()
+
+	tests := []struct {
+		name      string
+		lineID    string
+		stationID string
+		field     string
+	}{
+		{"invalid line_id", "not-a-uuid", uuid.New().String(), "line_id"},
+		{"invalid station_id", uuid.New().String(), "not-a-uuid", "station_id"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var resp *pb.IncidentResponse
+			var err error
+			require.NotPanics(t, func() {
+				resp, err = service.createIncidentByID(ctx, &pb.CreateIncidentRequest{LineId: tt.lineID, StationId: tt.stationID}, incidentFields{})
+			})
+
+			require.Error(t, err)
+			assert.Nil(t, resp)
+			st, ok := status.FromError(err)
+			require.True(t, ok)
+			assert.Equal(t, codes.InvalidArgument, st.Code())
+			assert.Contains(t, st.Message(), tt.field)
+		})
+	}
+}
+
+func TestCreateIncident_ByIDDryRun(t *testing.T) {
+	service, mockRepo := setupServiceWithMock()
+	ctx := context.Background()
+
+	lineID := uuid.New()
+
+	mockRepo.GetLineFn = func(ctx context.Context, id uuid.UUID) (*Line, error) {
+		return &Line{ID: id, Name: "Circle Line"}, nil
+	}
+	mockRepo.GetStationFn = func(ctx context.Context, id uuid.UUID) (*StationWithLine, error) {
+		return &StationWithLine{ID: id, Name: "Bishan", LineID: lineID}, nil
+	}
+
+	resp, err := service.CreateIncident(ctx, &pb.CreateIncidentRequest{
+		LineId:          lineID.String(),
+		StationId:       uuid.New().String(),
+		Timestamp:       timestamppb.New(time.Now().Add(-time.Hour)),
+		DurationMinutes: 10,
+		IncidentType:    "signal",
+		DryRun:          true,
+	})
+
+	require.NoError(t, err)
+	assert.True(t, resp.DryRun)
+	assert.Empty(t, resp.Id)
+	assert.Equal(t, "Bishan", resp.Station)
+}
+
+func TestGetIncidentTypeByHour_DenseMatrix(t *testing.T) {
+	service, mockRepo := setupServiceWithMock()
+	ctx := context.Background()
+
+	mockRepo.GetIncidentTypeCountsByHourFn = func(ctx context.Context, lineName string, start, end time.Time, includeDeleted bool) ([]HourTypeCount, error) {
+		assert.Equal(t, "North South Line", lineName)
+		return []HourTypeCount{
+			{Hour: 8, IncidentType: "power", Count: 4},
+			{Hour: 8, IncidentType: "signal", Count: 1},
+			{Hour: 18, IncidentType: "power", Count: 2},
+		}, nil
+	}
+
+	resp, err := service.GetIncidentTypeByHour(ctx, &pb.IncidentTypeByHourRequest{Line: "North South Line"})
+
+	require.NoError(t, err)
+	assert.Equal(t, incidentTypes, resp.IncidentTypes)
+	require.Len(t, resp.Rows, 24)
+	for hour, row := range resp.Rows {
+		assert.Equal(t, int32(hour), row.Hour)
+		assert.Len(t, row.CountsByType, len(incidentTypes))
+	}
+	assert.Equal(t, int32(4), resp.Rows[8].CountsByType["power"])
+	assert.Equal(t, int32(5), resp.Rows[8].Total)
+	assert.Equal(t, int32(2), resp.Rows[18].Total)
+	assert.Equal(t, int32(0), resp.Rows[0].CountsByType["weather"])
+	assert.Equal(t, int32(0), resp.Rows[0].Total)
+}
+
+func TestGetIncidentTypeByHour_RangeTooLarge(t *testing.T) {
+	service, _ := setupServiceWithMock()
+	ctx := context.Background()
+
+	now := time.Now()
+	resp, err := service.GetIncidentTypeByHour(ctx, &pb.IncidentTypeByHourRequest{
+		StartTime: timestamppb.New(now.AddDate(-2, 0, 0)),
+		EndTime:   timestamppb.New(now),
+	})
+
+	require.Error(t, err)
+	assert.Nil(t, resp)
+	st, ok := status.FromError(err)
+	require.True(t, ok)
+	assert.Equal(t, codes.InvalidArgument, st.Code())
+}
+
+func TestPreviewLineDeletion_Success(t *testing.T) {
+	service, mockRepo := setupServiceWithMock()
+	ctx := context.Background()
+
+	lineID := uuid.New()
+
+	mockRepo.PreviewLineDeletionFn = func(ctx context.Context, id uuid.UUID) (*LineDeletionImpact, error) {
+		assert.Equal(t, lineID, id)
+		return &LineDeletionImpact{
+			LineID:             lineID,
+			LineName:           "Circle Line",
+			StationCount:       12,
+			SharedStationCount: 3,
+			IncidentCount:      40,
+		}, nil
+	}
+
+	resp, err := service.PreviewLineDeletion(ctx, &pb.PreviewLineDeletionRequest{Id: lineID.String()})
+
+	require.NoError(t, err)
+	assert.Equal(t, lineID.String(), resp.LineId)
+	assert.Equal(t, "Circle Line", resp.LineName)
+	assert.Equal(t, int32(12), resp.StationCount)
+	assert.Equal(t, int32(3), resp.SharedStationCount)
+	assert.Equal(t, int32(40), resp.IncidentCount)
+}
+
+func TestPreviewLineDeletion_NotFound(t *testing.T) {
+	service, mockRepo := setupServiceWithMock()
+	ctx := context.Background()
+
+	mockRepo.PreviewLineDeletionFn = func(ctx context.Context, id uuid.UUID) (*LineDeletionImpact, error) {
+		return nil, ErrNotFound
+	}
+
+	resp, err := service.PreviewLineDeletion(ctx, &pb.PreviewLineDeletionRequest{Id: uuid.New().String()})
+
+	require.Error(t, err)
+	assert.Nil(t, resp)
+	st, ok := status.FromError(err)
+	require.True(t, ok)
+	assert.Equal(t, codes.NotFound, st.Code())
+}
+
+func TestPreviewLineDeletion_InvalidUUID(t *testing.T) {
+	service, _ := setupServiceWithMock()
+	ctx := context.Background()
+
+	resp, err := service.PreviewLineDeletion(ctx, &pb.PreviewLineDeletionRequest{Id: "invalid-uuid"})
+
+	require.Error(t, err)
+	assert.Nil(t, resp)
+	st, ok := status.FromError(err)
+	require.True(t, ok)
+	assert.Equal(t, codes.InvalidArgument, st.Code())
+}
+
+func TestGetLineTypeDistribution_Success(t *testing.T) {
+	service, mockRepo := setupServiceWithMock()
+	ctx := context.Background()
+
+	lineID := uuid.New()
+	mockRepo.FindLineByNameFn = func(ctx context.Context, name string) (*Line, error) {
+		assert.Equal(t, "circle line", name)
+		return &Line{ID: lineID, Name: "Circle Line"}, nil
+	}
+	mockRepo.GetLineIncidentTypeCountsFn = func(ctx context.Context, id uuid.UUID, start, end time.Time, includeDeleted bool) ([]TypeCount, error) {
+		assert.Equal(t, lineID, id)
+		return []TypeCount{
+			{IncidentType: "mechanical", Count: 1},
+			{IncidentType: "power", Count: 1},
+			{IncidentType: "signal", Count: 1},
+		}, nil
+	}
+
+	resp, err := service.GetLineTypeDistribution(ctx, &pb.LineTypeDistributionRequest{Line: " circle line "})
+
+	require.NoError(t, err)
+	assert.Equal(t, "Circle Line", resp.Line)
+	assert.Equal(t, int32(3), resp.TotalIncidents)
+	require.Len(t, resp.Types, len(incidentTypes))
+
+	var sum float64
+	for i, share := range resp.Types {
+		assert.Equal(t, incidentTypes[i], share.IncidentType)
+		sum += share.Percentage
+	}
+	assert.InDelta(t, 100, sum, 1e-9)
+	assert.Equal(t, 33.34, resp.Types[0].Percentage)
+	assert.Equal(t, 33.33, resp.Types[1].Percentage)
+	assert.Equal(t, 33.33, resp.Types[2].Percentage)
+	assert.Equal(t, int32(0), resp.Types[3].Count)
+	assert.Equal(t, float64(0), resp.Types[3].Percentage)
+}
+
+func TestGetLineTypeDistribution_NoIncidents(t *testing.T) {
+	service, mockRepo := setupServiceWithMock()
+	ctx := context.Background()
+
+	mockRepo.FindLineByNameFn = func(ctx context.Context, name string) (*Line, error) {
+		return &Line{ID: uuid.New(), Name: name}, nil
+	}
+	mockRepo.GetLineIncidentTypeCountsFn = func(ctx context.Context, id uuid.UUID, start, end time.Time, includeDeleted bool) ([]TypeCount, error) {
+		return nil, nil
+	}
+
+	resp, err := service.GetLineTypeDistribution(ctx, &pb.LineTypeDistributionRequest{Line: "Circle Line"})
+
+	require.NoError(t, err)
+	assert.Equal(t, int32(0), resp.TotalIncidents)
+	require.Len(t, resp.Types, len(incidentTypes))
+	for _, share := range resp.Types {
+		assert.Equal(t, float64(0), share.Percentage)
+	}
+}
+
+func TestGetLineTypeDistribution_LineNotFound(t *testing.T) {
+	service, mockRepo := setupServiceWithMock()
+	ctx := context.Background()
+
+	mockRepo.FindLineByNameFn = func(ctx context.Context, name string) (*Line, error) {
+		return nil, ErrNotFound
+	}
+
+	resp, err := service.GetLineTypeDistribution(ctx, &pb.LineTypeDistributionRequest{Line: "Unknown Line"})
+
+	require.Error(t, err)
+	assert.Nil(t, resp)
+	st, ok := status.FromError(err)
+	require.True(t, ok)
+	assert.Equal(t, codes.NotFound, st.Code())
+}
+
+func TestGetLineTypeDistribution_MissingLine(t *testing.T) {
+	service, _ := setupServiceWithMock()
+	ctx := context.Background()
+
+	resp, err := service.GetLineTypeDistribution(ctx, &pb.LineTypeDistributionRequest{Line: "  "})
+
+	require.Error(t, err)
+	assert.Nil(t, resp)
+	st, ok := status.FromError(err)
+	require.True(t, ok)
+	assert.Equal(t, codes.InvalidArgument, st.Code())
+}
+
+func TestSharePercentages(t *testing.T) {
+	tests := []struct {
+		name   string
+		counts []int32
+		want   []float64
+	}{
+		{"even split", []int32{1, 1}, []float64{50, 50}},
+		{"thirds", []int32{1, 1, 1}, []float64{33.34, 33.33, 33.33}},
+		{"largest remainder wins", []int32{1, 2, 0, 4}, []float64{14.29, 28.57, 0, 57.14}},
+		{"all zero", []int32{0, 0}, []float64{0, 0}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, sharePercentages(tt.counts))
+		})
+	}
+}
+
+func TestGetTimeSinceLastIncident_Success(t *testing.T) {
+	service, mockRepo := setupServiceWithMock()
+	ctx := context.Background()
+
+	last := time.Now().UTC().Add(-90 * time.Minute)
+	mockRepo.GetLastIncidentTimesFn = func(ctx context.Context, lineName string, includeDeleted bool) ([]LineLastIncident, error) {
+		assert.Equal(t, "Circle Line", lineName)
+		return []LineLastIncident{
+			{LineName: "Circle Line", LastIncidentAt: &last},
+		}, nil
+	}
+
+	resp, err := service.GetTimeSinceLastIncident(ctx, &pb.TimeSinceLastIncidentRequest{Line: " Circle Line "})
+
+	require.NoError(t, err)
+	require.NotNil(t, resp.AsOf)
+	require.Len(t, resp.Lines, 1)
+	item := resp.Lines[0]
+	assert.Equal(t, "Circle Line", item.Line)
+	assert.True(t, last.Equal(item.LastIncidentAt.AsTime()))
+	require.NotNil(t, item.MinutesSinceLastIncident)
+	assert.InDelta(t, 90, *item.MinutesSinceLastIncident, 1)
+}
+
+func TestGetTimeSinceLastIncident_NoIncidents(t *testing.T) {
+	service, mockRepo := setupServiceWithMock()
+	ctx := context.Background()
+
+	future := time.Now().UTC().Add(time.Minute)
+	mockRepo.GetLastIncidentTimesFn = func(ctx context.Context, lineName string, includeDeleted bool) ([]LineLastIncident, error) {
+		return []LineLastIncident{
+			{LineName: "Circle Line", LastIncidentAt: &future},
+			{LineName: "New Line"},
+		}, nil
+	}
+
+	resp, err := service.GetTimeSinceLastIncident(ctx, &pb.TimeSinceLastIncidentRequest{})
+
+	require.NoError(t, err)
+	require.Len(t, resp.Lines, 2)
+	require.NotNil(t, resp.Lines[0].MinutesSinceLastIncident)
+	assert.Equal(t, float64(0), *resp.Lines[0].MinutesSinceLastIncident)
+	assert.Nil(t, resp.Lines[1].LastIncidentAt)
+	assert.Nil(t, resp.Lines[1].MinutesSinceLastIncident)
+}
+
+func TestGetTimeSinceLastIncident_RepositoryError(t *testing.T) {
+	service, mockRepo := setupServiceWithMock()
+	ctx := context.Background()
+
+	mockRepo.GetLastIncidentTimesFn = func(ctx context.Context, lineName string, includeDeleted bool) ([]LineLastIncident, error) {
+		return nil, ErrDatabaseError
+	}
+
+	resp, err := service.GetTimeSinceLastIncident(ctx, &pb.TimeSinceLastIncidentRequest{})
+
+	require.Error(t, err)
+	assert.Nil(t, resp)
+	st, ok := status.FromError(err)
+	require.True(t, ok)
+	assert.Equal(t, codes.Internal, st.Code())
+}
+
+func TestAnalyticsRange(t *testing.T) {
+	end := time.Date(2025, 6, 30, 0, 0, 0, 0, time.UTC)
+	start := end.AddDate(0, 0, -7)
+
+	gotStart, gotEnd, err := analyticsRange(&start, &end)
+	require.NoError(t, err)
+	assert.Equal(t, start, gotStart)
+	assert.Equal(t, end, gotEnd)
+
+	gotStart, gotEnd, err = analyticsRange(nil, &end)
+	require.NoError(t, err)
+	assert.Equal(t, end.AddDate(0, 0, -30), gotStart)
+	assert.Equal(t, end, gotEnd)
+
+	gotStart, gotEnd, err = analyticsRange(nil, nil)
+	require.NoError(t, err)
+	assert.WithinDuration(t, time.Now(), gotEnd, time.Minute)
+	assert.Equal(t, 30*24*time.Hour, gotEnd.Sub(gotStart))
+
+	longStart := end.AddDate(0, 0, -367)
+	_, _, err = analyticsRange(&longStart, &end)
+	assert.EqualError(t, err, "date range must not exceed 366 days")
+
+	// A future start with no end would otherwise give a reversed range.
+	future := time.Now().Add(24 * time.Hour)
+	_, _, err = analyticsRange(&future, nil)
+	assert.Error(t, err)
+}
+
+func TestGetLineTopIncidentType_Success(t *testing.T) {
+	service, mockRepo := setupServiceWithMock()
+	ctx := context.Background()
+
+	mockRepo.GetTopIncidentTypeByLineFn = func(ctx context.Context, start, end time.Time, includeDeleted bool) ([]LineTypeCount, error) {
+		assert.Equal(t, 30*24*time.Hour, end.Sub(start))
+		return []LineTypeCount{
+			{LineName: "Circle Line", IncidentType: ptr("signal"), Count: 7},
+			{LineName: "New Line", Count: 0},
+		}, nil
+	}
+
+	resp, err := service.GetLineTopIncidentType(ctx, &pb.LineTopIncidentTypeRequest{})
+
+	require.NoError(t, err)
+	require.Len(t, resp.Items, 2)
+	assert.Equal(t, "Circle Line", resp.Items[0].Line)
+	assert.Equal(t, "signal", resp.Items[0].IncidentType)
+	assert.Equal(t, int32(7), resp.Items[0].Count)
+	assert.Equal(t, "New Line", resp.Items[1].Line)
+	assert.Empty(t, resp.Items[1].IncidentType)
+	assert.Equal(t, int32(0), resp.Items[1].Count)
+}
+
+func TestGetLineTopIncidentType_RangeTooLong(t *testing.T) {
+	service, _ := setupServiceWithMock()
+	ctx := context.Background()
+
+	end := time.Now().UTC()
+	resp, err := service.GetLineTopIncidentType(ctx, &pb.LineTopIncidentTypeRequest{
+		StartTime: timestamppb.New(end.AddDate(0, 0, -400)),
+		EndTime:   timestamppb.New(end),
+	})
+
+	require.Error(t, err)
+	assert.Nil(t, resp)
+	st, ok := status.FromError(err)
+	require.True(t, ok)
+	assert.Equal(t, codes.InvalidArgument, st.Code())
+}
+
+func TestGetIncidentStatusCounts_Success(t *testing.T) {
+	service, mockRepo := setupServiceWithMock()
+	ctx := context.Background()
+
+	mockRepo.GetIncidentStatusCountsFn = func(ctx context.Context, lineName string, start, end *time.Time, includeDeleted bool) ([]StatusCount, error) {
+		assert.Equal(t, "Circle Line", lineName)
+		assert.Nil(t, start)
+		assert.Nil(t, end)
+		return []StatusCount{
+			{Status: "closed", Count: 2},
+			{Status: "open", Count: 5},
+			{Status: "resolved", Count: 3},
+		}, nil
+	}
+
+	resp, err := service.GetIncidentStatusCounts(ctx, &pb.IncidentStatusCountsRequest{Line: " Circle Line "})
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"open", "investigating", "resolved", "closed"}, resp.Statuses)
+	assert.Equal(t, map[string]int32{"open": 5, "investigating": 0, "resolved": 3, "closed": 2}, resp.CountsByStatus)
+	assert.Equal(t, int32(10), resp.Total)
+}
+
+func TestGetIncidentStatusCounts_ConfiguredStatuses(t *testing.T) {
+	service, mockRepo := setupServiceWithMock()
+	service.cfg.IncidentStatuses = []string{"new", "done"}
+	ctx := context.Background()
+
+	mockRepo.GetIncidentStatusCountsFn = func(ctx context.Context, lineName string, start, end *time.Time, includeDeleted bool) ([]StatusCount, error) {
+		return nil, nil
+	}
+
+	resp, err := service.GetIncidentStatusCounts(ctx, &pb.IncidentStatusCountsRequest{})
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"new", "done"}, resp.Statuses)
+	assert.Equal(t, map[string]int32{"new": 0, "done": 0}, resp.CountsByStatus)
+	assert.Equal(t, int32(0), resp.Total)
+}
+
+func TestGetIncidentsDuringMaintenance_Success(t *testing.T) {
+	service, mockRepo := setupServiceWithMock()
+	ctx := context.Background()
+
+	mockRepo.GetIncidentsDuringMaintenanceFn = func(ctx context.Context, lineName string, start, end time.Time, includeDeleted bool) ([]StationIncidentAggregate, error) {
+		assert.Equal(t, "Circle Line", lineName)
+		assert.Equal(t, 30*24*time.Hour, end.Sub(start))
+		return []StationIncidentAggregate{
+			{StationName: "Bishan", LineName: "Circle Line", IncidentCount: 3, TotalDowntimeMinutes: 45},
+			{StationName: "Dhoby Ghaut", LineName: "Circle Line", IncidentCount: 1, TotalDowntimeMinutes: 10},
+		}, nil
+	}
+
+	resp, err := service.GetIncidentsDuringMaintenance(ctx, &pb.IncidentsDuringMaintenanceRequest{Line: "Circle Line"})
+
+	require.NoError(t, err)
+	require.Len(t, resp.Items, 2)
+	assert.Equal(t, "Bishan", resp.Items[0].Station)
+	assert.Equal(t, int32(3), resp.Items[0].IncidentCount)
+	assert.Equal(t, int32(45), resp.Items[0].TotalDowntimeMinutes)
+	assert.Equal(t, int32(4), resp.TotalIncidents)
+}
+
+func TestGetIncidentsDuringMaintenance_RepositoryError(t *testing.T) {
+	service, mockRepo := setupServiceWithMock()
+	ctx := context.Background()
+
+	mockRepo.GetIncidentsDuringMaintenanceFn = func(ctx context.Context, lineName string, start, end time.Time, includeDeleted bool) ([]StationIncidentAggregate, error) {
+		return nil, ErrDatabaseError
+	}
+
+	resp, err := service.GetIncidentsDuringMaintenance(ctx, &pb.IncidentsDuringMaintenanceRequest{})
+
+	require.Error(t, err)
+	assert.Nil(t, resp)
+	st, ok := status.FromError(err)
+	require.True(t, ok)
+	assert.Equal(t, codes.Internal, st.Code())
+}
+
+func TestGetLongestIncidents_Success(t *testing.T) {
+	service, mockRepo := setupServiceWithMock()
+	ctx := context.Background()
+
+	ts := time.Now().UTC().Add(-48 * time.Hour).Truncate(time.Second)
+	mockRepo.GetLongestIncidentsFn = func(ctx context.Context, start, end time.Time, includeDeleted bool) ([]IncidentWithDetails, error) {
+		return []IncidentWithDetails{
+			{ID: uuid.New(), LineName: "Circle Line", StationName: "Bishan", Timestamp: ts, DurationMinutes: 240, IncidentType: "power"},
+			{ID: uuid.New(), LineName: "North East Line", StationName: "Punggol", Timestamp: ts, DurationMinutes: 90, IncidentType: "signal"},
+		}, nil
+	}
+
+	resp, err := service.GetLongestIncidents(ctx, &pb.LongestIncidentsRequest{})
+
+	require.NoError(t, err)
+	require.Len(t, resp.Incidents, 2)
+	assert.Equal(t, "Circle Line", resp.Incidents[0].Line)
+	assert.Equal(t, "Bishan", resp.Incidents[0].Station)
+	assert.Equal(t, int32(240), resp.Incidents[0].DurationMinutes)
+	assert.True(t, ts.Equal(resp.Incidents[0].Timestamp.AsTime()))
+}
+
+func TestGetLongestIncidents_InvalidRange(t *testing.T) {
+	service, _ := setupServiceWithMock()
+	ctx := context.Background()
+
+	now := time.Now().UTC()
+	resp, err := service.GetLongestIncidents(ctx, &pb.LongestIncidentsRequest{
+		StartTime: timestamppb.New(now),
+		EndTime:   timestamppb.New(now.Add(-time.Hour)),
+	})
+
+	require.Error(t, err)
+	assert.Nil(t, resp)
+	st, ok := status.FromError(err)
+	require.True(t, ok)
+	assert.Equal(t, codes.InvalidArgument, st.Code())
+}
+
+func TestGetConcurrentIncidents_Success(t *testing.T) {
+	service, mockRepo := setupServiceWithMock()
+	ctx := context.Background()
+
+	first := time.Date(2024, 1, 15, 8, 0, 0, 0, time.UTC)
+	second := first.Add(20 * time.Minute)
+	mockRepo.GetConcurrentIncidentsFn = func(ctx context.Context, lineName string, start, end time.Time, limit int32, includeDeleted bool) ([]ConcurrentIncidentPair, error) {
+		assert.Equal(t, "Circle Line", lineName)
+		assert.Equal(t, int32(100), limit)
+		return []ConcurrentIncidentPair{{
+			First:        IncidentWithDetails{ID: uuid.New(), LineName: "Circle Line", StationName: "Bishan", Timestamp: first, DurationMinutes: 45},
+			Second:       IncidentWithDetails{ID: uuid.New(), LineName: "Circle Line", StationName: "Serangoon", Timestamp: second, DurationMinutes: 10},
+			OverlapStart: second,
+			OverlapEnd:   second.Add(10 * time.Minute),
+		}}, nil
+	}
+
+	resp, err := service.GetConcurrentIncidents(ctx, &pb.ConcurrentIncidentsRequest{Line: " Circle Line "})
+
+	require.NoError(t, err)
+	require.Len(t, resp.Pairs, 1)
+	pair := resp.Pairs[0]
+	assert.Equal(t, "Circle Line", pair.Line)
+	assert.Equal(t, "Bishan", pair.First.Station)
+	assert.Equal(t, "Serangoon", pair.Second.Station)
+	assert.Equal(t, second, pair.OverlapStart.AsTime())
+	assert.Equal(t, 10.0, pair.OverlapMinutes)
+}
+
+func TestGetConcurrentIncidents_InvalidRange(t *testing.T) {
+	service, _ := setupServiceWithMock()
+
+	now := time.Now().UTC()
+	resp, err := service.GetConcurrentIncidents(context.Background(), &pb.ConcurrentIncidentsRequest{
+		StartTime: timestamppb.New(now.AddDate(-2, 0, 0)),
+		EndTime:   timestamppb.New(now),
+	})
+
+	require.Error(t, err)
+	assert.Nil(t, resp)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestGetNetworkOverview_CachesByRange(t *testing.T) {
+	service, mockRepo := setupServiceWithMock()
+	service.networkOverview = newTTLCache[NetworkOverview](time.Minute)
+	ctx := context.Background()
+
+	mtbf := 720.5
+	calls := 0
+	mockRepo.GetNetworkOverviewFn = func(ctx context.Context, start, end time.Time, includeDeleted bool) (*NetworkOverview, error) {
+		calls++
+		assert.Equal(t, 30*24*time.Hour, end.Sub(start))
+		return &NetworkOverview{
+			TotalLines:      6,
+			TotalStations:   140,
+			TotalIncidents:  42,
+			DowntimeMinutes: 1260,
+			MTBFMinutes:     &mtbf,
+		}, nil
+	}
+
+	for i := 0; i < 2; i++ {
+		resp, err := service.GetNetworkOverview(ctx, &pb.NetworkOverviewRequest{})
+
+		require.NoError(t, err)
+		assert.Equal(t, int32(6), resp.TotalLines)
+		assert.Equal(t, int32(140), resp.TotalStations)
+		assert.Equal(t, int32(42), resp.TotalIncidents)
+		assert.Equal(t, int64(1260), resp.TotalDowntimeMinutes)
+		require.NotNil(t, resp.MtbfMinutes)
+		assert.Equal(t, 720.5, *resp.MtbfMinutes)
+	}
+	assert.Equal(t, 1, calls, "second request should be served from the cache")
+
+	end := time.Date(2025, 1, 31, 0, 0, 0, 0, time.UTC)
+	_, err := service.GetNetworkOverview(ctx, &pb.NetworkOverviewRequest{
+		StartTime: timestamppb.New(end.AddDate(0, 0, -30)),
+		EndTime:   timestamppb.New(end),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 2, calls, "a different range is a different cache entry")
+}
+
+func TestGetNetworkOverview_PartialFailure(t *testing.T) {
+	ctx := context.Background()
+	partial := func(ctx context.Context, start, end time.Time, includeDeleted bool) (*NetworkOverview, error) {
+		return &NetworkOverview{
+			TotalLines:    6,
+			TotalStations: 140,
+			Failures: []SectionFailure{
+				{Section: OverviewSectionIncidents, Err: fmt.Errorf("%w: %w", ErrDatabaseError, &pq.Error{Code: "57014"})},
+				{Section: OverviewSectionMTBF, Err: ErrDatabaseError},
+			},
+		}, nil
+	}
+
+	t.Run("fails without best_effort", func(t *testing.T) {
+		service, mockRepo := setupServiceWithMock()
+		mockRepo.GetNetworkOverviewFn = partial
+
+		resp, err := service.GetNetworkOverview(ctx, &pb.NetworkOverviewRequest{})
+
+		require.Error(t, err)
+		assert.Nil(t, resp)
+		assert.Equal(t, codes.Internal, status.Code(err))
+	})
+
+	t.Run("best_effort returns what succeeded", func(t *testing.T) {
+		service, mockRepo := setupServiceWithMock()
+		service.networkOverview = newTTLCache[NetworkOverview](time.Minute)
+		calls := 0
+		mockRepo.GetNetworkOverviewFn = func(ctx context.Context, start, end time.Time, includeDeleted bool) (*NetworkOverview, error) {
+			calls++
+			return partial(ctx, start, end, includeDeleted)
+		}
+
+		for i := 0; i < 2; i++ {
+			resp, err := service.GetNetworkOverview(ctx, &pb.NetworkOverviewRequest{BestEffort: true})
+
+			require.NoError(t, err)
+			assert.Equal(t, int32(6), resp.TotalLines)
+			assert.Equal(t, int32(140), resp.TotalStations)
+			require.Len(t, resp.FailedSections, 2)
+			assert.Equal(t, "incidents", resp.FailedSections[0].Section)
+			assert.Equal(t, "query timed out", resp.FailedSections[0].Reason)
+			assert.Equal(t, "mtbf", resp.FailedSections[1].Section)
+			assert.Equal(t, "query failed", resp.FailedSections[1].Reason)
+		}
+		assert.Equal(t, 2, calls, "partial overviews must not be cached")
+	})
+}
+
+func TestGetDurationPercentiles_Success(t *testing.T) {
+	service, mockRepo := setupServiceWithMock()
+	ctx := context.Background()
+
+	end := time.Date(2025, 1, 31, 0, 0, 0, 0, time.UTC)
+	start := end.AddDate(0, 0, -7)
+	mockRepo.GetDurationPercentilesFn = func(ctx context.Context, s, e time.Time, includeDeleted bool) ([]LineDurationPercentiles, error) {
+		return []LineDurationPercentiles{
+			{LineName: "Circle Line", IncidentCount: 12, P50Minutes: 15, P90Minutes: 42.5, P95Minutes: 58.25, P99Minutes: 117.8},
+		}, nil
+	}
+
+	resp, err := service.GetDurationPercentiles(ctx, &pb.DurationPercentilesRequest{
+		StartTime: timestamppb.New(start),
+		EndTime:   timestamppb.New(end),
+	})
+
+	require.NoError(t, err)
+	require.Len(t, resp.Lines, 1)
+	line := resp.Lines[0]
+	assert.Equal(t, "Circle Line", line.Name)
+	assert.Equal(t, int32(12), line.IncidentCount)
+	assert.Equal(t, 15.0, line.P50Minutes)
+	assert.Equal(t, 42.5, line.P90Minutes)
+	assert.Equal(t, 58.25, line.P95Minutes)
+	assert.Equal(t, 117.8, line.P99Minutes)
+}
+
+func TestGetLongestQuietPeriod_Success(t *testing.T) {
+	service, mockRepo := setupServiceWithMock()
+	ctx := context.Background()
+
+	end := time.Date(2025, 1, 31, 0, 0, 0, 0, time.UTC)
+	start := end.AddDate(0, 0, -7)
+	gapStart := time.Date(2025, 1, 25, 8, 0, 0, 0, time.UTC)
+	gapEnd := time.Date(2025, 1, 29, 20, 30, 0, 0, time.UTC)
+	mockRepo.GetLongestQuietPeriodsFn = func(ctx context.Context, s, e time.Time, includeDeleted bool) ([]LineQuietPeriod, error) {
+		return []LineQuietPeriod{
+			{LineName: "Circle Line", GapMinutes: 6510, StartTime: gapStart, EndTime: gapEnd},
+		}, nil
+	}
+
+	resp, err := service.GetLongestQuietPeriod(ctx, &pb.LongestQuietPeriodRequest{
+		StartTime: timestamppb.New(start),
+		EndTime:   timestamppb.New(end),
+	})
+
+	require.NoError(t, err)
+	require.Len(t, resp.Lines, 1)
+	line := resp.Lines[0]
+	assert.Equal(t, "Circle Line", line.Name)
+	assert.Equal(t, 6510.0, line.GapMinutes)
+	assert.Equal(t, gapStart, line.StartTime.AsTime())
+	assert.Equal(t, gapEnd, line.EndTime.AsTime())
+}
+
+func TestGetSLABreaches_Success(t *testing.T) {
+	service, mockRepo := setupServiceWithMock()
+	ctx := context.Background()
+
+	end := time.Date(2025, 1, 31, 0, 0, 0, 0, time.UTC)
+	start := end.AddDate(0, 0, -7)
+	mockRepo.GetSLABreachesFn = func(ctx context.Context, threshold int32, s, e time.Time, includeDeleted bool) ([]LineSLABreaches, error) {
+		assert.Equal(t, int32(45), threshold)
+		return []LineSLABreaches{
+			{LineName: "Circle Line", TotalIncidents: 8, Breaches: 2, BreachRate: 0.25},
+		}, nil
+	}
+
+	resp, err := service.GetSLABreaches(ctx, &pb.SLABreachesRequest{
+		ThresholdMinutes: 45,
+		StartTime:        timestamppb.New(start),
+		EndTime:          timestamppb.New(end),
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, int32(45), resp.ThresholdMinutes)
+	require.Len(t, resp.Lines, 1)
+	line := resp.Lines[0]
+	assert.Equal(t, "Circle Line", line.Name)
+	assert.Equal(t, int32(8), line.TotalIncidents)
+	assert.Equal(t, int32(2), line.Breaches)
+	assert.Equal(t, 0.25, line.BreachRate)
+}
+
+func TestGetSLABreaches_InvalidThreshold(t *testing.T) {
+	service, _ := setupServiceWithMock()
+
+	for _, threshold := range []int32{0, 1441} {
+		resp, err := service.GetSLABreaches(context.Background(), &pb.SLABreachesRequest{ThresholdMinutes: threshold})
+
+		require.Error(t, err)
+		assert.Nil(t, resp)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	}
+}
+
+// TestAnalyticsRange_RPCs covers what the range-based analytics RPCs share: the
+// requested range reaches the repository, ranges over the limit are rejected
+// and repository failures surface as Internal.
+func TestAnalyticsRange_RPCs(t *testing.T) {
+	type stubFn func(m *MockRepository, got *[2]time.Time, err error)
+	type callFn func(s *Service, start, end *timestamppb.Timestamp) error
+
+	tests := []struct {
+		name string
+		stub stubFn
+		call callFn
+	}{
+		{
+			"network overview",
+			func(m *MockRepository, got *[2]time.Time, err error) {
+				m.GetNetworkOverviewFn = func(ctx context.Context, start, end time.Time, includeDeleted bool) (*NetworkOverview, error) {
+					*got = [2]time.Time{start, end}
+					return &NetworkOverview{}, err
+				}
+			},
+			func(s *Service, start, end *timestamppb.Timestamp) error {
+				_, err := s.GetNetworkOverview(context.Background(), &pb.NetworkOverviewRequest{StartTime: start, EndTime: end})
+				return err
+			},
+		},
+		{
+			"duration percentiles",
+			func(m *MockRepository, got *[2]time.Time, err error) {
+				m.GetDurationPercentilesFn = func(ctx context.Context, start, end time.Time, includeDeleted bool) ([]LineDurationPercentiles, error) {
+					*got = [2]time.Time{start, end}
+					return nil, err
+				}
+			},
+			func(s *Service, start, end *timestamppb.Timestamp) error {
+				_, err := s.GetDurationPercentiles(context.Background(), &pb.DurationPercentilesRequest{StartTime: start, EndTime: end})
+				return err
+			},
+		},
+		{
+			"longest quiet period",
+			func(m *MockRepository, got *[2]time.Time, err error) {
+				m.GetLongestQuietPeriodsFn = func(ctx context.Context, start, end time.Time, includeDeleted bool) ([]LineQuietPeriod, error) {
+					*got = [2]time.Time{start, end}
+					return nil, err
+				}
+			},
+			func(s *Service, start, end *timestamppb.Timestamp) error {
+				_, err := s.GetLongestQuietPeriod(context.Background(), &pb.LongestQuietPeriodRequest{StartTime: start, EndTime: end})
+				return err
+			},
+		},
+		{
+			"sla breaches",
+			func(m *MockRepository, got *[2]time.Time, err error) {
+				m.GetSLABreachesFn = func(ctx context.Context, threshold int32, start, end time.Time, includeDeleted bool) ([]LineSLABreaches, error) {
+					*got = [2]time.Time{start, end}
+					return nil, err
+				}
+			},
+			func(s *Service, start, end *timestamppb.Timestamp) error {
+				_, err := s.GetSLABreaches(context.Background(), &pb.SLABreachesRequest{ThresholdMinutes: 30, StartTime: start, EndTime: end})
+				return err
+			},
+		},
+	}
+
+	end := time.Date(2025, 1, 31, 0, 0, 0, 0, time.UTC)
+	start := end.AddDate(0, 0, -7)
+	now := time.Now().UTC()
+
+	for _, tt := range tests {
+		t.Run(tt.name+"/passes range", func(t *testing.T) {
+			service, mockRepo := setupServiceWithMock()
+			var got [2]time.Time
+			tt.stub(mockRepo, &got, nil)
+
+			require.NoError(t, tt.call(service, timestamppb.New(start), timestamppb.New(end)))
+			assert.Equal(t, [2]time.Time{start, end}, got)
+		})
+
+		t.Run(tt.name+"/range too long", func(t *testing.T) {
+			service, mockRepo := setupServiceWithMock()
+			var got [2]time.Time
+			tt.stub(mockRepo, &got, nil)
+
+			err := tt.call(service, timestamppb.New(now.AddDate(-2, 0, 0)), timestamppb.New(now))
+			assert.Equal(t, codes.InvalidArgument, status.Code(err))
+			assert.True(t, got[0].IsZero(), "repository must not be queried")
+		})
+
+		t.Run(tt.name+"/repository error", func(t *testing.T) {
+			service, mockRepo := setupServiceWithMock()
+			var got [2]time.Time
+			tt.stub(mockRepo, &got, ErrDatabaseError)
+
+			err := tt.call(service, nil, nil)
+			assert.Equal(t, codes.Internal, status.Code(err))
+		})
+	}
+}
+
+func TestListIncidentsByTag_Success(t *testing.T) {
+	service, mockRepo := setupServiceWithMock()
+	ctx := context.Background()
+
+	mockRepo.ListIncidentsByTagFn = func(ctx context.Context, tag string, limit int32, timeField TimeField) ([]IncidentWithDetails, error) {
+		assert.Equal(t, "rain-related", tag)
+		assert.Equal(t, int32(50), limit)
+		assert.Equal(t, TimeFieldEvent, timeField)
+		return []IncidentWithDetails{
+			{ID: uuid.New(), LineName: "Circle Line", StationName: "Bishan", Tags: []string{"flooding", "rain-related"}},
+		}, nil
+	}
+
+	resp, err := service.ListIncidentsByTag(ctx, &pb.ListIncidentsByTagRequest{Tag: " Rain-Related "})
+
+	require.NoError(t, err)
+	assert.Equal(t, "rain-related", resp.Tag)
+	require.Len(t, resp.Incidents, 1)
+	assert.Equal(t, "Bishan", resp.Incidents[0].Station)
+	assert.Equal(t, []string{"flooding", "rain-related"}, resp.Incidents[0].Tags)
+}
+
+func TestListIncidentsByTag_EmptyTag(t *testing.T) {
+	service, _ := setupServiceWithMock()
+	ctx := context.Background()
+
+	resp, err := service.ListIncidentsByTag(ctx, &pb.ListIncidentsByTagRequest{Tag: " "})
+
+	require.Error(t, err)
+	assert.Nil(t, resp)
+	st, ok := status.FromError(err)
+	require.True(t, ok)
+	assert.Equal(t, codes.InvalidArgument, st.Code())
+}
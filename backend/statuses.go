@@ -0,0 +1,35 @@
+package backend
+
+import (
+	"fmt"
+	"strings"
+)
+
+// NewIncidentStatuses trims the configured incident statuses and the
+// resolved status, and checks that the list has no blank or repeated entries
+// and contains the resolved status.
+func NewIncidentStatuses(statuses []string, resolved string) ([]string, string, error) {
+	if len(statuses) == 0 {
+		return nil, "", fmt.Errorf("at least one incident status is required")
+	}
+
+	trimmed := make([]string, len(statuses))
+	seen := make(map[string]bool, len(statuses))
+	for i, s := range statuses {
+		s = strings.TrimSpace(s)
+		if s == "" {
+			return nil, "", fmt.Errorf("incident status %d is blank", i+1)
+		}
+		if seen[s] {
+			return nil, "", fmt.Errorf("incident status %q is listed twice", s)
+		}
+		seen[s] = true
+		trimmed[i] = s
+	}
+
+	resolved = strings.TrimSpace(resolved)
+	if !seen[resolved] {
+		return nil, "", fmt.Errorf("resolved status %q is not one of the incident statuses %v", resolved, trimmed)
+	}
+	return trimmed, resolved, nil
+}
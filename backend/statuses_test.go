@@ -0,0 +1,27 @@
+package backend
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewIncidentStatuses(t *testing.T) {
+	statuses, resolved, err := NewIncidentStatuses([]string{" open", "acknowledged ", " closed "}, " closed")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"open", "acknowledged", "closed"}, statuses)
+	assert.Equal(t, "closed", resolved)
+
+	_, _, err = NewIncidentStatuses(nil, "resolved")
+	assert.Error(t, err)
+
+	_, _, err = NewIncidentStatuses([]string{"open", " ", "resolved"}, "resolved")
+	assert.Error(t, err)
+
+	_, _, err = NewIncidentStatuses([]string{"open", "open ", "resolved"}, "resolved")
+	assert.Error(t, err)
+
+	_, _, err = NewIncidentStatuses([]string{"open", "closed"}, "resolved")
+	assert.Error(t, err)
+}
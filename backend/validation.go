@@ -0,0 +1,134 @@
+package backend
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	maxNameLength   = 100
+	maxSourceLength = 50
+	maxTagLength    = 50
+	maxTags         = 20
+)
+
+// ValidationError is a request field that failed validation. Message is the
+// full, client-facing description, e.g. "name must not be empty".
+type ValidationError struct {
+	Field   string
+	Message string
+}
+
+func (e *ValidationError) Error() string {
+	return e.Message
+}
+
+func newValidationError(field, format string, args ...any) *ValidationError {
+	return &ValidationError{Field: field, Message: fmt.Sprintf(format, args...)}
+}
+
+// validationStatus converts validation failures into a codes.InvalidArgument
+// status carrying the first error's message. Every *ValidationError among
+// errs is also attached as a BadRequest field violation, so clients can point
+// at the offending fields without parsing the message.
+func validationStatus(errs ...error) error {
+	if len(errs) == 0 {
+		return nil
+	}
+	var violations []*errdetails.BadRequest_FieldViolation
+	for _, err := range errs {
+		var verr *ValidationError
+		if errors.As(err, &verr) {
+			violations = append(violations, &errdetails.BadRequest_FieldViolation{
+				Field:       verr.Field,
+				Description: verr.Message,
+			})
+		}
+	}
+	return badRequestStatus(errs[0].Error(), violations)
+}
+
+func badRequestStatus(msg string, violations []*errdetails.BadRequest_FieldViolation) error {
+	st := status.New(codes.InvalidArgument, msg)
+	if len(violations) > 0 {
+		if detailed, err := st.WithDetails(&errdetails.BadRequest{FieldViolations: violations}); err == nil {
+			st = detailed
+		}
+	}
+	return st.Err()
+}
+
+// requiredText trims value and checks it is non-empty and at most maxLen
+// characters long. The trimmed value is returned for storage.
+func requiredText(field, value string, maxLen int) (string, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return "", newValidationError(field, "%s must not be empty", field)
+	}
+	return optionalText(field, value, maxLen)
+}
+
+// optionalText trims value and checks it is at most maxLen characters long.
+// An empty value is allowed.
+func optionalText(field, value string, maxLen int) (string, error) {
+	value = strings.TrimSpace(value)
+	if utf8.RuneCountInString(value) > maxLen {
+		return "", newValidationError(field, "%s must not exceed %d characters", field, maxLen)
+	}
+	return value, nil
+}
+
+// normalizeTags trims and lowercases each tag and drops duplicates, keeping
+// the first occurrence's position.
+func normalizeTags(tags []string) ([]string, error) {
+	if len(tags) > maxTags {
+		return nil, newValidationError("tags", "tags must not exceed %d entries", maxTags)
+	}
+
+	normalized := make([]string, 0, len(tags))
+	seen := make(map[string]bool, len(tags))
+	for i, tag := range tags {
+		tag, err := requiredText("tag", strings.ToLower(tag), maxTagLength)
+		if err != nil {
+			return nil, &ValidationError{Field: fmt.Sprintf("tags[%d]", i), Message: err.Error()}
+		}
+		if seen[tag] {
+			continue
+		}
+		seen[tag] = true
+		normalized = append(normalized, tag)
+	}
+	return normalized, nil
+}
+
+// parseUUIDs parses every entry of a repeated ID field. Rather than stopping
+// at the first bad entry, it checks them all and returns a single
+// codes.InvalidArgument status carrying one BadRequest field violation per
+// invalid entry, named like "additional_line_ids[2]".
+func parseUUIDs(field string, values []string) ([]uuid.UUID, error) {
+	ids := make([]uuid.UUID, 0, len(values))
+	var violations []*errdetails.BadRequest_FieldViolation
+	for i, raw := range values {
+		id, err := uuid.Parse(strings.TrimSpace(raw))
+		if err != nil {
+			violations = append(violations, &errdetails.BadRequest_FieldViolation{
+				Field:       fmt.Sprintf("%s[%d]", field, i),
+				Description: fmt.Sprintf("invalid UUID %q", raw),
+			})
+			continue
+		}
+		ids = append(ids, id)
+	}
+	if len(violations) == 0 {
+		return ids, nil
+	}
+
+	return nil, badRequestStatus(fmt.Sprintf("%s has %d invalid entries", field, len(violations)), violations)
+}
@@ -0,0 +1,166 @@
+package backend
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestRequiredText(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   string
+		maxLen  int
+		want    string
+		wantErr string
+	}{
+		{"trimmed", "  Circle Line  ", maxNameLength, "Circle Line", ""},
+		{"empty", "", maxNameLength, "", "name must not be empty"},
+		{"whitespace only", "   ", maxNameLength, "", "name must not be empty"},
+		{"name at limit", strings.Repeat("a", 100), maxNameLength, strings.Repeat("a", 100), ""},
+		{"name over limit", strings.Repeat("a", 101), maxNameLength, "", "name must not exceed 100 characters"},
+		{"limit ignores surrounding spaces", " " + strings.Repeat("a", 100) + " ", maxNameLength, strings.Repeat("a", 100), ""},
+		{"multibyte at limit", strings.Repeat("é", 100), maxNameLength, strings.Repeat("é", 100), ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := requiredText("name", tt.value, tt.maxLen)
+			if tt.wantErr != "" {
+				require.Error(t, err)
+				assert.Equal(t, tt.wantErr, err.Error())
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestOptionalText(t *testing.T) {
+	tests := []struct {
+		name    string
+		field   string
+		value   string
+		maxLen  int
+		want    string
+		wantErr string
+	}{
+		{"empty allowed", "source", "", maxSourceLength, "", ""},
+		{"source at limit", "source", strings.Repeat("s", 50), maxSourceLength, strings.Repeat("s", 50), ""},
+		{"source over limit", "source", strings.Repeat("s", 51), maxSourceLength, "", "source must not exceed 50 characters"},
+		{"name at limit", "name_contains", strings.Repeat("n", 100), maxNameLength, strings.Repeat("n", 100), ""},
+		{"name over limit", "name_contains", strings.Repeat("n", 101), maxNameLength, "", "name_contains must not exceed 100 characters"},
+		{"trimmed", "source", "\tsignal fault \n", maxSourceLength, "signal fault", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := optionalText(tt.field, tt.value, tt.maxLen)
+			if tt.wantErr != "" {
+				require.Error(t, err)
+				assert.Equal(t, tt.wantErr, err.Error())
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestNormalizeTags(t *testing.T) {
+	tags, err := normalizeTags([]string{" Rain-Related ", "signal", "rain-related"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"rain-related", "signal"}, tags)
+
+	_, err = normalizeTags([]string{strings.Repeat("a", 51)})
+	require.Error(t, err)
+	assert.Equal(t, "tag must not exceed 50 characters", err.Error())
+
+	_, err = normalizeTags(make([]string, 21))
+	require.Error(t, err)
+	assert.Equal(t, "tags must not exceed 20 entries", err.Error())
+}
+
+func TestParseUUIDs(t *testing.T) {
+	a, b := uuid.New(), uuid.New()
+
+	ids, err := parseUUIDs("additional_line_ids", []string{a.String(), " " + b.String() + " "})
+	require.NoError(t, err)
+	assert.Equal(t, []uuid.UUID{a, b}, ids)
+
+	ids, err = parseUUIDs("additional_line_ids", nil)
+	require.NoError(t, err)
+	assert.Empty(t, ids)
+}
+
+func TestParseUUIDs_ReportsEveryInvalidEntry(t *testing.T) {
+	valid := uuid.New().String()
+
+	ids, err := parseUUIDs("additional_line_ids", []string{valid, "-1", valid, "0000", ""})
+
+	require.Error(t, err)
+	assert.Nil(t, ids)
+	st, ok := status.FromError(err)
+	require.True(t, ok)
+	assert.Equal(t, codes.InvalidArgument, st.Code())
+
+	require.Len(t, st.Details(), 1)
+	badRequest, ok := st.Details()[0].(*errdetails.BadRequest)
+	require.True(t, ok)
+	var fields []string
+	for _, v := range badRequest.FieldViolations {
+		fields = append(fields, v.Field)
+	}
+	assert.Equal(t, []string{"additional_line_ids[1]", "additional_line_ids[3]", "additional_line_ids[4]"}, fields)
+}
+
+func TestValidationStatus(t *testing.T) {
+	err := validationStatus(
+		newValidationError("line", "line must not be empty"),
+		fmt.Errorf("wrapped: %w", newValidationError("duration_minutes", "duration_minutes must be between 0 and 1440")),
+		errors.New("not a field problem"),
+	)
+
+	st, ok := status.FromError(err)
+	require.True(t, ok)
+	assert.Equal(t, codes.InvalidArgument, st.Code())
+	assert.Equal(t, "line must not be empty", st.Message())
+
+	require.Len(t, st.Details(), 1)
+	badRequest, ok := st.Details()[0].(*errdetails.BadRequest)
+	require.True(t, ok)
+	require.Len(t, badRequest.FieldViolations, 2)
+	assert.Equal(t, "line", badRequest.FieldViolations[0].Field)
+	assert.Equal(t, "line must not be empty", badRequest.FieldViolations[0].Description)
+	assert.Equal(t, "duration_minutes", badRequest.FieldViolations[1].Field)
+}
+
+func TestValidationStatus_NoErrors(t *testing.T) {
+	assert.NoError(t, validationStatus())
+}
+
+func TestValidationStatus_PlainError(t *testing.T) {
+	st, ok := status.FromError(validationStatus(errors.New("bad request")))
+	require.True(t, ok)
+	assert.Equal(t, codes.InvalidArgument, st.Code())
+	assert.Equal(t, "bad request", st.Message())
+	assert.Empty(t, st.Details())
+}
+
+func TestNormalizeTags_ReportsTagIndex(t *testing.T) {
+	_, err := normalizeTags([]string{"signal", " "})
+
+	var verr *ValidationError
+	require.ErrorAs(t, err, &verr)
+	assert.Equal(t, "tags[1]", verr.Field)
+	assert.Equal(t, "tag must not be empty", verr.Message)
+}
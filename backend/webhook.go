@@ -0,0 +1,146 @@
+package backend
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	pb "github.com/bluesg/transport-analytics/proto"
+	"github.com/go-coldbrew/log"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// webhookTimeout bounds a single delivery attempt.
+const webhookTimeout = 10 * time.Second
+
+type WebhookConfig struct {
+	// URL receives a POST with the JSON of every qualifying incident. Empty
+	// disables the webhook.
+	URL string
+	// MinDurationMinutes is the shortest incident that is posted.
+	MinDurationMinutes int32
+	// QueueSize is how many incidents may wait for delivery. Incidents created
+	// while the queue is full are dropped and logged.
+	QueueSize int
+	// Retry controls how failed deliveries are retried.
+	Retry RetryConfig
+}
+
+// WebhookDispatcher posts newly created incidents to an external URL in the
+// background, so a slow or failing receiver never delays or fails incident
+// creation.
+type WebhookDispatcher struct {
+	cfg    WebhookConfig
+	client *http.Client
+	queue  chan webhookEvent
+}
+
+type webhookEvent struct {
+	incidentID string
+	body       []byte
+}
+
+// webhookStatusError is a delivery rejected by the receiver.
+type webhookStatusError struct {
+	code int
+}
+
+func (e *webhookStatusError) Error() string {
+	return fmt.Sprintf("webhook responded with status %d", e.code)
+}
+
+// NewWebhookDispatcher returns a dispatcher for cfg, or nil when no URL is
+// configured. A nil dispatcher ignores every incident.
+func NewWebhookDispatcher(cfg WebhookConfig) *WebhookDispatcher {
+	if cfg.URL == "" {
+		return nil
+	}
+	return &WebhookDispatcher{
+		cfg:    cfg,
+		client: &http.Client{Timeout: webhookTimeout},
+		queue:  make(chan webhookEvent, max(cfg.QueueSize, 1)),
+	}
+}
+
+// Notify queues incident for delivery if it meets the duration threshold. It
+// never blocks: when the queue is full the incident is dropped and logged.
+func (d *WebhookDispatcher) Notify(ctx context.Context, incident *pb.IncidentResponse) {
+	if d == nil || incident.DurationMinutes < d.cfg.MinDurationMinutes {
+		return
+	}
+
+	// Marshal now: the caller may keep modifying incident after we return.
+	body, err := protojson.MarshalOptions{UseProtoNames: true}.Marshal(incident)
+	if err != nil {
+		log.Error(ctx, "Failed to encode incident webhook", "incident_id", incident.Id, "error", err)
+		return
+	}
+
+	select {
+	case d.queue <- webhookEvent{incidentID: incident.Id, body: body}:
+	default:
+		log.Warn(ctx, "Incident webhook queue full, dropping notification", "incident_id", incident.Id)
+	}
+}
+
+// Run delivers queued incidents until ctx is cancelled. Incidents still
+// queued at that point are not delivered.
+func (d *WebhookDispatcher) Run(ctx context.Context) {
+	log.Info(ctx, "Incident webhook dispatcher started", "queue_size", cap(d.queue))
+
+	for {
+		select {
+		case <-ctx.Done():
+			log.Info(ctx, "Incident webhook dispatcher stopped")
+			return
+		case event := <-d.queue:
+			if err := d.deliver(ctx, event); err != nil {
+				if ctx.Err() != nil {
+					log.Info(ctx, "Incident webhook dispatcher stopped")
+					return
+				}
+				log.Error(ctx, "Failed to deliver incident webhook", "incident_id", event.incidentID, "error", err)
+			}
+		}
+	}
+}
+
+func (d *WebhookDispatcher) deliver(ctx context.Context, event webhookEvent) error {
+	return retryIf(ctx, d.cfg.Retry, isRetryableWebhookError, func() error {
+		req, err := http.NewRequestWithContext(ctx, http.MethodPost, d.cfg.URL, bytes.NewReader(event.body))
+		if err != nil {
+			return err
+		}
+		req.Header.Set("Content-Type", "application/json")
+
+		resp, err := d.client.Do(req)
+		if err != nil {
+			return err
+		}
+		defer resp.Body.Close()
+		_, _ = io.Copy(io.Discard, resp.Body)
+
+		if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+			return &webhookStatusError{code: resp.StatusCode}
+		}
+		return nil
+	})
+}
+
+// isRetryableWebhookError reports whether a failed delivery may succeed if
+// repeated: network errors, rate limiting and server errors. Other 4xx
+// responses mean the receiver rejected the payload and are not retried.
+func isRetryableWebhookError(err error) bool {
+	if errors.Is(err, context.Canceled) {
+		return false
+	}
+	var statusErr *webhookStatusError
+	if errors.As(err, &statusErr) {
+		return statusErr.code == http.StatusTooManyRequests || statusErr.code >= 500
+	}
+	return true
+}
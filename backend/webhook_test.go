@@ -0,0 +1,117 @@
+package backend
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	pb "github.com/bluesg/transport-analytics/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func runWebhook(t *testing.T, d *WebhookDispatcher) {
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		d.Run(ctx)
+	}()
+	t.Cleanup(func() {
+		cancel()
+		<-done
+	})
+}
+
+func TestWebhookDispatcher_PostsIncidentsOverThreshold(t *testing.T) {
+	received := make(chan map[string]any, 2)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		body, _ := io.ReadAll(r.Body)
+		var payload map[string]any
+		assert.NoError(t, json.Unmarshal(body, &payload))
+		received <- payload
+	}))
+	defer server.Close()
+
+	d := NewWebhookDispatcher(WebhookConfig{URL: server.URL, MinDurationMinutes: 30, QueueSize: 10})
+	runWebhook(t, d)
+
+	ctx := context.Background()
+	d.Notify(ctx, &pb.IncidentResponse{Id: "short", DurationMinutes: 29})
+	d.Notify(ctx, &pb.IncidentResponse{Id: "long", Line: "Circle Line", DurationMinutes: 30})
+
+	select {
+	case payload := <-received:
+		assert.Equal(t, "long", payload["id"])
+		assert.Equal(t, "Circle Line", payload["line"])
+		assert.EqualValues(t, 30, payload["duration_minutes"])
+	case <-time.After(5 * time.Second):
+		t.Fatal("webhook was not delivered")
+	}
+	select {
+	case payload := <-received:
+		t.Fatalf("unexpected delivery of %v", payload["id"])
+	case <-time.After(50 * time.Millisecond):
+	}
+}
+
+func TestWebhookDispatcher_RetriesServerErrors(t *testing.T) {
+	var calls atomic.Int32
+	delivered := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if calls.Add(1) < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		close(delivered)
+	}))
+	defer server.Close()
+
+	d := NewWebhookDispatcher(WebhookConfig{
+		URL:       server.URL,
+		QueueSize: 1,
+		Retry:     RetryConfig{MaxAttempts: 3, BaseDelay: time.Millisecond},
+	})
+	runWebhook(t, d)
+	d.Notify(context.Background(), &pb.IncidentResponse{Id: "a"})
+
+	select {
+	case <-delivered:
+	case <-time.After(5 * time.Second):
+		t.Fatal("webhook was not retried")
+	}
+	assert.Equal(t, int32(3), calls.Load())
+}
+
+func TestWebhookDispatcher_DropsWhenQueueFull(t *testing.T) {
+	d := NewWebhookDispatcher(WebhookConfig{URL: "http://example.invalid", QueueSize: 1})
+
+	// Nothing drains the queue, so the second incident must be dropped
+	// rather than block the caller.
+	d.Notify(context.Background(), &pb.IncidentResponse{Id: "a"})
+	d.Notify(context.Background(), &pb.IncidentResponse{Id: "b"})
+
+	require.Len(t, d.queue, 1)
+	assert.Equal(t, "a", (<-d.queue).incidentID)
+}
+
+func TestNewWebhookDispatcher_DisabledWithoutURL(t *testing.T) {
+	d := NewWebhookDispatcher(WebhookConfig{})
+	assert.Nil(t, d)
+	d.Notify(context.Background(), &pb.IncidentResponse{Id: "a"})
+}
+
+func TestIsRetryableWebhookError(t *testing.T) {
+	assert.True(t, isRetryableWebhookError(&webhookStatusError{code: http.StatusBadGateway}))
+	assert.True(t, isRetryableWebhookError(&webhookStatusError{code: http.StatusTooManyRequests}))
+	assert.False(t, isRetryableWebhookError(&webhookStatusError{code: http.StatusBadRequest}))
+	assert.False(t, isRetryableWebhookError(context.Canceled))
+	assert.True(t, isRetryableWebhookError(io.ErrUnexpectedEOF))
+}
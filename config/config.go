@@ -2,6 +2,7 @@ package config
 
 import (
 	"context"
+	"time"
 
 	cbConfig "github.com/go-coldbrew/core/config"
 	"github.com/go-coldbrew/log"
@@ -12,9 +13,39 @@ var defaultConfig Config
 
 type Config struct {
 	cbConfig.Config
-	PanicOnConfigError bool   `envconfig:"PANIC_ON_CONFIG_ERROR" default:"true"`
-	DatabaseURL        string `envconfig:"DATABASE_URL" required:"true"`
-	Prefix string `envconfig:"PREFIX" default:"got"`
+	PanicOnConfigError      bool          `envconfig:"PANIC_ON_CONFIG_ERROR" default:"true"`
+	DatabaseURL             string        `envconfig:"DATABASE_URL" required:"true"`
+	DatabaseReadonlyURL     string        `envconfig:"DATABASE_READONLY_URL"`
+	DBSchema                string        `envconfig:"DB_SCHEMA" default:"public"`
+	RunMigrations           bool          `envconfig:"RUN_MIGRATIONS" default:"false"`
+	DBRetryAttempts         int           `envconfig:"DB_RETRY_ATTEMPTS" default:"3"`
+	DBRetryBaseDelay        time.Duration `envconfig:"DB_RETRY_BASE_DELAY" default:"50ms"`
+	DBConnectRetries        int           `envconfig:"DB_CONNECT_RETRIES" default:"10"`
+	DBConnectRetryDelay     time.Duration `envconfig:"DB_CONNECT_RETRY_DELAY" default:"1s"`
+	SlowQueryMS             int           `envconfig:"SLOW_QUERY_MS" default:"500"`
+	IncidentStatuses        []string      `envconfig:"INCIDENT_STATUSES" default:"open,investigating,resolved"`
+	ResolvedIncidentStatus  string        `envconfig:"RESOLVED_INCIDENT_STATUS" default:"resolved"`
+	GradeMaxIncidents       []int32       `envconfig:"GRADE_MAX_INCIDENTS" default:"0,2,5,10"`
+	GradeMaxDowntime        []int32       `envconfig:"GRADE_MAX_DOWNTIME_MINUTES" default:"0,60,180,480"`
+	MaxRequestBytes         int           `envconfig:"MAX_REQUEST_BYTES" default:"4194304"`
+	AdminRPCsEnabled        bool          `envconfig:"ADMIN_RPCS_ENABLED" default:"false"`
+	AggregationInterval     time.Duration `envconfig:"AGGREGATION_INTERVAL" default:"0"`
+	IncidentFutureTolerance time.Duration `envconfig:"INCIDENT_FUTURE_TOLERANCE" default:"0"`
+	IncidentStrictInsert    bool          `envconfig:"INCIDENT_STRICT_INSERT" default:"false"`
+	BreakerFailureThreshold int           `envconfig:"BREAKER_FAILURE_THRESHOLD" default:"5"`
+	BreakerCooldown         time.Duration `envconfig:"BREAKER_COOLDOWN" default:"30s"`
+	DisplayTimezone         string        `envconfig:"DISPLAY_TIMEZONE" default:"UTC"`
+	IncidentRetentionDays   int           `envconfig:"INCIDENT_RETENTION_DAYS" default:"0"`
+	IncidentPurgeInterval   time.Duration `envconfig:"INCIDENT_PURGE_INTERVAL" default:"0"`
+	EnableGRPCReflection    bool          `envconfig:"ENABLE_GRPC_REFLECTION" default:"false"`
+	NetworkOverviewCacheTTL time.Duration `envconfig:"NETWORK_OVERVIEW_CACHE_TTL" default:"30s"`
+	AnalyticsCacheTTL       time.Duration `envconfig:"ANALYTICS_CACHE_TTL" default:"30s"`
+	MaxListLimit            int32         `envconfig:"MAX_LIST_LIMIT" default:"1000"`
+	IncidentWebhookURL      string        `envconfig:"INCIDENT_WEBHOOK_URL"`
+	IncidentWebhookMinDur   int32         `envconfig:"INCIDENT_WEBHOOK_MIN_DURATION_MINUTES" default:"30"`
+	IncidentWebhookQueue    int           `envconfig:"INCIDENT_WEBHOOK_QUEUE_SIZE" default:"100"`
+	IncidentWebhookAttempts int           `envconfig:"INCIDENT_WEBHOOK_ATTEMPTS" default:"3"`
+	AutoMaintenanceMinDur   int32         `envconfig:"AUTO_MAINTENANCE_MIN_DURATION_MINUTES" default:"60"`
 }
 
 func init() {
@@ -1,6 +1,11 @@
 
-CREATE EXTENSION IF NOT EXISTS "uuid-ossp";
+CREATE EXTENSION IF NOT EXISTS "uuid-ossp" WITH SCHEMA public;
 
+DROP TABLE IF EXISTS incident_tags CASCADE;
+DROP TABLE IF EXISTS tags CASCADE;
+DROP TABLE IF EXISTS station_status_history CASCADE;
+DROP TABLE IF EXISTS incident_audit CASCADE;
+DROP TABLE IF EXISTS station_lines CASCADE;
 DROP TABLE IF EXISTS incidents CASCADE;
 DROP TABLE IF EXISTS stations CASCADE;
 DROP TABLE IF EXISTS lines CASCADE;
@@ -8,6 +13,8 @@ DROP TABLE IF EXISTS lines CASCADE;
 CREATE TABLE lines (
     id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
     name TEXT UNIQUE NOT NULL CHECK (LENGTH(TRIM(name)) > 0 AND LENGTH(name) <= 100),
+    color TEXT CHECK (color ~ '^#[0-9A-Fa-f]{6}$'),
+    display_order INT CHECK (display_order >= 0),
     created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
 );
 
@@ -16,8 +23,18 @@ CREATE TABLE stations (
     name TEXT NOT NULL CHECK (LENGTH(TRIM(name)) > 0 AND LENGTH(name) <= 100),
     line_id UUID NOT NULL REFERENCES lines(id) ON DELETE CASCADE,
     status TEXT NOT NULL DEFAULT 'active' CHECK (status IN ('active', 'inactive', 'maintenance', 'closed')),
+    latitude DOUBLE PRECISION CHECK (latitude BETWEEN -90 AND 90),
+    longitude DOUBLE PRECISION CHECK (longitude BETWEEN -180 AND 180),
     created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
-    UNIQUE(name, line_id)
+    deleted_at TIMESTAMPTZ
+);
+
+CREATE TABLE station_lines (
+    station_id UUID NOT NULL REFERENCES stations(id) ON DELETE CASCADE,
+    line_id UUID NOT NULL REFERENCES lines(id) ON DELETE CASCADE,
+    position INT CHECK (position >= 0),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (station_id, line_id)
 );
 
 CREATE TABLE incidents (
@@ -27,18 +44,62 @@ CREATE TABLE incidents (
     ts TIMESTAMPTZ NOT NULL,
     duration_minutes INT NOT NULL,
     incident_type TEXT NOT NULL CHECK (incident_type IN ('mechanical', 'power', 'signal', 'weather', 'other')),
-    status TEXT NOT NULL DEFAULT 'open' CHECK (status IN ('open', 'investigating', 'resolved', 'closed')),
+    status TEXT NOT NULL DEFAULT 'open',
+    source VARCHAR(50),
     created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
     UNIQUE(station_id, line_id, ts)
 );
 
+CREATE TABLE tags (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    name TEXT UNIQUE NOT NULL CHECK (LENGTH(TRIM(name)) > 0 AND LENGTH(name) <= 50),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE TABLE incident_tags (
+    incident_id UUID NOT NULL REFERENCES incidents(id) ON DELETE CASCADE,
+    tag_id UUID NOT NULL REFERENCES tags(id) ON DELETE CASCADE,
+    PRIMARY KEY (incident_id, tag_id)
+);
+
+CREATE TABLE station_status_history (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    station_id UUID NOT NULL REFERENCES stations(id) ON DELETE CASCADE,
+    status TEXT NOT NULL,
+    changed_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE TABLE incident_audit (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    incident_id UUID NOT NULL,
+    action TEXT NOT NULL,
+    old_values JSONB,
+    new_values JSONB,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
 CREATE INDEX idx_incidents_ts ON incidents(ts DESC);
-CREATE INDEX idx_incidents_station_id ON incidents(station_id);
-CREATE INDEX idx_incidents_line_id ON incidents(line_id);
 CREATE INDEX idx_incidents_line_ts ON incidents(line_id, ts DESC);
 CREATE INDEX idx_incidents_station_ts ON incidents(station_id, ts DESC);
 CREATE INDEX idx_incidents_status ON incidents(status);
 CREATE INDEX idx_stations_status ON stations(status);
+CREATE UNIQUE INDEX idx_stations_name_line_active ON stations(name, line_id) WHERE deleted_at IS NULL;
+CREATE UNIQUE INDEX idx_lines_name_lower ON lines(LOWER(name));
+CREATE INDEX idx_station_lines_line_id ON station_lines(line_id);
+CREATE INDEX idx_incident_audit_incident_id ON incident_audit(incident_id, created_at);
+CREATE INDEX idx_station_status_history_station ON station_status_history(station_id, changed_at);
+CREATE INDEX idx_incident_tags_tag_id ON incident_tags(tag_id);
+
+CREATE MATERIALIZED VIEW monthly_line_incidents AS
+SELECT
+    line_id,
+    date_trunc('month', ts AT TIME ZONE 'UTC') AS month,
+    COUNT(*)::int AS incident_count,
+    COALESCE(SUM(duration_minutes), 0)::int AS total_downtime_minutes
+FROM incidents
+GROUP BY line_id, date_trunc('month', ts AT TIME ZONE 'UTC');
+
+CREATE UNIQUE INDEX idx_monthly_line_incidents ON monthly_line_incidents(line_id, month);
 
 INSERT INTO lines (name) VALUES
     ('North South Line'),
@@ -337,6 +398,12 @@ SELECT 'Sengkang', id FROM lines WHERE name = 'North East Line'
 UNION ALL
 SELECT 'Punggol', id FROM lines WHERE name = 'North East Line';
 
+INSERT INTO station_lines (station_id, line_id)
+SELECT id, line_id FROM stations;
+
+INSERT INTO station_status_history (station_id, status, changed_at)
+SELECT id, status, created_at FROM stations;
+
 
 SELECT
     'Lines' as entity,
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS incidents CASCADE;
+DROP TABLE IF EXISTS stations CASCADE;
+DROP TABLE IF EXISTS lines CASCADE;
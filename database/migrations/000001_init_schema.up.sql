@@ -0,0 +1,36 @@
+CREATE EXTENSION IF NOT EXISTS "uuid-ossp" WITH SCHEMA public;
+
+CREATE TABLE IF NOT EXISTS lines (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    name TEXT UNIQUE NOT NULL CHECK (LENGTH(TRIM(name)) > 0 AND LENGTH(name) <= 100),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE TABLE IF NOT EXISTS stations (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    name TEXT NOT NULL CHECK (LENGTH(TRIM(name)) > 0 AND LENGTH(name) <= 100),
+    line_id UUID NOT NULL REFERENCES lines(id) ON DELETE CASCADE,
+    status TEXT NOT NULL DEFAULT 'active' CHECK (status IN ('active', 'inactive', 'maintenance', 'closed')),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    UNIQUE(name, line_id)
+);
+
+CREATE TABLE IF NOT EXISTS incidents (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    station_id UUID NOT NULL REFERENCES stations(id) ON DELETE CASCADE,
+    line_id UUID NOT NULL REFERENCES lines(id) ON DELETE CASCADE,
+    ts TIMESTAMPTZ NOT NULL,
+    duration_minutes INT NOT NULL,
+    incident_type TEXT NOT NULL CHECK (incident_type IN ('mechanical', 'power', 'signal', 'weather', 'other')),
+    status TEXT NOT NULL DEFAULT 'open' CHECK (status IN ('open', 'investigating', 'resolved', 'closed')),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    UNIQUE(station_id, line_id, ts)
+);
+
+CREATE INDEX IF NOT EXISTS idx_incidents_ts ON incidents(ts DESC);
+CREATE INDEX IF NOT EXISTS idx_incidents_station_id ON incidents(station_id);
+CREATE INDEX IF NOT EXISTS idx_incidents_line_id ON incidents(line_id);
+CREATE INDEX IF NOT EXISTS idx_incidents_line_ts ON incidents(line_id, ts DESC);
+CREATE INDEX IF NOT EXISTS idx_incidents_station_ts ON incidents(station_id, ts DESC);
+CREATE INDEX IF NOT EXISTS idx_incidents_status ON incidents(status);
+CREATE INDEX IF NOT EXISTS idx_stations_status ON stations(status);
@@ -0,0 +1 @@
+DROP INDEX IF EXISTS idx_lines_name_lower;
@@ -0,0 +1 @@
+CREATE UNIQUE INDEX IF NOT EXISTS idx_lines_name_lower ON lines(LOWER(name));
@@ -0,0 +1,12 @@
+CREATE TABLE IF NOT EXISTS station_lines (
+    station_id UUID NOT NULL REFERENCES stations(id) ON DELETE CASCADE,
+    line_id UUID NOT NULL REFERENCES lines(id) ON DELETE CASCADE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (station_id, line_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_station_lines_line_id ON station_lines(line_id);
+
+INSERT INTO station_lines (station_id, line_id)
+SELECT id, line_id FROM stations
+ON CONFLICT DO NOTHING;
@@ -0,0 +1,10 @@
+CREATE TABLE IF NOT EXISTS incident_audit (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    incident_id UUID NOT NULL,
+    action TEXT NOT NULL,
+    old_values JSONB,
+    new_values JSONB,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_incident_audit_incident_id ON incident_audit(incident_id, created_at);
@@ -0,0 +1 @@
+ALTER TABLE incidents DROP COLUMN IF EXISTS source;
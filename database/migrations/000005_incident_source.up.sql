@@ -0,0 +1 @@
+ALTER TABLE incidents ADD COLUMN IF NOT EXISTS source VARCHAR(50);
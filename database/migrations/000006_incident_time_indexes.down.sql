@@ -0,0 +1,2 @@
+CREATE INDEX IF NOT EXISTS idx_incidents_station_id ON incidents(station_id);
+CREATE INDEX IF NOT EXISTS idx_incidents_line_id ON incidents(line_id);
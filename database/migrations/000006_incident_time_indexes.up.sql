@@ -0,0 +1,6 @@
+CREATE INDEX IF NOT EXISTS idx_incidents_line_ts ON incidents(line_id, ts DESC);
+CREATE INDEX IF NOT EXISTS idx_incidents_station_ts ON incidents(station_id, ts DESC);
+
+-- Covered by the composite indexes above.
+DROP INDEX IF EXISTS idx_incidents_line_id;
+DROP INDEX IF EXISTS idx_incidents_station_id;
@@ -0,0 +1,2 @@
+ALTER TABLE lines DROP COLUMN IF EXISTS display_order;
+ALTER TABLE lines DROP COLUMN IF EXISTS color;
@@ -0,0 +1,2 @@
+ALTER TABLE lines ADD COLUMN IF NOT EXISTS color TEXT CHECK (color ~ '^#[0-9A-Fa-f]{6}$');
+ALTER TABLE lines ADD COLUMN IF NOT EXISTS display_order INT CHECK (display_order >= 0);
@@ -0,0 +1,2 @@
+ALTER TABLE stations DROP COLUMN IF EXISTS longitude;
+ALTER TABLE stations DROP COLUMN IF EXISTS latitude;
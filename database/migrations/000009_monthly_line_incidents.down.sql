@@ -0,0 +1 @@
+DROP MATERIALIZED VIEW IF EXISTS monthly_line_incidents;
@@ -0,0 +1,11 @@
+CREATE MATERIALIZED VIEW IF NOT EXISTS monthly_line_incidents AS
+SELECT
+    line_id,
+    date_trunc('month', ts AT TIME ZONE 'UTC') AS month,
+    COUNT(*)::int AS incident_count,
+    COALESCE(SUM(duration_minutes), 0)::int AS total_downtime_minutes
+FROM incidents
+GROUP BY line_id, date_trunc('month', ts AT TIME ZONE 'UTC');
+
+-- Required for REFRESH MATERIALIZED VIEW CONCURRENTLY.
+CREATE UNIQUE INDEX IF NOT EXISTS idx_monthly_line_incidents ON monthly_line_incidents(line_id, month);
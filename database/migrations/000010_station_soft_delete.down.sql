@@ -0,0 +1,4 @@
+DELETE FROM stations WHERE deleted_at IS NOT NULL;
+DROP INDEX IF EXISTS idx_stations_name_line_active;
+ALTER TABLE stations ADD CONSTRAINT stations_name_line_id_key UNIQUE (name, line_id);
+ALTER TABLE stations DROP COLUMN IF EXISTS deleted_at;
@@ -0,0 +1,3 @@
+ALTER TABLE stations ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMPTZ;
+ALTER TABLE stations DROP CONSTRAINT IF EXISTS stations_name_line_id_key;
+CREATE UNIQUE INDEX IF NOT EXISTS idx_stations_name_line_active ON stations(name, line_id) WHERE deleted_at IS NULL;
@@ -0,0 +1,12 @@
+CREATE TABLE IF NOT EXISTS station_status_history (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    station_id UUID NOT NULL REFERENCES stations(id) ON DELETE CASCADE,
+    status TEXT NOT NULL,
+    changed_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_station_status_history_station ON station_status_history(station_id, changed_at);
+
+-- History starts now: when existing stations entered their current status is unknown.
+INSERT INTO station_status_history (station_id, status)
+SELECT id, status FROM stations WHERE deleted_at IS NULL;
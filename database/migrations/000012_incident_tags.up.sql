@@ -0,0 +1,13 @@
+CREATE TABLE IF NOT EXISTS tags (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    name TEXT UNIQUE NOT NULL CHECK (LENGTH(TRIM(name)) > 0 AND LENGTH(name) <= 50),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE TABLE IF NOT EXISTS incident_tags (
+    incident_id UUID NOT NULL REFERENCES incidents(id) ON DELETE CASCADE,
+    tag_id UUID NOT NULL REFERENCES tags(id) ON DELETE CASCADE,
+    PRIMARY KEY (incident_id, tag_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_incident_tags_tag_id ON incident_tags(tag_id);
@@ -0,0 +1 @@
+ALTER TABLE station_lines DROP COLUMN IF EXISTS position;
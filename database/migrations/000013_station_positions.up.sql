@@ -0,0 +1 @@
+ALTER TABLE station_lines ADD COLUMN IF NOT EXISTS position INT CHECK (position >= 0);
@@ -0,0 +1 @@
+ALTER TABLE incidents DROP CONSTRAINT IF EXISTS incidents_status_check;
@@ -0,0 +1,13 @@
+package database
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLatestVersion(t *testing.T) {
+	version, err := LatestVersion()
+	assert.NoError(t, err)
+	assert.GreaterOrEqual(t, version, uint(13))
+}
@@ -0,0 +1,86 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"regexp"
+
+	"github.com/lib/pq"
+)
+
+// DefaultSchema is the schema used when none is configured.
+const DefaultSchema = "public"
+
+// schemaPattern accepts unquoted Postgres identifiers: a letter or underscore
+// followed by letters, digits or underscores, at most 63 bytes.
+var schemaPattern = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]{0,62}$`)
+
+// ValidateSchema reports whether name is safe to use as a search_path entry.
+func ValidateSchema(name string) error {
+	if !schemaPattern.MatchString(name) {
+		return fmt.Errorf("invalid database schema %q: must start with a letter or underscore, contain only letters, digits and underscores, and be at most 63 characters", name)
+	}
+	return nil
+}
+
+// NewConnector returns a connector for the Postgres DSN that runs
+// SET search_path on every new connection, so each pooled connection
+// resolves unqualified table names in schema. public stays on the path after
+// schema because extensions such as uuid-ossp are installed once per
+// database, in public.
+func NewConnector(dsn, schema string) (driver.Connector, error) {
+	if err := ValidateSchema(schema); err != nil {
+		return nil, err
+	}
+	connector, err := pq.NewConnector(dsn)
+	if err != nil {
+		return nil, err
+	}
+	path := pq.QuoteIdentifier(schema)
+	if schema != DefaultSchema {
+		path += ", " + pq.QuoteIdentifier(DefaultSchema)
+	}
+	return &schemaConnector{
+		Connector: connector,
+		setPath:   "SET search_path TO " + path,
+	}, nil
+}
+
+// CheckSchema returns an error unless schema exists. Schemas are never
+// created by the service, so a tenant schema must exist before migrations or
+// queries run against it.
+func CheckSchema(ctx context.Context, db *sql.DB, schema string) error {
+	var exists bool
+	err := db.QueryRowContext(ctx, "SELECT EXISTS (SELECT 1 FROM pg_namespace WHERE nspname = $1)", schema).Scan(&exists)
+	if err != nil {
+		return fmt.Errorf("failed to check database schema %q: %w", schema, err)
+	}
+	if !exists {
+		return fmt.Errorf("database schema %q does not exist", schema)
+	}
+	return nil
+}
+
+type schemaConnector struct {
+	driver.Connector
+	setPath string
+}
+
+func (c *schemaConnector) Connect(ctx context.Context) (driver.Conn, error) {
+	conn, err := c.Connector.Connect(ctx)
+	if err != nil {
+		return nil, err
+	}
+	execer, ok := conn.(driver.ExecerContext)
+	if !ok {
+		conn.Close()
+		return nil, fmt.Errorf("postgres connection does not support ExecContext")
+	}
+	if _, err := execer.ExecContext(ctx, c.setPath, nil); err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("failed to set search_path: %w", err)
+	}
+	return conn, nil
+}
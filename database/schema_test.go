@@ -0,0 +1,75 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateSchema(t *testing.T) {
+	for _, name := range []string{"public", "tenant_a", "_staging", "T2"} {
+		assert.NoError(t, ValidateSchema(name), name)
+	}
+	for _, name := range []string{"", "1tenant", "tenant-a", "public; DROP TABLE incidents", `"quoted"`, "a b", string(make([]byte, 64))} {
+		assert.Error(t, ValidateSchema(name), name)
+	}
+}
+
+func TestNewConnector_RejectsInvalidSchema(t *testing.T) {
+	_, err := NewConnector("postgres://localhost/db", "public,evil")
+	assert.Error(t, err)
+}
+
+func TestNewConnector_SearchPath(t *testing.T) {
+	for schema, want := range map[string]string{
+		"public":   `SET search_path TO "public"`,
+		"tenant_a": `SET search_path TO "tenant_a", "public"`,
+	} {
+		connector, err := NewConnector("postgres://localhost/db", schema)
+		require.NoError(t, err)
+		assert.Equal(t, want, connector.(*schemaConnector).setPath, schema)
+	}
+}
+
+func TestRunMigrations_NonPublicSchema(t *testing.T) {
+	dsn := os.Getenv("TEST_DATABASE_URL")
+	if dsn == "" {
+		t.Skip("TEST_DATABASE_URL not set")
+	}
+	ctx := context.Background()
+
+	admin, err := sql.Open("postgres", dsn)
+	require.NoError(t, err)
+	t.Cleanup(func() { admin.Close() })
+
+	schema := fmt.Sprintf("tenant_%d", time.Now().UnixNano())
+	connector, err := NewConnector(dsn, schema)
+	require.NoError(t, err)
+	db := sql.OpenDB(connector)
+	t.Cleanup(func() { db.Close() })
+	assert.Error(t, CheckSchema(ctx, admin, schema), "schema does not exist yet")
+
+	_, err = admin.ExecContext(ctx, "CREATE SCHEMA "+pq.QuoteIdentifier(schema))
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_, _ = admin.ExecContext(ctx, "DROP SCHEMA "+pq.QuoteIdentifier(schema)+" CASCADE")
+	})
+	require.NoError(t, CheckSchema(ctx, db, schema))
+
+	require.NoError(t, RunMigrations(db))
+
+	// uuid_generate_v4 lives in public and must still resolve.
+	_, err = db.ExecContext(ctx, "INSERT INTO lines (name) VALUES ('Tenant Line')")
+	require.NoError(t, err)
+	var count int
+	err = admin.QueryRowContext(ctx, "SELECT COUNT(*) FROM "+pq.QuoteIdentifier(schema)+".lines").Scan(&count)
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+}
@@ -2,11 +2,14 @@ package main
 
 import (
 	"context"
+	"database/sql"
 	"mime"
 	"net/http"
+	"time"
 
 	"github.com/bluesg/transport-analytics/backend"
 	"github.com/bluesg/transport-analytics/config"
+	"github.com/bluesg/transport-analytics/database"
 	myapp "github.com/bluesg/transport-analytics/proto"
 	"github.com/bluesg/transport-analytics/version"
 	"github.com/go-coldbrew/core"
@@ -17,24 +20,56 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/metadata"
 	healthgrpc "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/reflection"
 	"google.golang.org/protobuf/proto"
 
 	openapi "github.com/bluesg/transport-analytics/third_party/OpenAPI"
 )
 
 type cbSvc struct {
-	stopper      core.CBStopper
-	db           *sqlx.DB
-	transportSvc *backend.Service
+	stopper        core.CBStopper
+	db             *sqlx.DB
+	readDB         *sqlx.DB
+	transportSvc   *backend.Service
+	breaker        *backend.CircuitBreaker
+	stopAggregator context.CancelFunc
+	aggregatorDone chan struct{}
+	stopPurge      context.CancelFunc
+	purgeDone      chan struct{}
+	stopWebhook    context.CancelFunc
+	webhookDone    chan struct{}
 }
 
+// FailCheck is called by coldbrew at the start of a graceful shutdown. It
+// fails the health and readiness probes so load balancers stop routing new
+// traffic while in-flight requests drain. The write circuit breaker is left
+// to database failure detection.
 func (s *cbSvc) FailCheck(fail bool) {
+	if s.transportSvc != nil {
+		s.transportSvc.SetFailing(fail)
+	}
 }
 
 func (s *cbSvc) Stop() {
+	// Let an in-flight refresh finish before the pools are closed.
+	if s.stopAggregator != nil {
+		s.stopAggregator()
+		<-s.aggregatorDone
+	}
+	if s.stopPurge != nil {
+		s.stopPurge()
+		<-s.purgeDone
+	}
+	if s.stopWebhook != nil {
+		s.stopWebhook()
+		<-s.webhookDone
+	}
 	if s.db != nil {
 		s.db.Close()
 	}
+	if s.readDB != nil {
+		s.readDB.Close()
+	}
 	if s.stopper != nil {
 		s.stopper.Stop()
 	}
@@ -50,7 +85,15 @@ func (s *cbSvc) InitHTTP(ctx context.Context, mux *runtime.ServeMux, endpoint st
 }
 
 func (s *cbSvc) HTTPMiddleware() func(http.Handler) http.Handler {
+	cfg := config.Get()
+	maxBytes := int64(cfg.MaxRequestBytes)
+	displayLoc, err := time.LoadLocation(cfg.DisplayTimezone)
+	if err != nil {
+		log.Error(context.Background(), "Invalid display timezone, using UTC", "timezone", cfg.DisplayTimezone, "error", err)
+		displayLoc = time.UTC
+	}
 	return func(next http.Handler) http.Handler {
+		handler := backend.MaxBodySize(maxBytes)(backend.FormIncidents(backend.DisplayTimezone(displayLoc)(backend.ConditionalGET(next))))
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			// Set CORS headers for ALL requests
 			w.Header().Set("Access-Control-Allow-Origin", "http://localhost:3000")
@@ -58,13 +101,15 @@ func (s *cbSvc) HTTPMiddleware() func(http.Handler) http.Handler {
 			w.Header().Set("Access-Control-Allow-Headers", "*")
 			w.Header().Set("Access-Control-Allow-Credentials", "true")
 
+			w.Header().Set("Access-Control-Expose-Headers", "ETag, "+backend.DisplayTimezoneHeader)
+
 			// Handle CORS preflight requests
 			if r.Method == "OPTIONS" {
 				w.WriteHeader(http.StatusNoContent)
 				return
 			}
 
-			next.ServeHTTP(w, r)
+			handler.ServeHTTP(w, r)
 		})
 	}
 }
@@ -72,6 +117,8 @@ func (s *cbSvc) HTTPMiddleware() func(http.Handler) http.Handler {
 func (s *cbSvc) GRPCGatewayMuxOptions() []runtime.ServeMuxOption {
 	return []runtime.ServeMuxOption{
 		runtime.WithForwardResponseOption(addCORSHeaders),
+		runtime.WithForwardResponseOption(backend.SetETag),
+		runtime.WithForwardResponseOption(backend.FormatDisplayLabels),
 		runtime.WithErrorHandler(func(ctx context.Context, mux *runtime.ServeMux, marshaler runtime.Marshaler, w http.ResponseWriter, r *http.Request, err error) {
 			// Add CORS headers even for errors
 			w.Header().Set("Access-Control-Allow-Origin", "http://localhost:3000")
@@ -99,7 +146,7 @@ func addCORSHeaders(ctx context.Context, w http.ResponseWriter, _ proto.Message)
 func (s *cbSvc) InitGRPC(ctx context.Context, server *grpc.Server) error {
 	cfg := config.Get()
 
-	db, err := sqlx.Connect("postgres", cfg.DatabaseURL)
+	db, err := connectDB(ctx, "primary", cfg.DatabaseURL, cfg.DBSchema, cfg.DBConnectRetries, cfg.DBConnectRetryDelay)
 	if err != nil {
 		log.Error(ctx, "Failed to connect to database", "error", err)
 		return err
@@ -109,20 +156,174 @@ func (s *cbSvc) InitGRPC(ctx context.Context, server *grpc.Server) error {
 	s.db.SetMaxOpenConns(25)
 	s.db.SetMaxIdleConns(5)
 
-	log.Info(ctx, "Database connection established")
+	log.Info(ctx, "Database connection established", "schema", cfg.DBSchema)
+
+	if cfg.DatabaseReadonlyURL != "" {
+		readDB, err := connectDB(ctx, "replica", cfg.DatabaseReadonlyURL, cfg.DBSchema, cfg.DBConnectRetries, cfg.DBConnectRetryDelay)
+		if err != nil {
+			log.Error(ctx, "Failed to connect to read replica", "error", err)
+			return err
+		}
+		s.readDB = readDB
+
+		s.readDB.SetMaxOpenConns(25)
+		s.readDB.SetMaxIdleConns(5)
+
+		log.Info(ctx, "Read replica connection established")
+	}
+
+	if cfg.RunMigrations {
+		if err := database.RunMigrations(db.DB); err != nil {
+			log.Error(ctx, "Failed to run database migrations", "error", err)
+			return err
+		}
+		log.Info(ctx, "Database migrations applied")
+	}
+
+	s.breaker = backend.NewCircuitBreaker(backend.CircuitBreakerConfig{
+		FailureThreshold: cfg.BreakerFailureThreshold,
+		Cooldown:         cfg.BreakerCooldown,
+	}, db.PingContext)
+
+	repo := backend.NewRepository(db, s.readDB, backend.RepositoryConfig{
+		Retry: backend.RetryConfig{
+			MaxAttempts: cfg.DBRetryAttempts,
+			BaseDelay:   cfg.DBRetryBaseDelay,
+		},
+		SlowQueryThreshold:   time.Duration(cfg.SlowQueryMS) * time.Millisecond,
+		StrictIncidentInsert: cfg.IncidentStrictInsert,
+		Breaker:              s.breaker,
+	})
+	incidentStatuses, resolvedStatus, err := backend.NewIncidentStatuses(cfg.IncidentStatuses, cfg.ResolvedIncidentStatus)
+	if err != nil {
+		log.Error(ctx, "Invalid incident statuses", "error", err)
+		return err
+	}
+	gradeThresholds, err := backend.NewGradeThresholds(cfg.GradeMaxIncidents, cfg.GradeMaxDowntime)
+	if err != nil {
+		log.Error(ctx, "Invalid station grade thresholds", "error", err)
+		return err
+	}
+
+	webhook := backend.NewWebhookDispatcher(backend.WebhookConfig{
+		URL:                cfg.IncidentWebhookURL,
+		MinDurationMinutes: cfg.IncidentWebhookMinDur,
+		QueueSize:          cfg.IncidentWebhookQueue,
+		Retry: backend.RetryConfig{
+			MaxAttempts: cfg.IncidentWebhookAttempts,
+			BaseDelay:   time.Second,
+		},
+	})
+
+	schemaVersion, err := database.LatestVersion()
+	if err != nil {
+		log.Error(ctx, "Failed to read migration versions", "error", err)
+		return err
+	}
+
+	s.transportSvc = backend.NewService(repo, backend.ServiceConfig{
+		AppName:                    appName(),
+		IncidentStatuses:           incidentStatuses,
+		ResolvedStatus:             resolvedStatus,
+		GradeThresholds:            gradeThresholds,
+		AdminEnabled:               cfg.AdminRPCsEnabled,
+		IncidentFutureTolerance:    cfg.IncidentFutureTolerance,
+		IncidentRetentionDays:      cfg.IncidentRetentionDays,
+		NetworkOverviewCacheTTL:    cfg.NetworkOverviewCacheTTL,
+		AnalyticsCacheTTL:          cfg.AnalyticsCacheTTL,
+		MaxListLimit:               cfg.MaxListLimit,
+		IncidentWebhook:            webhook,
+		AutoMaintenanceMinDuration: cfg.AutoMaintenanceMinDur,
+		SchemaVersion:              schemaVersion,
+	})
 
-	repo := backend.NewRepository(db)
-	s.transportSvc = backend.NewService(repo)
+	desc := backend.WithCircuitBreaker(backend.WithPanicRecovery(&myapp.TransportAnalytics_ServiceDesc), s.breaker)
+	server.RegisterService(backend.WithMaxRequestSize(desc, cfg.MaxRequestBytes), s.transportSvc)
 
-	myapp.RegisterTransportAnalyticsServer(server, s.transportSvc)
+	if cfg.AggregationInterval > 0 {
+		aggCtx, cancel := context.WithCancel(context.Background())
+		s.stopAggregator = cancel
+		s.aggregatorDone = make(chan struct{})
+		go func() {
+			defer close(s.aggregatorDone)
+			backend.RunAggregator(aggCtx, cfg.AggregationInterval, repo.RefreshSummaries)
+		}()
+	}
+
+	if cfg.IncidentPurgeInterval > 0 && cfg.IncidentRetentionDays > 0 {
+		purgeCtx, cancel := context.WithCancel(context.Background())
+		s.stopPurge = cancel
+		s.purgeDone = make(chan struct{})
+		go func() {
+			defer close(s.purgeDone)
+			backend.RunIncidentPurge(purgeCtx, cfg.IncidentPurgeInterval, s.transportSvc.PurgeExpiredIncidents)
+		}()
+	}
+
+	if webhook != nil {
+		webhookCtx, cancel := context.WithCancel(context.Background())
+		s.stopWebhook = cancel
+		s.webhookDone = make(chan struct{})
+		go func() {
+			defer close(s.webhookDone)
+			webhook.Run(webhookCtx)
+		}()
+	}
 
 	healthgrpc.RegisterHealthServer(server, &healthService{})
 
+	if cfg.EnableGRPCReflection {
+		reflection.Register(server)
+		log.Info(ctx, "gRPC server reflection enabled")
+	}
+
 	log.Info(ctx, "Transport analytics assessment registered")
 
 	return nil
 }
 
+// connectDB connects to Postgres, retrying up to retries more times so the
+// service can start before the database is reachable. The delay doubles after
+// each failed attempt, capped at 30 seconds. Every pooled connection uses
+// schema as its search_path.
+func connectDB(ctx context.Context, name, url, schema string, retries int, delay time.Duration) (*sqlx.DB, error) {
+	const maxDelay = 30 * time.Second
+
+	connector, err := database.NewConnector(url, schema)
+	if err != nil {
+		return nil, err
+	}
+
+	for attempt := 1; ; attempt++ {
+		db := sqlx.NewDb(sql.OpenDB(connector), "postgres")
+		err = db.PingContext(ctx)
+		if err == nil {
+			if err := database.CheckSchema(ctx, db.DB, schema); err != nil {
+				db.Close()
+				return nil, err
+			}
+			return db, nil
+		}
+		db.Close()
+		if attempt > retries {
+			return nil, err
+		}
+
+		log.Warn(ctx, "Database not ready, retrying",
+			"database", name,
+			"attempt", attempt,
+			"retry_in", delay.String(),
+			"error", err)
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(delay):
+		}
+		delay = min(delay*2, maxDelay)
+	}
+}
+
 type healthService struct {
 	healthgrpc.UnimplementedHealthServer
 }
@@ -141,12 +342,20 @@ func getOpenAPIHandler() http.Handler {
 	return http.FileServer(http.FS(openapi.ContentFS))
 }
 
+func appName() string {
+	if name := config.GetColdBrewConfig().AppName; name != "" {
+		return name
+	}
+	return backend.DefaultAppName
+}
+
 func main() {
 	cfg := config.GetColdBrewConfig()
-	if cfg.AppName == "" {
-		cfg.AppName = "backend-analytics"
-	}
+	cfg.AppName = appName()
 	cfg.ReleaseName = version.GitCommit
+	// Reflection is registered by InitGRPC only when ENABLE_GRPC_REFLECTION
+	// is set, so coldbrew must not register it unconditionally as well.
+	cfg.DisableGRPCReflection = true
 
 	cb := core.New(cfg)
 	cb.SetOpenAPIHandler(getOpenAPIHandler())
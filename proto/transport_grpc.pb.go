@@ -21,22 +21,63 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	TransportAnalytics_HealthCheck_FullMethodName          = "/com.bluesg.transport.TransportAnalytics/HealthCheck"
-	TransportAnalytics_ReadyCheck_FullMethodName           = "/com.bluesg.transport.TransportAnalytics/ReadyCheck"
-	TransportAnalytics_CreateIncident_FullMethodName       = "/com.bluesg.transport.TransportAnalytics/CreateIncident"
-	TransportAnalytics_GetTopBreakdowns_FullMethodName     = "/com.bluesg.transport.TransportAnalytics/GetTopBreakdowns"
-	TransportAnalytics_GetMTBF_FullMethodName              = "/com.bluesg.transport.TransportAnalytics/GetMTBF"
-	TransportAnalytics_GetRecentDisruptions_FullMethodName = "/com.bluesg.transport.TransportAnalytics/GetRecentDisruptions"
-	TransportAnalytics_CreateLine_FullMethodName           = "/com.bluesg.transport.TransportAnalytics/CreateLine"
-	TransportAnalytics_ListLines_FullMethodName            = "/com.bluesg.transport.TransportAnalytics/ListLines"
-	TransportAnalytics_GetLine_FullMethodName              = "/com.bluesg.transport.TransportAnalytics/GetLine"
-	TransportAnalytics_UpdateLine_FullMethodName           = "/com.bluesg.transport.TransportAnalytics/UpdateLine"
-	TransportAnalytics_DeleteLine_FullMethodName           = "/com.bluesg.transport.TransportAnalytics/DeleteLine"
-	TransportAnalytics_CreateStation_FullMethodName        = "/com.bluesg.transport.TransportAnalytics/CreateStation"
-	TransportAnalytics_ListStations_FullMethodName         = "/com.bluesg.transport.TransportAnalytics/ListStations"
-	TransportAnalytics_GetStation_FullMethodName           = "/com.bluesg.transport.TransportAnalytics/GetStation"
-	TransportAnalytics_UpdateStation_FullMethodName        = "/com.bluesg.transport.TransportAnalytics/UpdateStation"
-	TransportAnalytics_DeleteStation_FullMethodName        = "/com.bluesg.transport.TransportAnalytics/DeleteStation"
+	TransportAnalytics_HealthCheck_FullMethodName                   = "/com.bluesg.transport.TransportAnalytics/HealthCheck"
+	TransportAnalytics_ReadyCheck_FullMethodName                    = "/com.bluesg.transport.TransportAnalytics/ReadyCheck"
+	TransportAnalytics_CreateIncident_FullMethodName                = "/com.bluesg.transport.TransportAnalytics/CreateIncident"
+	TransportAnalytics_ValidateIncident_FullMethodName              = "/com.bluesg.transport.TransportAnalytics/ValidateIncident"
+	TransportAnalytics_UpdateIncidentStatus_FullMethodName          = "/com.bluesg.transport.TransportAnalytics/UpdateIncidentStatus"
+	TransportAnalytics_UpdateIncident_FullMethodName                = "/com.bluesg.transport.TransportAnalytics/UpdateIncident"
+	TransportAnalytics_ReassignIncident_FullMethodName              = "/com.bluesg.transport.TransportAnalytics/ReassignIncident"
+	TransportAnalytics_ResolveIncident_FullMethodName               = "/com.bluesg.transport.TransportAnalytics/ResolveIncident"
+	TransportAnalytics_DeleteIncident_FullMethodName                = "/com.bluesg.transport.TransportAnalytics/DeleteIncident"
+	TransportAnalytics_GetIncidentHistory_FullMethodName            = "/com.bluesg.transport.TransportAnalytics/GetIncidentHistory"
+	TransportAnalytics_ListIncidentsByTag_FullMethodName            = "/com.bluesg.transport.TransportAnalytics/ListIncidentsByTag"
+	TransportAnalytics_GetTopBreakdowns_FullMethodName              = "/com.bluesg.transport.TransportAnalytics/GetTopBreakdowns"
+	TransportAnalytics_GetMTBF_FullMethodName                       = "/com.bluesg.transport.TransportAnalytics/GetMTBF"
+	TransportAnalytics_GetStationMTBF_FullMethodName                = "/com.bluesg.transport.TransportAnalytics/GetStationMTBF"
+	TransportAnalytics_GetWeightedMTBF_FullMethodName               = "/com.bluesg.transport.TransportAnalytics/GetWeightedMTBF"
+	TransportAnalytics_GetRecentDisruptions_FullMethodName          = "/com.bluesg.transport.TransportAnalytics/GetRecentDisruptions"
+	TransportAnalytics_GetSegmentIncidents_FullMethodName           = "/com.bluesg.transport.TransportAnalytics/GetSegmentIncidents"
+	TransportAnalytics_GetRollingIncidentAverage_FullMethodName     = "/com.bluesg.transport.TransportAnalytics/GetRollingIncidentAverage"
+	TransportAnalytics_GetCumulativeIncidents_FullMethodName        = "/com.bluesg.transport.TransportAnalytics/GetCumulativeIncidents"
+	TransportAnalytics_GetBusiestPeriod_FullMethodName              = "/com.bluesg.transport.TransportAnalytics/GetBusiestPeriod"
+	TransportAnalytics_GetIncidentMatrix_FullMethodName             = "/com.bluesg.transport.TransportAnalytics/GetIncidentMatrix"
+	TransportAnalytics_GetIncidentTypeByHour_FullMethodName         = "/com.bluesg.transport.TransportAnalytics/GetIncidentTypeByHour"
+	TransportAnalytics_GetIncidentStatusCounts_FullMethodName       = "/com.bluesg.transport.TransportAnalytics/GetIncidentStatusCounts"
+	TransportAnalytics_GetIncidentsDuringMaintenance_FullMethodName = "/com.bluesg.transport.TransportAnalytics/GetIncidentsDuringMaintenance"
+	TransportAnalytics_GetLongestIncidents_FullMethodName           = "/com.bluesg.transport.TransportAnalytics/GetLongestIncidents"
+	TransportAnalytics_GetConcurrentIncidents_FullMethodName        = "/com.bluesg.transport.TransportAnalytics/GetConcurrentIncidents"
+	TransportAnalytics_GetNetworkOverview_FullMethodName            = "/com.bluesg.transport.TransportAnalytics/GetNetworkOverview"
+	TransportAnalytics_GetDurationPercentiles_FullMethodName        = "/com.bluesg.transport.TransportAnalytics/GetDurationPercentiles"
+	TransportAnalytics_GetLongestQuietPeriod_FullMethodName         = "/com.bluesg.transport.TransportAnalytics/GetLongestQuietPeriod"
+	TransportAnalytics_GetSLABreaches_FullMethodName                = "/com.bluesg.transport.TransportAnalytics/GetSLABreaches"
+	TransportAnalytics_GetLineTypeDistribution_FullMethodName       = "/com.bluesg.transport.TransportAnalytics/GetLineTypeDistribution"
+	TransportAnalytics_GetLineTopIncidentType_FullMethodName        = "/com.bluesg.transport.TransportAnalytics/GetLineTopIncidentType"
+	TransportAnalytics_GetTimeSinceLastIncident_FullMethodName      = "/com.bluesg.transport.TransportAnalytics/GetTimeSinceLastIncident"
+	TransportAnalytics_GetStationGrades_FullMethodName              = "/com.bluesg.transport.TransportAnalytics/GetStationGrades"
+	TransportAnalytics_GetIncidentsForMap_FullMethodName            = "/com.bluesg.transport.TransportAnalytics/GetIncidentsForMap"
+	TransportAnalytics_GetStationVsLineAverage_FullMethodName       = "/com.bluesg.transport.TransportAnalytics/GetStationVsLineAverage"
+	TransportAnalytics_GetStationStatusSummary_FullMethodName       = "/com.bluesg.transport.TransportAnalytics/GetStationStatusSummary"
+	TransportAnalytics_CreateLine_FullMethodName                    = "/com.bluesg.transport.TransportAnalytics/CreateLine"
+	TransportAnalytics_ListLines_FullMethodName                     = "/com.bluesg.transport.TransportAnalytics/ListLines"
+	TransportAnalytics_GetLine_FullMethodName                       = "/com.bluesg.transport.TransportAnalytics/GetLine"
+	TransportAnalytics_UpdateLine_FullMethodName                    = "/com.bluesg.transport.TransportAnalytics/UpdateLine"
+	TransportAnalytics_DeleteLine_FullMethodName                    = "/com.bluesg.transport.TransportAnalytics/DeleteLine"
+	TransportAnalytics_GetLineSummary_FullMethodName                = "/com.bluesg.transport.TransportAnalytics/GetLineSummary"
+	TransportAnalytics_PreviewLineDeletion_FullMethodName           = "/com.bluesg.transport.TransportAnalytics/PreviewLineDeletion"
+	TransportAnalytics_CreateStation_FullMethodName                 = "/com.bluesg.transport.TransportAnalytics/CreateStation"
+	TransportAnalytics_ListStations_FullMethodName                  = "/com.bluesg.transport.TransportAnalytics/ListStations"
+	TransportAnalytics_GetStation_FullMethodName                    = "/com.bluesg.transport.TransportAnalytics/GetStation"
+	TransportAnalytics_GetStationWithIncidents_FullMethodName       = "/com.bluesg.transport.TransportAnalytics/GetStationWithIncidents"
+	TransportAnalytics_GetStationDetails_FullMethodName             = "/com.bluesg.transport.TransportAnalytics/GetStationDetails"
+	TransportAnalytics_UpdateStation_FullMethodName                 = "/com.bluesg.transport.TransportAnalytics/UpdateStation"
+	TransportAnalytics_DeleteStation_FullMethodName                 = "/com.bluesg.transport.TransportAnalytics/DeleteStation"
+	TransportAnalytics_MergeStations_FullMethodName                 = "/com.bluesg.transport.TransportAnalytics/MergeStations"
+	TransportAnalytics_ReorderStations_FullMethodName               = "/com.bluesg.transport.TransportAnalytics/ReorderStations"
+	TransportAnalytics_StreamIncidentsExport_FullMethodName         = "/com.bluesg.transport.TransportAnalytics/StreamIncidentsExport"
+	TransportAnalytics_FindOrphanedIncidents_FullMethodName         = "/com.bluesg.transport.TransportAnalytics/FindOrphanedIncidents"
+	TransportAnalytics_NormalizeStationStatuses_FullMethodName      = "/com.bluesg.transport.TransportAnalytics/NormalizeStationStatuses"
+	TransportAnalytics_PurgeOldIncidents_FullMethodName             = "/com.bluesg.transport.TransportAnalytics/PurgeOldIncidents"
 )
 
 // TransportAnalyticsClient is the client API for TransportAnalytics service.
@@ -46,19 +87,60 @@ type TransportAnalyticsClient interface {
 	HealthCheck(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*httpbody.HttpBody, error)
 	ReadyCheck(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*httpbody.HttpBody, error)
 	CreateIncident(ctx context.Context, in *CreateIncidentRequest, opts ...grpc.CallOption) (*IncidentResponse, error)
+	ValidateIncident(ctx context.Context, in *CreateIncidentRequest, opts ...grpc.CallOption) (*ValidateIncidentResponse, error)
+	UpdateIncidentStatus(ctx context.Context, in *UpdateIncidentStatusRequest, opts ...grpc.CallOption) (*IncidentResponse, error)
+	UpdateIncident(ctx context.Context, in *UpdateIncidentRequest, opts ...grpc.CallOption) (*IncidentResponse, error)
+	ReassignIncident(ctx context.Context, in *ReassignIncidentRequest, opts ...grpc.CallOption) (*IncidentResponse, error)
+	ResolveIncident(ctx context.Context, in *ResolveIncidentRequest, opts ...grpc.CallOption) (*IncidentResponse, error)
+	DeleteIncident(ctx context.Context, in *DeleteIncidentRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetIncidentHistory(ctx context.Context, in *GetIncidentHistoryRequest, opts ...grpc.CallOption) (*IncidentHistoryResponse, error)
+	ListIncidentsByTag(ctx context.Context, in *ListIncidentsByTagRequest, opts ...grpc.CallOption) (*ListIncidentsByTagResponse, error)
 	GetTopBreakdowns(ctx context.Context, in *TopBreakdownsRequest, opts ...grpc.CallOption) (*TopBreakdownsResponse, error)
-	GetMTBF(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*MTBFResponse, error)
+	GetMTBF(ctx context.Context, in *MTBFRequest, opts ...grpc.CallOption) (*MTBFResponse, error)
+	GetStationMTBF(ctx context.Context, in *StationMTBFRequest, opts ...grpc.CallOption) (*StationMTBFResponse, error)
+	GetWeightedMTBF(ctx context.Context, in *WeightedMTBFRequest, opts ...grpc.CallOption) (*WeightedMTBFResponse, error)
 	GetRecentDisruptions(ctx context.Context, in *RecentDisruptionsRequest, opts ...grpc.CallOption) (*RecentDisruptionsResponse, error)
+	GetSegmentIncidents(ctx context.Context, in *SegmentIncidentsRequest, opts ...grpc.CallOption) (*SegmentIncidentsResponse, error)
+	GetRollingIncidentAverage(ctx context.Context, in *RollingAverageRequest, opts ...grpc.CallOption) (*RollingAverageResponse, error)
+	GetCumulativeIncidents(ctx context.Context, in *CumulativeIncidentsRequest, opts ...grpc.CallOption) (*CumulativeIncidentsResponse, error)
+	GetBusiestPeriod(ctx context.Context, in *BusiestPeriodRequest, opts ...grpc.CallOption) (*BusiestPeriodResponse, error)
+	GetIncidentMatrix(ctx context.Context, in *IncidentMatrixRequest, opts ...grpc.CallOption) (*IncidentMatrixResponse, error)
+	GetIncidentTypeByHour(ctx context.Context, in *IncidentTypeByHourRequest, opts ...grpc.CallOption) (*IncidentTypeByHourResponse, error)
+	GetIncidentStatusCounts(ctx context.Context, in *IncidentStatusCountsRequest, opts ...grpc.CallOption) (*IncidentStatusCountsResponse, error)
+	GetIncidentsDuringMaintenance(ctx context.Context, in *IncidentsDuringMaintenanceRequest, opts ...grpc.CallOption) (*IncidentsDuringMaintenanceResponse, error)
+	GetLongestIncidents(ctx context.Context, in *LongestIncidentsRequest, opts ...grpc.CallOption) (*LongestIncidentsResponse, error)
+	GetConcurrentIncidents(ctx context.Context, in *ConcurrentIncidentsRequest, opts ...grpc.CallOption) (*ConcurrentIncidentsResponse, error)
+	GetNetworkOverview(ctx context.Context, in *NetworkOverviewRequest, opts ...grpc.CallOption) (*NetworkOverviewResponse, error)
+	GetDurationPercentiles(ctx context.Context, in *DurationPercentilesRequest, opts ...grpc.CallOption) (*DurationPercentilesResponse, error)
+	GetLongestQuietPeriod(ctx context.Context, in *LongestQuietPeriodRequest, opts ...grpc.CallOption) (*LongestQuietPeriodResponse, error)
+	GetSLABreaches(ctx context.Context, in *SLABreachesRequest, opts ...grpc.CallOption) (*SLABreachesResponse, error)
+	GetLineTypeDistribution(ctx context.Context, in *LineTypeDistributionRequest, opts ...grpc.CallOption) (*LineTypeDistributionResponse, error)
+	GetLineTopIncidentType(ctx context.Context, in *LineTopIncidentTypeRequest, opts ...grpc.CallOption) (*LineTopIncidentTypeResponse, error)
+	GetTimeSinceLastIncident(ctx context.Context, in *TimeSinceLastIncidentRequest, opts ...grpc.CallOption) (*TimeSinceLastIncidentResponse, error)
+	GetStationGrades(ctx context.Context, in *StationGradesRequest, opts ...grpc.CallOption) (*StationGradesResponse, error)
+	GetIncidentsForMap(ctx context.Context, in *IncidentMapRequest, opts ...grpc.CallOption) (*IncidentMapResponse, error)
+	GetStationVsLineAverage(ctx context.Context, in *StationVsLineAverageRequest, opts ...grpc.CallOption) (*StationVsLineAverageResponse, error)
+	GetStationStatusSummary(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*StationStatusSummaryResponse, error)
 	CreateLine(ctx context.Context, in *CreateLineRequest, opts ...grpc.CallOption) (*LineResponse, error)
-	ListLines(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListLinesResponse, error)
+	ListLines(ctx context.Context, in *ListLinesRequest, opts ...grpc.CallOption) (*ListLinesResponse, error)
 	GetLine(ctx context.Context, in *GetLineRequest, opts ...grpc.CallOption) (*LineResponse, error)
 	UpdateLine(ctx context.Context, in *UpdateLineRequest, opts ...grpc.CallOption) (*LineResponse, error)
 	DeleteLine(ctx context.Context, in *DeleteLineRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetLineSummary(ctx context.Context, in *GetLineSummaryRequest, opts ...grpc.CallOption) (*LineSummaryResponse, error)
+	PreviewLineDeletion(ctx context.Context, in *PreviewLineDeletionRequest, opts ...grpc.CallOption) (*LineDeletionPreviewResponse, error)
 	CreateStation(ctx context.Context, in *CreateStationRequest, opts ...grpc.CallOption) (*StationResponse, error)
 	ListStations(ctx context.Context, in *ListStationsRequest, opts ...grpc.CallOption) (*ListStationsResponse, error)
 	GetStation(ctx context.Context, in *GetStationRequest, opts ...grpc.CallOption) (*StationResponse, error)
+	GetStationWithIncidents(ctx context.Context, in *GetStationWithIncidentsRequest, opts ...grpc.CallOption) (*StationWithIncidentsResponse, error)
+	GetStationDetails(ctx context.Context, in *GetStationDetailsRequest, opts ...grpc.CallOption) (*StationDetailsResponse, error)
 	UpdateStation(ctx context.Context, in *UpdateStationRequest, opts ...grpc.CallOption) (*StationResponse, error)
 	DeleteStation(ctx context.Context, in *DeleteStationRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	MergeStations(ctx context.Context, in *MergeStationsRequest, opts ...grpc.CallOption) (*MergeStationsResponse, error)
+	ReorderStations(ctx context.Context, in *ReorderStationsRequest, opts ...grpc.CallOption) (*ListStationsResponse, error)
+	StreamIncidentsExport(ctx context.Context, in *StreamIncidentsExportRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[IncidentResponse], error)
+	FindOrphanedIncidents(ctx context.Context, in *OrphanedIncidentsRequest, opts ...grpc.CallOption) (*OrphanedIncidentsResponse, error)
+	NormalizeStationStatuses(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*NormalizeStationStatusesResponse, error)
+	PurgeOldIncidents(ctx context.Context, in *PurgeOldIncidentsRequest, opts ...grpc.CallOption) (*PurgeOldIncidentsResponse, error)
 }
 
 type transportAnalyticsClient struct {
@@ -99,6 +181,86 @@ func (c *transportAnalyticsClient) CreateIncident(ctx context.Context, in *Creat
 	return out, nil
 }
 
+func (c *transportAnalyticsClient) ValidateIncident(ctx context.Context, in *CreateIncidentRequest, opts ...grpc.CallOption) (*ValidateIncidentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ValidateIncidentResponse)
+	err := c.cc.Invoke(ctx, TransportAnalytics_ValidateIncident_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transportAnalyticsClient) UpdateIncidentStatus(ctx context.Context, in *UpdateIncidentStatusRequest, opts ...grpc.CallOption) (*IncidentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(IncidentResponse)
+	err := c.cc.Invoke(ctx, TransportAnalytics_UpdateIncidentStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transportAnalyticsClient) UpdateIncident(ctx context.Context, in *UpdateIncidentRequest, opts ...grpc.CallOption) (*IncidentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(IncidentResponse)
+	err := c.cc.Invoke(ctx, TransportAnalytics_UpdateIncident_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transportAnalyticsClient) ReassignIncident(ctx context.Context, in *ReassignIncidentRequest, opts ...grpc.CallOption) (*IncidentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(IncidentResponse)
+	err := c.cc.Invoke(ctx, TransportAnalytics_ReassignIncident_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transportAnalyticsClient) ResolveIncident(ctx context.Context, in *ResolveIncidentRequest, opts ...grpc.CallOption) (*IncidentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(IncidentResponse)
+	err := c.cc.Invoke(ctx, TransportAnalytics_ResolveIncident_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transportAnalyticsClient) DeleteIncident(ctx context.Context, in *DeleteIncidentRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, TransportAnalytics_DeleteIncident_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transportAnalyticsClient) GetIncidentHistory(ctx context.Context, in *GetIncidentHistoryRequest, opts ...grpc.CallOption) (*IncidentHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(IncidentHistoryResponse)
+	err := c.cc.Invoke(ctx, TransportAnalytics_GetIncidentHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transportAnalyticsClient) ListIncidentsByTag(ctx context.Context, in *ListIncidentsByTagRequest, opts ...grpc.CallOption) (*ListIncidentsByTagResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListIncidentsByTagResponse)
+	err := c.cc.Invoke(ctx, TransportAnalytics_ListIncidentsByTag_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *transportAnalyticsClient) GetTopBreakdowns(ctx context.Context, in *TopBreakdownsRequest, opts ...grpc.CallOption) (*TopBreakdownsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(TopBreakdownsResponse)
@@ -109,7 +271,7 @@ func (c *transportAnalyticsClient) GetTopBreakdowns(ctx context.Context, in *Top
 	return out, nil
 }
 
-func (c *transportAnalyticsClient) GetMTBF(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*MTBFResponse, error) {
+func (c *transportAnalyticsClient) GetMTBF(ctx context.Context, in *MTBFRequest, opts ...grpc.CallOption) (*MTBFResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(MTBFResponse)
 	err := c.cc.Invoke(ctx, TransportAnalytics_GetMTBF_FullMethodName, in, out, cOpts...)
@@ -119,6 +281,26 @@ func (c *transportAnalyticsClient) GetMTBF(ctx context.Context, in *emptypb.Empt
 	return out, nil
 }
 
+func (c *transportAnalyticsClient) GetStationMTBF(ctx context.Context, in *StationMTBFRequest, opts ...grpc.CallOption) (*StationMTBFResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StationMTBFResponse)
+	err := c.cc.Invoke(ctx, TransportAnalytics_GetStationMTBF_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transportAnalyticsClient) GetWeightedMTBF(ctx context.Context, in *WeightedMTBFRequest, opts ...grpc.CallOption) (*WeightedMTBFResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WeightedMTBFResponse)
+	err := c.cc.Invoke(ctx, TransportAnalytics_GetWeightedMTBF_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *transportAnalyticsClient) GetRecentDisruptions(ctx context.Context, in *RecentDisruptionsRequest, opts ...grpc.CallOption) (*RecentDisruptionsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(RecentDisruptionsResponse)
@@ -129,6 +311,216 @@ func (c *transportAnalyticsClient) GetRecentDisruptions(ctx context.Context, in
 	return out, nil
 }
 
+func (c *transportAnalyticsClient) GetSegmentIncidents(ctx context.Context, in *SegmentIncidentsRequest, opts ...grpc.CallOption) (*SegmentIncidentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SegmentIncidentsResponse)
+	err := c.cc.Invoke(ctx, TransportAnalytics_GetSegmentIncidents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transportAnalyticsClient) GetRollingIncidentAverage(ctx context.Context, in *RollingAverageRequest, opts ...grpc.CallOption) (*RollingAverageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RollingAverageResponse)
+	err := c.cc.Invoke(ctx, TransportAnalytics_GetRollingIncidentAverage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transportAnalyticsClient) GetCumulativeIncidents(ctx context.Context, in *CumulativeIncidentsRequest, opts ...grpc.CallOption) (*CumulativeIncidentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CumulativeIncidentsResponse)
+	err := c.cc.Invoke(ctx, TransportAnalytics_GetCumulativeIncidents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transportAnalyticsClient) GetBusiestPeriod(ctx context.Context, in *BusiestPeriodRequest, opts ...grpc.CallOption) (*BusiestPeriodResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BusiestPeriodResponse)
+	err := c.cc.Invoke(ctx, TransportAnalytics_GetBusiestPeriod_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transportAnalyticsClient) GetIncidentMatrix(ctx context.Context, in *IncidentMatrixRequest, opts ...grpc.CallOption) (*IncidentMatrixResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(IncidentMatrixResponse)
+	err := c.cc.Invoke(ctx, TransportAnalytics_GetIncidentMatrix_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transportAnalyticsClient) GetIncidentTypeByHour(ctx context.Context, in *IncidentTypeByHourRequest, opts ...grpc.CallOption) (*IncidentTypeByHourResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(IncidentTypeByHourResponse)
+	err := c.cc.Invoke(ctx, TransportAnalytics_GetIncidentTypeByHour_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transportAnalyticsClient) GetIncidentStatusCounts(ctx context.Context, in *IncidentStatusCountsRequest, opts ...grpc.CallOption) (*IncidentStatusCountsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(IncidentStatusCountsResponse)
+	err := c.cc.Invoke(ctx, TransportAnalytics_GetIncidentStatusCounts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transportAnalyticsClient) GetIncidentsDuringMaintenance(ctx context.Context, in *IncidentsDuringMaintenanceRequest, opts ...grpc.CallOption) (*IncidentsDuringMaintenanceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(IncidentsDuringMaintenanceResponse)
+	err := c.cc.Invoke(ctx, TransportAnalytics_GetIncidentsDuringMaintenance_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transportAnalyticsClient) GetLongestIncidents(ctx context.Context, in *LongestIncidentsRequest, opts ...grpc.CallOption) (*LongestIncidentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LongestIncidentsResponse)
+	err := c.cc.Invoke(ctx, TransportAnalytics_GetLongestIncidents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transportAnalyticsClient) GetConcurrentIncidents(ctx context.Context, in *ConcurrentIncidentsRequest, opts ...grpc.CallOption) (*ConcurrentIncidentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ConcurrentIncidentsResponse)
+	err := c.cc.Invoke(ctx, TransportAnalytics_GetConcurrentIncidents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transportAnalyticsClient) GetNetworkOverview(ctx context.Context, in *NetworkOverviewRequest, opts ...grpc.CallOption) (*NetworkOverviewResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(NetworkOverviewResponse)
+	err := c.cc.Invoke(ctx, TransportAnalytics_GetNetworkOverview_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transportAnalyticsClient) GetDurationPercentiles(ctx context.Context, in *DurationPercentilesRequest, opts ...grpc.CallOption) (*DurationPercentilesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DurationPercentilesResponse)
+	err := c.cc.Invoke(ctx, TransportAnalytics_GetDurationPercentiles_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transportAnalyticsClient) GetLongestQuietPeriod(ctx context.Context, in *LongestQuietPeriodRequest, opts ...grpc.CallOption) (*LongestQuietPeriodResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LongestQuietPeriodResponse)
+	err := c.cc.Invoke(ctx, TransportAnalytics_GetLongestQuietPeriod_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transportAnalyticsClient) GetSLABreaches(ctx context.Context, in *SLABreachesRequest, opts ...grpc.CallOption) (*SLABreachesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SLABreachesResponse)
+	err := c.cc.Invoke(ctx, TransportAnalytics_GetSLABreaches_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transportAnalyticsClient) GetLineTypeDistribution(ctx context.Context, in *LineTypeDistributionRequest, opts ...grpc.CallOption) (*LineTypeDistributionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LineTypeDistributionResponse)
+	err := c.cc.Invoke(ctx, TransportAnalytics_GetLineTypeDistribution_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transportAnalyticsClient) GetLineTopIncidentType(ctx context.Context, in *LineTopIncidentTypeRequest, opts ...grpc.CallOption) (*LineTopIncidentTypeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LineTopIncidentTypeResponse)
+	err := c.cc.Invoke(ctx, TransportAnalytics_GetLineTopIncidentType_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transportAnalyticsClient) GetTimeSinceLastIncident(ctx context.Context, in *TimeSinceLastIncidentRequest, opts ...grpc.CallOption) (*TimeSinceLastIncidentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TimeSinceLastIncidentResponse)
+	err := c.cc.Invoke(ctx, TransportAnalytics_GetTimeSinceLastIncident_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transportAnalyticsClient) GetStationGrades(ctx context.Context, in *StationGradesRequest, opts ...grpc.CallOption) (*StationGradesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StationGradesResponse)
+	err := c.cc.Invoke(ctx, TransportAnalytics_GetStationGrades_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transportAnalyticsClient) GetIncidentsForMap(ctx context.Context, in *IncidentMapRequest, opts ...grpc.CallOption) (*IncidentMapResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(IncidentMapResponse)
+	err := c.cc.Invoke(ctx, TransportAnalytics_GetIncidentsForMap_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transportAnalyticsClient) GetStationVsLineAverage(ctx context.Context, in *StationVsLineAverageRequest, opts ...grpc.CallOption) (*StationVsLineAverageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StationVsLineAverageResponse)
+	err := c.cc.Invoke(ctx, TransportAnalytics_GetStationVsLineAverage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transportAnalyticsClient) GetStationStatusSummary(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*StationStatusSummaryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StationStatusSummaryResponse)
+	err := c.cc.Invoke(ctx, TransportAnalytics_GetStationStatusSummary_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *transportAnalyticsClient) CreateLine(ctx context.Context, in *CreateLineRequest, opts ...grpc.CallOption) (*LineResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(LineResponse)
@@ -139,7 +531,7 @@ func (c *transportAnalyticsClient) CreateLine(ctx context.Context, in *CreateLin
 	return out, nil
 }
 
-func (c *transportAnalyticsClient) ListLines(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListLinesResponse, error) {
+func (c *transportAnalyticsClient) ListLines(ctx context.Context, in *ListLinesRequest, opts ...grpc.CallOption) (*ListLinesResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ListLinesResponse)
 	err := c.cc.Invoke(ctx, TransportAnalytics_ListLines_FullMethodName, in, out, cOpts...)
@@ -179,6 +571,26 @@ func (c *transportAnalyticsClient) DeleteLine(ctx context.Context, in *DeleteLin
 	return out, nil
 }
 
+func (c *transportAnalyticsClient) GetLineSummary(ctx context.Context, in *GetLineSummaryRequest, opts ...grpc.CallOption) (*LineSummaryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LineSummaryResponse)
+	err := c.cc.Invoke(ctx, TransportAnalytics_GetLineSummary_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transportAnalyticsClient) PreviewLineDeletion(ctx context.Context, in *PreviewLineDeletionRequest, opts ...grpc.CallOption) (*LineDeletionPreviewResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LineDeletionPreviewResponse)
+	err := c.cc.Invoke(ctx, TransportAnalytics_PreviewLineDeletion_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *transportAnalyticsClient) CreateStation(ctx context.Context, in *CreateStationRequest, opts ...grpc.CallOption) (*StationResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(StationResponse)
@@ -209,6 +621,26 @@ func (c *transportAnalyticsClient) GetStation(ctx context.Context, in *GetStatio
 	return out, nil
 }
 
+func (c *transportAnalyticsClient) GetStationWithIncidents(ctx context.Context, in *GetStationWithIncidentsRequest, opts ...grpc.CallOption) (*StationWithIncidentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StationWithIncidentsResponse)
+	err := c.cc.Invoke(ctx, TransportAnalytics_GetStationWithIncidents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transportAnalyticsClient) GetStationDetails(ctx context.Context, in *GetStationDetailsRequest, opts ...grpc.CallOption) (*StationDetailsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StationDetailsResponse)
+	err := c.cc.Invoke(ctx, TransportAnalytics_GetStationDetails_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *transportAnalyticsClient) UpdateStation(ctx context.Context, in *UpdateStationRequest, opts ...grpc.CallOption) (*StationResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(StationResponse)
@@ -229,26 +661,136 @@ func (c *transportAnalyticsClient) DeleteStation(ctx context.Context, in *Delete
 	return out, nil
 }
 
-// TransportAnalyticsServer is the server API for TransportAnalytics service.
-// All implementations should embed UnimplementedTransportAnalyticsServer
-// for forward compatibility.
-type TransportAnalyticsServer interface {
-	HealthCheck(context.Context, *emptypb.Empty) (*httpbody.HttpBody, error)
-	ReadyCheck(context.Context, *emptypb.Empty) (*httpbody.HttpBody, error)
+func (c *transportAnalyticsClient) MergeStations(ctx context.Context, in *MergeStationsRequest, opts ...grpc.CallOption) (*MergeStationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MergeStationsResponse)
+	err := c.cc.Invoke(ctx, TransportAnalytics_MergeStations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transportAnalyticsClient) ReorderStations(ctx context.Context, in *ReorderStationsRequest, opts ...grpc.CallOption) (*ListStationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListStationsResponse)
+	err := c.cc.Invoke(ctx, TransportAnalytics_ReorderStations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transportAnalyticsClient) StreamIncidentsExport(ctx context.Context, in *StreamIncidentsExportRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[IncidentResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &TransportAnalytics_ServiceDesc.Streams[0], TransportAnalytics_StreamIncidentsExport_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[StreamIncidentsExportRequest, IncidentResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type TransportAnalytics_StreamIncidentsExportClient = grpc.ServerStreamingClient[IncidentResponse]
+
+func (c *transportAnalyticsClient) FindOrphanedIncidents(ctx context.Context, in *OrphanedIncidentsRequest, opts ...grpc.CallOption) (*OrphanedIncidentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OrphanedIncidentsResponse)
+	err := c.cc.Invoke(ctx, TransportAnalytics_FindOrphanedIncidents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transportAnalyticsClient) NormalizeStationStatuses(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*NormalizeStationStatusesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(NormalizeStationStatusesResponse)
+	err := c.cc.Invoke(ctx, TransportAnalytics_NormalizeStationStatuses_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transportAnalyticsClient) PurgeOldIncidents(ctx context.Context, in *PurgeOldIncidentsRequest, opts ...grpc.CallOption) (*PurgeOldIncidentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PurgeOldIncidentsResponse)
+	err := c.cc.Invoke(ctx, TransportAnalytics_PurgeOldIncidents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// TransportAnalyticsServer is the server API for TransportAnalytics service.
+// All implementations should embed UnimplementedTransportAnalyticsServer
+// for forward compatibility.
+type TransportAnalyticsServer interface {
+	HealthCheck(context.Context, *emptypb.Empty) (*httpbody.HttpBody, error)
+	ReadyCheck(context.Context, *emptypb.Empty) (*httpbody.HttpBody, error)
 	CreateIncident(context.Context, *CreateIncidentRequest) (*IncidentResponse, error)
+	ValidateIncident(context.Context, *CreateIncidentRequest) (*ValidateIncidentResponse, error)
+	UpdateIncidentStatus(context.Context, *UpdateIncidentStatusRequest) (*IncidentResponse, error)
+	UpdateIncident(context.Context, *UpdateIncidentRequest) (*IncidentResponse, error)
+	ReassignIncident(context.Context, *ReassignIncidentRequest) (*IncidentResponse, error)
+	ResolveIncident(context.Context, *ResolveIncidentRequest) (*IncidentResponse, error)
+	DeleteIncident(context.Context, *DeleteIncidentRequest) (*emptypb.Empty, error)
+	GetIncidentHistory(context.Context, *GetIncidentHistoryRequest) (*IncidentHistoryResponse, error)
+	ListIncidentsByTag(context.Context, *ListIncidentsByTagRequest) (*ListIncidentsByTagResponse, error)
 	GetTopBreakdowns(context.Context, *TopBreakdownsRequest) (*TopBreakdownsResponse, error)
-	GetMTBF(context.Context, *emptypb.Empty) (*MTBFResponse, error)
+	GetMTBF(context.Context, *MTBFRequest) (*MTBFResponse, error)
+	GetStationMTBF(context.Context, *StationMTBFRequest) (*StationMTBFResponse, error)
+	GetWeightedMTBF(context.Context, *WeightedMTBFRequest) (*WeightedMTBFResponse, error)
 	GetRecentDisruptions(context.Context, *RecentDisruptionsRequest) (*RecentDisruptionsResponse, error)
+	GetSegmentIncidents(context.Context, *SegmentIncidentsRequest) (*SegmentIncidentsResponse, error)
+	GetRollingIncidentAverage(context.Context, *RollingAverageRequest) (*RollingAverageResponse, error)
+	GetCumulativeIncidents(context.Context, *CumulativeIncidentsRequest) (*CumulativeIncidentsResponse, error)
+	GetBusiestPeriod(context.Context, *BusiestPeriodRequest) (*BusiestPeriodResponse, error)
+	GetIncidentMatrix(context.Context, *IncidentMatrixRequest) (*IncidentMatrixResponse, error)
+	GetIncidentTypeByHour(context.Context, *IncidentTypeByHourRequest) (*IncidentTypeByHourResponse, error)
+	GetIncidentStatusCounts(context.Context, *IncidentStatusCountsRequest) (*IncidentStatusCountsResponse, error)
+	GetIncidentsDuringMaintenance(context.Context, *IncidentsDuringMaintenanceRequest) (*IncidentsDuringMaintenanceResponse, error)
+	GetLongestIncidents(context.Context, *LongestIncidentsRequest) (*LongestIncidentsResponse, error)
+	GetConcurrentIncidents(context.Context, *ConcurrentIncidentsRequest) (*ConcurrentIncidentsResponse, error)
+	GetNetworkOverview(context.Context, *NetworkOverviewRequest) (*NetworkOverviewResponse, error)
+	GetDurationPercentiles(context.Context, *DurationPercentilesRequest) (*DurationPercentilesResponse, error)
+	GetLongestQuietPeriod(context.Context, *LongestQuietPeriodRequest) (*LongestQuietPeriodResponse, error)
+	GetSLABreaches(context.Context, *SLABreachesRequest) (*SLABreachesResponse, error)
+	GetLineTypeDistribution(context.Context, *LineTypeDistributionRequest) (*LineTypeDistributionResponse, error)
+	GetLineTopIncidentType(context.Context, *LineTopIncidentTypeRequest) (*LineTopIncidentTypeResponse, error)
+	GetTimeSinceLastIncident(context.Context, *TimeSinceLastIncidentRequest) (*TimeSinceLastIncidentResponse, error)
+	GetStationGrades(context.Context, *StationGradesRequest) (*StationGradesResponse, error)
+	GetIncidentsForMap(context.Context, *IncidentMapRequest) (*IncidentMapResponse, error)
+	GetStationVsLineAverage(context.Context, *StationVsLineAverageRequest) (*StationVsLineAverageResponse, error)
+	GetStationStatusSummary(context.Context, *emptypb.Empty) (*StationStatusSummaryResponse, error)
 	CreateLine(context.Context, *CreateLineRequest) (*LineResponse, error)
-	ListLines(context.Context, *emptypb.Empty) (*ListLinesResponse, error)
+	ListLines(context.Context, *ListLinesRequest) (*ListLinesResponse, error)
 	GetLine(context.Context, *GetLineRequest) (*LineResponse, error)
 	UpdateLine(context.Context, *UpdateLineRequest) (*LineResponse, error)
 	DeleteLine(context.Context, *DeleteLineRequest) (*emptypb.Empty, error)
+	GetLineSummary(context.Context, *GetLineSummaryRequest) (*LineSummaryResponse, error)
+	PreviewLineDeletion(context.Context, *PreviewLineDeletionRequest) (*LineDeletionPreviewResponse, error)
 	CreateStation(context.Context, *CreateStationRequest) (*StationResponse, error)
 	ListStations(context.Context, *ListStationsRequest) (*ListStationsResponse, error)
 	GetStation(context.Context, *GetStationRequest) (*StationResponse, error)
+	GetStationWithIncidents(context.Context, *GetStationWithIncidentsRequest) (*StationWithIncidentsResponse, error)
+	GetStationDetails(context.Context, *GetStationDetailsRequest) (*StationDetailsResponse, error)
 	UpdateStation(context.Context, *UpdateStationRequest) (*StationResponse, error)
 	DeleteStation(context.Context, *DeleteStationRequest) (*emptypb.Empty, error)
+	MergeStations(context.Context, *MergeStationsRequest) (*MergeStationsResponse, error)
+	ReorderStations(context.Context, *ReorderStationsRequest) (*ListStationsResponse, error)
+	StreamIncidentsExport(*StreamIncidentsExportRequest, grpc.ServerStreamingServer[IncidentResponse]) error
+	FindOrphanedIncidents(context.Context, *OrphanedIncidentsRequest) (*OrphanedIncidentsResponse, error)
+	NormalizeStationStatuses(context.Context, *emptypb.Empty) (*NormalizeStationStatusesResponse, error)
+	PurgeOldIncidents(context.Context, *PurgeOldIncidentsRequest) (*PurgeOldIncidentsResponse, error)
 }
 
 // UnimplementedTransportAnalyticsServer should be embedded to have
@@ -267,19 +809,112 @@ func (UnimplementedTransportAnalyticsServer) ReadyCheck(context.Context, *emptyp
 func (UnimplementedTransportAnalyticsServer) CreateIncident(context.Context, *CreateIncidentRequest) (*IncidentResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateIncident not implemented")
 }
+func (UnimplementedTransportAnalyticsServer) ValidateIncident(context.Context, *CreateIncidentRequest) (*ValidateIncidentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidateIncident not implemented")
+}
+func (UnimplementedTransportAnalyticsServer) UpdateIncidentStatus(context.Context, *UpdateIncidentStatusRequest) (*IncidentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateIncidentStatus not implemented")
+}
+func (UnimplementedTransportAnalyticsServer) UpdateIncident(context.Context, *UpdateIncidentRequest) (*IncidentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateIncident not implemented")
+}
+func (UnimplementedTransportAnalyticsServer) ReassignIncident(context.Context, *ReassignIncidentRequest) (*IncidentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReassignIncident not implemented")
+}
+func (UnimplementedTransportAnalyticsServer) ResolveIncident(context.Context, *ResolveIncidentRequest) (*IncidentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResolveIncident not implemented")
+}
+func (UnimplementedTransportAnalyticsServer) DeleteIncident(context.Context, *DeleteIncidentRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteIncident not implemented")
+}
+func (UnimplementedTransportAnalyticsServer) GetIncidentHistory(context.Context, *GetIncidentHistoryRequest) (*IncidentHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetIncidentHistory not implemented")
+}
+func (UnimplementedTransportAnalyticsServer) ListIncidentsByTag(context.Context, *ListIncidentsByTagRequest) (*ListIncidentsByTagResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListIncidentsByTag not implemented")
+}
 func (UnimplementedTransportAnalyticsServer) GetTopBreakdowns(context.Context, *TopBreakdownsRequest) (*TopBreakdownsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetTopBreakdowns not implemented")
 }
-func (UnimplementedTransportAnalyticsServer) GetMTBF(context.Context, *emptypb.Empty) (*MTBFResponse, error) {
+func (UnimplementedTransportAnalyticsServer) GetMTBF(context.Context, *MTBFRequest) (*MTBFResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetMTBF not implemented")
 }
+func (UnimplementedTransportAnalyticsServer) GetStationMTBF(context.Context, *StationMTBFRequest) (*StationMTBFResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStationMTBF not implemented")
+}
+func (UnimplementedTransportAnalyticsServer) GetWeightedMTBF(context.Context, *WeightedMTBFRequest) (*WeightedMTBFResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWeightedMTBF not implemented")
+}
 func (UnimplementedTransportAnalyticsServer) GetRecentDisruptions(context.Context, *RecentDisruptionsRequest) (*RecentDisruptionsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetRecentDisruptions not implemented")
 }
+func (UnimplementedTransportAnalyticsServer) GetSegmentIncidents(context.Context, *SegmentIncidentsRequest) (*SegmentIncidentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSegmentIncidents not implemented")
+}
+func (UnimplementedTransportAnalyticsServer) GetRollingIncidentAverage(context.Context, *RollingAverageRequest) (*RollingAverageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRollingIncidentAverage not implemented")
+}
+func (UnimplementedTransportAnalyticsServer) GetCumulativeIncidents(context.Context, *CumulativeIncidentsRequest) (*CumulativeIncidentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCumulativeIncidents not implemented")
+}
+func (UnimplementedTransportAnalyticsServer) GetBusiestPeriod(context.Context, *BusiestPeriodRequest) (*BusiestPeriodResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBusiestPeriod not implemented")
+}
+func (UnimplementedTransportAnalyticsServer) GetIncidentMatrix(context.Context, *IncidentMatrixRequest) (*IncidentMatrixResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetIncidentMatrix not implemented")
+}
+func (UnimplementedTransportAnalyticsServer) GetIncidentTypeByHour(context.Context, *IncidentTypeByHourRequest) (*IncidentTypeByHourResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetIncidentTypeByHour not implemented")
+}
+func (UnimplementedTransportAnalyticsServer) GetIncidentStatusCounts(context.Context, *IncidentStatusCountsRequest) (*IncidentStatusCountsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetIncidentStatusCounts not implemented")
+}
+func (UnimplementedTransportAnalyticsServer) GetIncidentsDuringMaintenance(context.Context, *IncidentsDuringMaintenanceRequest) (*IncidentsDuringMaintenanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetIncidentsDuringMaintenance not implemented")
+}
+func (UnimplementedTransportAnalyticsServer) GetLongestIncidents(context.Context, *LongestIncidentsRequest) (*LongestIncidentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLongestIncidents not implemented")
+}
+func (UnimplementedTransportAnalyticsServer) GetConcurrentIncidents(context.Context, *ConcurrentIncidentsRequest) (*ConcurrentIncidentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetConcurrentIncidents not implemented")
+}
+func (UnimplementedTransportAnalyticsServer) GetNetworkOverview(context.Context, *NetworkOverviewRequest) (*NetworkOverviewResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNetworkOverview not implemented")
+}
+func (UnimplementedTransportAnalyticsServer) GetDurationPercentiles(context.Context, *DurationPercentilesRequest) (*DurationPercentilesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDurationPercentiles not implemented")
+}
+func (UnimplementedTransportAnalyticsServer) GetLongestQuietPeriod(context.Context, *LongestQuietPeriodRequest) (*LongestQuietPeriodResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLongestQuietPeriod not implemented")
+}
+func (UnimplementedTransportAnalyticsServer) GetSLABreaches(context.Context, *SLABreachesRequest) (*SLABreachesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSLABreaches not implemented")
+}
+func (UnimplementedTransportAnalyticsServer) GetLineTypeDistribution(context.Context, *LineTypeDistributionRequest) (*LineTypeDistributionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLineTypeDistribution not implemented")
+}
+func (UnimplementedTransportAnalyticsServer) GetLineTopIncidentType(context.Context, *LineTopIncidentTypeRequest) (*LineTopIncidentTypeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLineTopIncidentType not implemented")
+}
+func (UnimplementedTransportAnalyticsServer) GetTimeSinceLastIncident(context.Context, *TimeSinceLastIncidentRequest) (*TimeSinceLastIncidentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTimeSinceLastIncident not implemented")
+}
+func (UnimplementedTransportAnalyticsServer) GetStationGrades(context.Context, *StationGradesRequest) (*StationGradesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStationGrades not implemented")
+}
+func (UnimplementedTransportAnalyticsServer) GetIncidentsForMap(context.Context, *IncidentMapRequest) (*IncidentMapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetIncidentsForMap not implemented")
+}
+func (UnimplementedTransportAnalyticsServer) GetStationVsLineAverage(context.Context, *StationVsLineAverageRequest) (*StationVsLineAverageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStationVsLineAverage not implemented")
+}
+func (UnimplementedTransportAnalyticsServer) GetStationStatusSummary(context.Context, *emptypb.Empty) (*StationStatusSummaryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStationStatusSummary not implemented")
+}
 func (UnimplementedTransportAnalyticsServer) CreateLine(context.Context, *CreateLineRequest) (*LineResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateLine not implemented")
 }
-func (UnimplementedTransportAnalyticsServer) ListLines(context.Context, *emptypb.Empty) (*ListLinesResponse, error) {
+func (UnimplementedTransportAnalyticsServer) ListLines(context.Context, *ListLinesRequest) (*ListLinesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListLines not implemented")
 }
 func (UnimplementedTransportAnalyticsServer) GetLine(context.Context, *GetLineRequest) (*LineResponse, error) {
@@ -291,6 +926,12 @@ func (UnimplementedTransportAnalyticsServer) UpdateLine(context.Context, *Update
 func (UnimplementedTransportAnalyticsServer) DeleteLine(context.Context, *DeleteLineRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteLine not implemented")
 }
+func (UnimplementedTransportAnalyticsServer) GetLineSummary(context.Context, *GetLineSummaryRequest) (*LineSummaryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLineSummary not implemented")
+}
+func (UnimplementedTransportAnalyticsServer) PreviewLineDeletion(context.Context, *PreviewLineDeletionRequest) (*LineDeletionPreviewResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PreviewLineDeletion not implemented")
+}
 func (UnimplementedTransportAnalyticsServer) CreateStation(context.Context, *CreateStationRequest) (*StationResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateStation not implemented")
 }
@@ -300,12 +941,36 @@ func (UnimplementedTransportAnalyticsServer) ListStations(context.Context, *List
 func (UnimplementedTransportAnalyticsServer) GetStation(context.Context, *GetStationRequest) (*StationResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetStation not implemented")
 }
+func (UnimplementedTransportAnalyticsServer) GetStationWithIncidents(context.Context, *GetStationWithIncidentsRequest) (*StationWithIncidentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStationWithIncidents not implemented")
+}
+func (UnimplementedTransportAnalyticsServer) GetStationDetails(context.Context, *GetStationDetailsRequest) (*StationDetailsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStationDetails not implemented")
+}
 func (UnimplementedTransportAnalyticsServer) UpdateStation(context.Context, *UpdateStationRequest) (*StationResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateStation not implemented")
 }
 func (UnimplementedTransportAnalyticsServer) DeleteStation(context.Context, *DeleteStationRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteStation not implemented")
 }
+func (UnimplementedTransportAnalyticsServer) MergeStations(context.Context, *MergeStationsRequest) (*MergeStationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MergeStations not implemented")
+}
+func (UnimplementedTransportAnalyticsServer) ReorderStations(context.Context, *ReorderStationsRequest) (*ListStationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReorderStations not implemented")
+}
+func (UnimplementedTransportAnalyticsServer) StreamIncidentsExport(*StreamIncidentsExportRequest, grpc.ServerStreamingServer[IncidentResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method StreamIncidentsExport not implemented")
+}
+func (UnimplementedTransportAnalyticsServer) FindOrphanedIncidents(context.Context, *OrphanedIncidentsRequest) (*OrphanedIncidentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindOrphanedIncidents not implemented")
+}
+func (UnimplementedTransportAnalyticsServer) NormalizeStationStatuses(context.Context, *emptypb.Empty) (*NormalizeStationStatusesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NormalizeStationStatuses not implemented")
+}
+func (UnimplementedTransportAnalyticsServer) PurgeOldIncidents(context.Context, *PurgeOldIncidentsRequest) (*PurgeOldIncidentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PurgeOldIncidents not implemented")
+}
 func (UnimplementedTransportAnalyticsServer) testEmbeddedByValue() {}
 
 // UnsafeTransportAnalyticsServer may be embedded to opt out of forward compatibility for this service.
@@ -315,121 +980,679 @@ type UnsafeTransportAnalyticsServer interface {
 	mustEmbedUnimplementedTransportAnalyticsServer()
 }
 
-func RegisterTransportAnalyticsServer(s grpc.ServiceRegistrar, srv TransportAnalyticsServer) {
-	// If the following call pancis, it indicates UnimplementedTransportAnalyticsServer was
-	// embedded by pointer and is nil.  This will cause panics if an
-	// unimplemented method is ever invoked, so we test this at initialization
-	// time to prevent it from happening at runtime later due to I/O.
-	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
-		t.testEmbeddedByValue()
+func RegisterTransportAnalyticsServer(s grpc.ServiceRegistrar, srv TransportAnalyticsServer) {
+	// If the following call pancis, it indicates UnimplementedTransportAnalyticsServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&TransportAnalytics_ServiceDesc, srv)
+}
+
+func _TransportAnalytics_HealthCheck_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransportAnalyticsServer).HealthCheck(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TransportAnalytics_HealthCheck_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransportAnalyticsServer).HealthCheck(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransportAnalytics_ReadyCheck_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransportAnalyticsServer).ReadyCheck(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TransportAnalytics_ReadyCheck_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransportAnalyticsServer).ReadyCheck(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransportAnalytics_CreateIncident_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateIncidentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransportAnalyticsServer).CreateIncident(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TransportAnalytics_CreateIncident_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransportAnalyticsServer).CreateIncident(ctx, req.(*CreateIncidentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransportAnalytics_ValidateIncident_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateIncidentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransportAnalyticsServer).ValidateIncident(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TransportAnalytics_ValidateIncident_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransportAnalyticsServer).ValidateIncident(ctx, req.(*CreateIncidentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransportAnalytics_UpdateIncidentStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateIncidentStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransportAnalyticsServer).UpdateIncidentStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TransportAnalytics_UpdateIncidentStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransportAnalyticsServer).UpdateIncidentStatus(ctx, req.(*UpdateIncidentStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransportAnalytics_UpdateIncident_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateIncidentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransportAnalyticsServer).UpdateIncident(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TransportAnalytics_UpdateIncident_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransportAnalyticsServer).UpdateIncident(ctx, req.(*UpdateIncidentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransportAnalytics_ReassignIncident_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReassignIncidentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransportAnalyticsServer).ReassignIncident(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TransportAnalytics_ReassignIncident_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransportAnalyticsServer).ReassignIncident(ctx, req.(*ReassignIncidentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransportAnalytics_ResolveIncident_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResolveIncidentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransportAnalyticsServer).ResolveIncident(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TransportAnalytics_ResolveIncident_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransportAnalyticsServer).ResolveIncident(ctx, req.(*ResolveIncidentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransportAnalytics_DeleteIncident_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteIncidentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransportAnalyticsServer).DeleteIncident(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TransportAnalytics_DeleteIncident_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransportAnalyticsServer).DeleteIncident(ctx, req.(*DeleteIncidentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransportAnalytics_GetIncidentHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetIncidentHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransportAnalyticsServer).GetIncidentHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TransportAnalytics_GetIncidentHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransportAnalyticsServer).GetIncidentHistory(ctx, req.(*GetIncidentHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransportAnalytics_ListIncidentsByTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListIncidentsByTagRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransportAnalyticsServer).ListIncidentsByTag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TransportAnalytics_ListIncidentsByTag_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransportAnalyticsServer).ListIncidentsByTag(ctx, req.(*ListIncidentsByTagRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransportAnalytics_GetTopBreakdowns_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TopBreakdownsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransportAnalyticsServer).GetTopBreakdowns(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TransportAnalytics_GetTopBreakdowns_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransportAnalyticsServer).GetTopBreakdowns(ctx, req.(*TopBreakdownsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransportAnalytics_GetMTBF_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MTBFRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransportAnalyticsServer).GetMTBF(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TransportAnalytics_GetMTBF_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransportAnalyticsServer).GetMTBF(ctx, req.(*MTBFRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransportAnalytics_GetStationMTBF_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StationMTBFRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransportAnalyticsServer).GetStationMTBF(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TransportAnalytics_GetStationMTBF_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransportAnalyticsServer).GetStationMTBF(ctx, req.(*StationMTBFRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransportAnalytics_GetWeightedMTBF_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WeightedMTBFRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransportAnalyticsServer).GetWeightedMTBF(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TransportAnalytics_GetWeightedMTBF_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransportAnalyticsServer).GetWeightedMTBF(ctx, req.(*WeightedMTBFRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransportAnalytics_GetRecentDisruptions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecentDisruptionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransportAnalyticsServer).GetRecentDisruptions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TransportAnalytics_GetRecentDisruptions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransportAnalyticsServer).GetRecentDisruptions(ctx, req.(*RecentDisruptionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransportAnalytics_GetSegmentIncidents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SegmentIncidentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransportAnalyticsServer).GetSegmentIncidents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TransportAnalytics_GetSegmentIncidents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransportAnalyticsServer).GetSegmentIncidents(ctx, req.(*SegmentIncidentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransportAnalytics_GetRollingIncidentAverage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RollingAverageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransportAnalyticsServer).GetRollingIncidentAverage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TransportAnalytics_GetRollingIncidentAverage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransportAnalyticsServer).GetRollingIncidentAverage(ctx, req.(*RollingAverageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransportAnalytics_GetCumulativeIncidents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CumulativeIncidentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransportAnalyticsServer).GetCumulativeIncidents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TransportAnalytics_GetCumulativeIncidents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransportAnalyticsServer).GetCumulativeIncidents(ctx, req.(*CumulativeIncidentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransportAnalytics_GetBusiestPeriod_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BusiestPeriodRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransportAnalyticsServer).GetBusiestPeriod(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TransportAnalytics_GetBusiestPeriod_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransportAnalyticsServer).GetBusiestPeriod(ctx, req.(*BusiestPeriodRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransportAnalytics_GetIncidentMatrix_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IncidentMatrixRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransportAnalyticsServer).GetIncidentMatrix(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TransportAnalytics_GetIncidentMatrix_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransportAnalyticsServer).GetIncidentMatrix(ctx, req.(*IncidentMatrixRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransportAnalytics_GetIncidentTypeByHour_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IncidentTypeByHourRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransportAnalyticsServer).GetIncidentTypeByHour(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TransportAnalytics_GetIncidentTypeByHour_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransportAnalyticsServer).GetIncidentTypeByHour(ctx, req.(*IncidentTypeByHourRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransportAnalytics_GetIncidentStatusCounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IncidentStatusCountsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransportAnalyticsServer).GetIncidentStatusCounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TransportAnalytics_GetIncidentStatusCounts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransportAnalyticsServer).GetIncidentStatusCounts(ctx, req.(*IncidentStatusCountsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransportAnalytics_GetIncidentsDuringMaintenance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IncidentsDuringMaintenanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransportAnalyticsServer).GetIncidentsDuringMaintenance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TransportAnalytics_GetIncidentsDuringMaintenance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransportAnalyticsServer).GetIncidentsDuringMaintenance(ctx, req.(*IncidentsDuringMaintenanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransportAnalytics_GetLongestIncidents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LongestIncidentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransportAnalyticsServer).GetLongestIncidents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TransportAnalytics_GetLongestIncidents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransportAnalyticsServer).GetLongestIncidents(ctx, req.(*LongestIncidentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransportAnalytics_GetConcurrentIncidents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConcurrentIncidentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransportAnalyticsServer).GetConcurrentIncidents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TransportAnalytics_GetConcurrentIncidents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransportAnalyticsServer).GetConcurrentIncidents(ctx, req.(*ConcurrentIncidentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransportAnalytics_GetNetworkOverview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NetworkOverviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransportAnalyticsServer).GetNetworkOverview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TransportAnalytics_GetNetworkOverview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransportAnalyticsServer).GetNetworkOverview(ctx, req.(*NetworkOverviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransportAnalytics_GetDurationPercentiles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DurationPercentilesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransportAnalyticsServer).GetDurationPercentiles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TransportAnalytics_GetDurationPercentiles_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransportAnalyticsServer).GetDurationPercentiles(ctx, req.(*DurationPercentilesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransportAnalytics_GetLongestQuietPeriod_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LongestQuietPeriodRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransportAnalyticsServer).GetLongestQuietPeriod(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TransportAnalytics_GetLongestQuietPeriod_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransportAnalyticsServer).GetLongestQuietPeriod(ctx, req.(*LongestQuietPeriodRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransportAnalytics_GetSLABreaches_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SLABreachesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransportAnalyticsServer).GetSLABreaches(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TransportAnalytics_GetSLABreaches_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransportAnalyticsServer).GetSLABreaches(ctx, req.(*SLABreachesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransportAnalytics_GetLineTypeDistribution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LineTypeDistributionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	s.RegisterService(&TransportAnalytics_ServiceDesc, srv)
+	if interceptor == nil {
+		return srv.(TransportAnalyticsServer).GetLineTypeDistribution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TransportAnalytics_GetLineTypeDistribution_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransportAnalyticsServer).GetLineTypeDistribution(ctx, req.(*LineTypeDistributionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _TransportAnalytics_HealthCheck_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(emptypb.Empty)
+func _TransportAnalytics_GetLineTopIncidentType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LineTopIncidentTypeRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TransportAnalyticsServer).HealthCheck(ctx, in)
+		return srv.(TransportAnalyticsServer).GetLineTopIncidentType(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: TransportAnalytics_HealthCheck_FullMethodName,
+		FullMethod: TransportAnalytics_GetLineTopIncidentType_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TransportAnalyticsServer).HealthCheck(ctx, req.(*emptypb.Empty))
+		return srv.(TransportAnalyticsServer).GetLineTopIncidentType(ctx, req.(*LineTopIncidentTypeRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _TransportAnalytics_ReadyCheck_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(emptypb.Empty)
+func _TransportAnalytics_GetTimeSinceLastIncident_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TimeSinceLastIncidentRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TransportAnalyticsServer).ReadyCheck(ctx, in)
+		return srv.(TransportAnalyticsServer).GetTimeSinceLastIncident(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: TransportAnalytics_ReadyCheck_FullMethodName,
+		FullMethod: TransportAnalytics_GetTimeSinceLastIncident_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TransportAnalyticsServer).ReadyCheck(ctx, req.(*emptypb.Empty))
+		return srv.(TransportAnalyticsServer).GetTimeSinceLastIncident(ctx, req.(*TimeSinceLastIncidentRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _TransportAnalytics_CreateIncident_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateIncidentRequest)
+func _TransportAnalytics_GetStationGrades_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StationGradesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TransportAnalyticsServer).CreateIncident(ctx, in)
+		return srv.(TransportAnalyticsServer).GetStationGrades(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: TransportAnalytics_CreateIncident_FullMethodName,
+		FullMethod: TransportAnalytics_GetStationGrades_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TransportAnalyticsServer).CreateIncident(ctx, req.(*CreateIncidentRequest))
+		return srv.(TransportAnalyticsServer).GetStationGrades(ctx, req.(*StationGradesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _TransportAnalytics_GetTopBreakdowns_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(TopBreakdownsRequest)
+func _TransportAnalytics_GetIncidentsForMap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IncidentMapRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TransportAnalyticsServer).GetTopBreakdowns(ctx, in)
+		return srv.(TransportAnalyticsServer).GetIncidentsForMap(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: TransportAnalytics_GetTopBreakdowns_FullMethodName,
+		FullMethod: TransportAnalytics_GetIncidentsForMap_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TransportAnalyticsServer).GetTopBreakdowns(ctx, req.(*TopBreakdownsRequest))
+		return srv.(TransportAnalyticsServer).GetIncidentsForMap(ctx, req.(*IncidentMapRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _TransportAnalytics_GetMTBF_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(emptypb.Empty)
+func _TransportAnalytics_GetStationVsLineAverage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StationVsLineAverageRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TransportAnalyticsServer).GetMTBF(ctx, in)
+		return srv.(TransportAnalyticsServer).GetStationVsLineAverage(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: TransportAnalytics_GetMTBF_FullMethodName,
+		FullMethod: TransportAnalytics_GetStationVsLineAverage_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TransportAnalyticsServer).GetMTBF(ctx, req.(*emptypb.Empty))
+		return srv.(TransportAnalyticsServer).GetStationVsLineAverage(ctx, req.(*StationVsLineAverageRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _TransportAnalytics_GetRecentDisruptions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RecentDisruptionsRequest)
+func _TransportAnalytics_GetStationStatusSummary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TransportAnalyticsServer).GetRecentDisruptions(ctx, in)
+		return srv.(TransportAnalyticsServer).GetStationStatusSummary(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: TransportAnalytics_GetRecentDisruptions_FullMethodName,
+		FullMethod: TransportAnalytics_GetStationStatusSummary_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TransportAnalyticsServer).GetRecentDisruptions(ctx, req.(*RecentDisruptionsRequest))
+		return srv.(TransportAnalyticsServer).GetStationStatusSummary(ctx, req.(*emptypb.Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -453,7 +1676,7 @@ func _TransportAnalytics_CreateLine_Handler(srv interface{}, ctx context.Context
 }
 
 func _TransportAnalytics_ListLines_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(emptypb.Empty)
+	in := new(ListLinesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -465,7 +1688,7 @@ func _TransportAnalytics_ListLines_Handler(srv interface{}, ctx context.Context,
 		FullMethod: TransportAnalytics_ListLines_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TransportAnalyticsServer).ListLines(ctx, req.(*emptypb.Empty))
+		return srv.(TransportAnalyticsServer).ListLines(ctx, req.(*ListLinesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -524,6 +1747,42 @@ func _TransportAnalytics_DeleteLine_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TransportAnalytics_GetLineSummary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLineSummaryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransportAnalyticsServer).GetLineSummary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TransportAnalytics_GetLineSummary_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransportAnalyticsServer).GetLineSummary(ctx, req.(*GetLineSummaryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransportAnalytics_PreviewLineDeletion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PreviewLineDeletionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransportAnalyticsServer).PreviewLineDeletion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TransportAnalytics_PreviewLineDeletion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransportAnalyticsServer).PreviewLineDeletion(ctx, req.(*PreviewLineDeletionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _TransportAnalytics_CreateStation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateStationRequest)
 	if err := dec(in); err != nil {
@@ -578,6 +1837,42 @@ func _TransportAnalytics_GetStation_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TransportAnalytics_GetStationWithIncidents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStationWithIncidentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransportAnalyticsServer).GetStationWithIncidents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TransportAnalytics_GetStationWithIncidents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransportAnalyticsServer).GetStationWithIncidents(ctx, req.(*GetStationWithIncidentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransportAnalytics_GetStationDetails_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStationDetailsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransportAnalyticsServer).GetStationDetails(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TransportAnalytics_GetStationDetails_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransportAnalyticsServer).GetStationDetails(ctx, req.(*GetStationDetailsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _TransportAnalytics_UpdateStation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(UpdateStationRequest)
 	if err := dec(in); err != nil {
@@ -614,6 +1909,107 @@ func _TransportAnalytics_DeleteStation_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TransportAnalytics_MergeStations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MergeStationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransportAnalyticsServer).MergeStations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TransportAnalytics_MergeStations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransportAnalyticsServer).MergeStations(ctx, req.(*MergeStationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransportAnalytics_ReorderStations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReorderStationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransportAnalyticsServer).ReorderStations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TransportAnalytics_ReorderStations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransportAnalyticsServer).ReorderStations(ctx, req.(*ReorderStationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransportAnalytics_StreamIncidentsExport_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamIncidentsExportRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(TransportAnalyticsServer).StreamIncidentsExport(m, &grpc.GenericServerStream[StreamIncidentsExportRequest, IncidentResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type TransportAnalytics_StreamIncidentsExportServer = grpc.ServerStreamingServer[IncidentResponse]
+
+func _TransportAnalytics_FindOrphanedIncidents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OrphanedIncidentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransportAnalyticsServer).FindOrphanedIncidents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TransportAnalytics_FindOrphanedIncidents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransportAnalyticsServer).FindOrphanedIncidents(ctx, req.(*OrphanedIncidentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransportAnalytics_NormalizeStationStatuses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransportAnalyticsServer).NormalizeStationStatuses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TransportAnalytics_NormalizeStationStatuses_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransportAnalyticsServer).NormalizeStationStatuses(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransportAnalytics_PurgeOldIncidents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurgeOldIncidentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransportAnalyticsServer).PurgeOldIncidents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TransportAnalytics_PurgeOldIncidents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransportAnalyticsServer).PurgeOldIncidents(ctx, req.(*PurgeOldIncidentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // TransportAnalytics_ServiceDesc is the grpc.ServiceDesc for TransportAnalytics service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -633,6 +2029,38 @@ var TransportAnalytics_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CreateIncident",
 			Handler:    _TransportAnalytics_CreateIncident_Handler,
 		},
+		{
+			MethodName: "ValidateIncident",
+			Handler:    _TransportAnalytics_ValidateIncident_Handler,
+		},
+		{
+			MethodName: "UpdateIncidentStatus",
+			Handler:    _TransportAnalytics_UpdateIncidentStatus_Handler,
+		},
+		{
+			MethodName: "UpdateIncident",
+			Handler:    _TransportAnalytics_UpdateIncident_Handler,
+		},
+		{
+			MethodName: "ReassignIncident",
+			Handler:    _TransportAnalytics_ReassignIncident_Handler,
+		},
+		{
+			MethodName: "ResolveIncident",
+			Handler:    _TransportAnalytics_ResolveIncident_Handler,
+		},
+		{
+			MethodName: "DeleteIncident",
+			Handler:    _TransportAnalytics_DeleteIncident_Handler,
+		},
+		{
+			MethodName: "GetIncidentHistory",
+			Handler:    _TransportAnalytics_GetIncidentHistory_Handler,
+		},
+		{
+			MethodName: "ListIncidentsByTag",
+			Handler:    _TransportAnalytics_ListIncidentsByTag_Handler,
+		},
 		{
 			MethodName: "GetTopBreakdowns",
 			Handler:    _TransportAnalytics_GetTopBreakdowns_Handler,
@@ -641,10 +2069,102 @@ var TransportAnalytics_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetMTBF",
 			Handler:    _TransportAnalytics_GetMTBF_Handler,
 		},
+		{
+			MethodName: "GetStationMTBF",
+			Handler:    _TransportAnalytics_GetStationMTBF_Handler,
+		},
+		{
+			MethodName: "GetWeightedMTBF",
+			Handler:    _TransportAnalytics_GetWeightedMTBF_Handler,
+		},
 		{
 			MethodName: "GetRecentDisruptions",
 			Handler:    _TransportAnalytics_GetRecentDisruptions_Handler,
 		},
+		{
+			MethodName: "GetSegmentIncidents",
+			Handler:    _TransportAnalytics_GetSegmentIncidents_Handler,
+		},
+		{
+			MethodName: "GetRollingIncidentAverage",
+			Handler:    _TransportAnalytics_GetRollingIncidentAverage_Handler,
+		},
+		{
+			MethodName: "GetCumulativeIncidents",
+			Handler:    _TransportAnalytics_GetCumulativeIncidents_Handler,
+		},
+		{
+			MethodName: "GetBusiestPeriod",
+			Handler:    _TransportAnalytics_GetBusiestPeriod_Handler,
+		},
+		{
+			MethodName: "GetIncidentMatrix",
+			Handler:    _TransportAnalytics_GetIncidentMatrix_Handler,
+		},
+		{
+			MethodName: "GetIncidentTypeByHour",
+			Handler:    _TransportAnalytics_GetIncidentTypeByHour_Handler,
+		},
+		{
+			MethodName: "GetIncidentStatusCounts",
+			Handler:    _TransportAnalytics_GetIncidentStatusCounts_Handler,
+		},
+		{
+			MethodName: "GetIncidentsDuringMaintenance",
+			Handler:    _TransportAnalytics_GetIncidentsDuringMaintenance_Handler,
+		},
+		{
+			MethodName: "GetLongestIncidents",
+			Handler:    _TransportAnalytics_GetLongestIncidents_Handler,
+		},
+		{
+			MethodName: "GetConcurrentIncidents",
+			Handler:    _TransportAnalytics_GetConcurrentIncidents_Handler,
+		},
+		{
+			MethodName: "GetNetworkOverview",
+			Handler:    _TransportAnalytics_GetNetworkOverview_Handler,
+		},
+		{
+			MethodName: "GetDurationPercentiles",
+			Handler:    _TransportAnalytics_GetDurationPercentiles_Handler,
+		},
+		{
+			MethodName: "GetLongestQuietPeriod",
+			Handler:    _TransportAnalytics_GetLongestQuietPeriod_Handler,
+		},
+		{
+			MethodName: "GetSLABreaches",
+			Handler:    _TransportAnalytics_GetSLABreaches_Handler,
+		},
+		{
+			MethodName: "GetLineTypeDistribution",
+			Handler:    _TransportAnalytics_GetLineTypeDistribution_Handler,
+		},
+		{
+			MethodName: "GetLineTopIncidentType",
+			Handler:    _TransportAnalytics_GetLineTopIncidentType_Handler,
+		},
+		{
+			MethodName: "GetTimeSinceLastIncident",
+			Handler:    _TransportAnalytics_GetTimeSinceLastIncident_Handler,
+		},
+		{
+			MethodName: "GetStationGrades",
+			Handler:    _TransportAnalytics_GetStationGrades_Handler,
+		},
+		{
+			MethodName: "GetIncidentsForMap",
+			Handler:    _TransportAnalytics_GetIncidentsForMap_Handler,
+		},
+		{
+			MethodName: "GetStationVsLineAverage",
+			Handler:    _TransportAnalytics_GetStationVsLineAverage_Handler,
+		},
+		{
+			MethodName: "GetStationStatusSummary",
+			Handler:    _TransportAnalytics_GetStationStatusSummary_Handler,
+		},
 		{
 			MethodName: "CreateLine",
 			Handler:    _TransportAnalytics_CreateLine_Handler,
@@ -665,6 +2185,14 @@ var TransportAnalytics_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteLine",
 			Handler:    _TransportAnalytics_DeleteLine_Handler,
 		},
+		{
+			MethodName: "GetLineSummary",
+			Handler:    _TransportAnalytics_GetLineSummary_Handler,
+		},
+		{
+			MethodName: "PreviewLineDeletion",
+			Handler:    _TransportAnalytics_PreviewLineDeletion_Handler,
+		},
 		{
 			MethodName: "CreateStation",
 			Handler:    _TransportAnalytics_CreateStation_Handler,
@@ -677,6 +2205,14 @@ var TransportAnalytics_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetStation",
 			Handler:    _TransportAnalytics_GetStation_Handler,
 		},
+		{
+			MethodName: "GetStationWithIncidents",
+			Handler:    _TransportAnalytics_GetStationWithIncidents_Handler,
+		},
+		{
+			MethodName: "GetStationDetails",
+			Handler:    _TransportAnalytics_GetStationDetails_Handler,
+		},
 		{
 			MethodName: "UpdateStation",
 			Handler:    _TransportAnalytics_UpdateStation_Handler,
@@ -685,7 +2221,33 @@ var TransportAnalytics_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteStation",
 			Handler:    _TransportAnalytics_DeleteStation_Handler,
 		},
+		{
+			MethodName: "MergeStations",
+			Handler:    _TransportAnalytics_MergeStations_Handler,
+		},
+		{
+			MethodName: "ReorderStations",
+			Handler:    _TransportAnalytics_ReorderStations_Handler,
+		},
+		{
+			MethodName: "FindOrphanedIncidents",
+			Handler:    _TransportAnalytics_FindOrphanedIncidents_Handler,
+		},
+		{
+			MethodName: "NormalizeStationStatuses",
+			Handler:    _TransportAnalytics_NormalizeStationStatuses_Handler,
+		},
+		{
+			MethodName: "PurgeOldIncidents",
+			Handler:    _TransportAnalytics_PurgeOldIncidents_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamIncidentsExport",
+			Handler:       _TransportAnalytics_StreamIncidentsExport_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "transport.proto",
 }
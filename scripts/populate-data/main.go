@@ -3,15 +3,23 @@ package main
 import (
 	"bytes"
 	"encoding/json"
+	"flag"
 	"fmt"
 	"io"
 	"math/rand"
 	"net/http"
+	"net/url"
 	"os"
+	"sort"
+	"strconv"
+	"strings"
 	"time"
 )
 
-const baseURL = "http://localhost:9091"
+const defaultBaseURL = "http://localhost:9091"
+
+// baseURL is the API the script talks to, set from -url or BASE_URL.
+var baseURL = defaultBaseURL
 
 type Line struct {
 	ID   string `json:"id"`
@@ -34,7 +42,35 @@ type Incident struct {
 }
 
 func main() {
+	seedFlag := flag.Int64("seed", 0, "seed for the random incident generator; overrides the SEED environment variable (default: time-based)")
+	urlFlag := flag.String("url", defaultBaseURL, "base URL of the HTTP API; overrides the BASE_URL environment variable")
+	incidents := flag.Int("incidents", 420, "number of incidents to create")
+	days := flag.Int("days", 90, "spread incidents over this many days before today")
+	flag.Parse()
+
+	if *incidents < 0 {
+		fmt.Println("❌ Error: -incidents must not be negative")
+		os.Exit(1)
+	}
+	if *days < 1 {
+		fmt.Println("❌ Error: -days must be at least 1")
+		os.Exit(1)
+	}
+
+	seed, err := rngSeed(seedFlag)
+	if err != nil {
+		fmt.Printf("❌ Error: %v\n", err)
+		os.Exit(1)
+	}
+
+	baseURL, err = apiBaseURL(urlFlag)
+	if err != nil {
+		fmt.Printf("❌ Error: %v\n", err)
+		os.Exit(1)
+	}
+
 	fmt.Println("🚀 Starting to populate Transport Analytics database with sample data...")
+	fmt.Printf("   API: %s\n", baseURL)
 	fmt.Println()
 
 	// Wait for API to be ready
@@ -122,15 +158,16 @@ func main() {
 	fmt.Println()
 
 	// Create Incidents
-	fmt.Println("⚠️  Creating sample incidents (400+ over last 90 days)...")
+	fmt.Printf("⚠️  Creating %d sample incidents over the last %d days...\n", *incidents, *days)
 
 	// Create random number generator
-	rng := rand.New(rand.NewSource(time.Now().UnixNano()))
+	rng := rand.New(rand.NewSource(seed))
+	fmt.Printf("  Using seed %d (pass -seed %d to repeat this run)\n", seed, seed)
 
 	// Incident types with their probabilities
 	incidentTypes := []string{"mechanical", "signal", "power", "mechanical", "signal", "mechanical", "weather", "other"}
 
-	// Generate 420 incidents spread over 90 days
+	// Generate the incidents spread over the requested window
 	incidentCount := 0
 	successCount := 0
 	failCount := 0
@@ -141,8 +178,16 @@ func main() {
 		Station string
 	}{}
 
-	for lineName, stationNames := range stations {
-		for _, stationName := range stationNames {
+	// Walk the lines in a fixed order so a given seed always picks the same
+	// pairs; map iteration order is random.
+	lineNames := make([]string, 0, len(stations))
+	for lineName := range stations {
+		lineNames = append(lineNames, lineName)
+	}
+	sort.Strings(lineNames)
+
+	for _, lineName := range lineNames {
+		for _, stationName := range stations[lineName] {
 			lineStationPairs = append(lineStationPairs, struct {
 				Line    string
 				Station string
@@ -150,10 +195,15 @@ func main() {
 		}
 	}
 
-	// Generate 420 incidents
-	for i := 0; i < 420; i++ {
-		// Random day in the last 90 days
-		daysAgo := rng.Intn(90)
+	// Print progress about every 2% of the run, but no more often than every
+	// 50 incidents.
+	progressEvery := max(50, *incidents/50)
+
+	// Incidents are posted one at a time: the API has no batch ingestion
+	// endpoint yet. Large runs take a while.
+	for i := 0; i < *incidents; i++ {
+		// Random day in the window
+		daysAgo := rng.Intn(*days)
 
 		// Random hour (weighted toward peak hours 7-9am and 5-8pm)
 		hour := rng.Intn(24)
@@ -205,8 +255,8 @@ func main() {
 		}
 		successCount++
 
-		// Print progress every 50 incidents
-		if successCount%50 == 0 {
+		// Print progress
+		if successCount%progressEvery == 0 {
 			fmt.Printf("  ✓ Created %d incidents...\n", successCount)
 		}
 	}
@@ -222,8 +272,62 @@ func main() {
 	fmt.Printf("  - Incidents created: %d\n", successCount)
 	fmt.Println()
 	fmt.Println("You can now view the data at:")
-	fmt.Println("  - Swagger UI: http://localhost:9091/swagger/")
-	fmt.Println("  - API: http://localhost:9091")
+	fmt.Printf("  - Swagger UI: %s/swagger/\n", baseURL)
+	fmt.Printf("  - API: %s\n", baseURL)
+}
+
+// rngSeed returns the seed for the incident generator: the -seed flag when
+// given, else the SEED environment variable, else the current time.
+func rngSeed(seedFlag *int64) (int64, error) {
+	if flagSet("seed") {
+		return *seedFlag, nil
+	}
+
+	if env := os.Getenv("SEED"); env != "" {
+		seed, err := strconv.ParseInt(env, 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("invalid SEED %q: must be an integer", env)
+		}
+		return seed, nil
+	}
+
+	return time.Now().UnixNano(), nil
+}
+
+// apiBaseURL returns the API base URL: the -url flag when given, else the
+// BASE_URL environment variable, else the local default. It must be an
+// absolute http or https URL; a trailing slash is dropped.
+func apiBaseURL(urlFlag *string) (string, error) {
+	raw := *urlFlag
+	if !flagSet("url") {
+		if env := os.Getenv("BASE_URL"); env != "" {
+			raw = env
+		}
+	}
+
+	raw = strings.TrimRight(strings.TrimSpace(raw), "/")
+	u, err := url.Parse(raw)
+	if err != nil {
+		return "", fmt.Errorf("invalid base URL %q: %v", raw, err)
+	}
+	if (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return "", fmt.Errorf("invalid base URL %q: must be an absolute http or https URL", raw)
+	}
+	if u.RawQuery != "" || u.Fragment != "" {
+		return "", fmt.Errorf("invalid base URL %q: must not have a query or fragment", raw)
+	}
+	return raw, nil
+}
+
+// flagSet reports whether the named flag was given on the command line.
+func flagSet(name string) bool {
+	set := false
+	flag.Visit(func(f *flag.Flag) {
+		if f.Name == name {
+			set = true
+		}
+	})
+	return set
 }
 
 func waitForAPI() error {